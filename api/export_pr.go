@@ -25,6 +25,7 @@ func (issue *Issue) ExportData(fields []string) map[string]interface{} {
 				items = append(items, map[string]interface{}{
 					"status": n.Status,
 					"title":  n.Project.Title,
+					"number": n.Project.Number,
 				})
 			}
 			data[f] = items
@@ -111,15 +112,20 @@ func (pr *PullRequest) ExportData(fields []string) map[string]interface{} {
 				items = append(items, map[string]interface{}{
 					"status": n.Status,
 					"title":  n.Project.Title,
+					"number": n.Project.Number,
 				})
 			}
 			data[f] = items
+		case "mergeQueueEntry":
+			data[f] = pr.MergeQueueEntry
 		case "reviews":
 			data[f] = pr.Reviews.Nodes
 		case "latestReviews":
 			data[f] = pr.LatestReviews.Nodes
 		case "files":
 			data[f] = pr.Files.Nodes
+		case "timelineItems":
+			data[f] = pr.TimelineItems.Nodes
 		case "reviewRequests":
 			requests := make([]interface{}, 0, len(pr.ReviewRequests.Nodes))
 			for _, req := range pr.ReviewRequests.Nodes {
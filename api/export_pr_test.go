@@ -84,7 +84,8 @@ func TestIssue_ExportData(t *testing.T) {
 						"id": "PVTI_id",
 						"project": {
 							"id": "PVT_id",
-							"title": "Some Project"
+							"title": "Some Project",
+							"number": 7
 						},
 						"status": {
 							"name": "Todo",
@@ -97,6 +98,7 @@ func TestIssue_ExportData(t *testing.T) {
 				{
 					"projectItems": [
 						{
+							"number": 7,
 							"status": {
 								"optionId": "abc123",
 								"name": "Todo"
@@ -107,6 +109,24 @@ func TestIssue_ExportData(t *testing.T) {
 				}
 			`),
 		},
+		{
+			name:   "tracked issues",
+			fields: []string{"number", "trackedIssues", "trackedInIssues"},
+			inputJSON: heredoc.Doc(`
+				{ "number": 2345, "trackedIssues": {"totalCount": 3}, "trackedInIssues": {"totalCount": 1} }
+			`),
+			outputJSON: heredoc.Doc(`
+				{
+					"number": 2345,
+					"trackedInIssues": {
+						"totalCount": 1
+					},
+					"trackedIssues": {
+						"totalCount": 3
+					}
+				}
+			`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -222,7 +242,8 @@ func TestPullRequest_ExportData(t *testing.T) {
 						"id": "PVTPR_id",
 						"project": {
 							"id": "PVT_id",
-							"title": "Some Project"
+							"title": "Some Project",
+							"number": 7
 						},
 						"status": {
 							"name": "Todo",
@@ -235,6 +256,7 @@ func TestPullRequest_ExportData(t *testing.T) {
 				{
 					"projectItems": [
 						{
+							"number": 7,
 							"status": {
 								"optionId": "abc123",
 								"name": "Todo"
@@ -245,6 +267,95 @@ func TestPullRequest_ExportData(t *testing.T) {
 				}
 			`),
 		},
+		{
+			name:   "latest reviews",
+			fields: []string{"latestReviews"},
+			inputJSON: heredoc.Doc(`
+				{ "latestReviews": { "nodes": [
+					{
+						"author": { "login": "hubot" },
+						"authorAssociation": "COLLABORATOR",
+						"submittedAt": "2020-08-31T15:44:24+02:00",
+						"body": "looks good",
+						"state": "APPROVED"
+					},
+					{
+						"author": { "login": "monalisa" },
+						"authorAssociation": "MEMBER",
+						"submittedAt": "2020-09-01T10:00:00+02:00",
+						"body": "needs work",
+						"state": "CHANGES_REQUESTED"
+					}
+				] } }
+			`),
+			outputJSON: heredoc.Doc(`
+				{
+					"latestReviews": [
+						{
+							"id": "",
+							"author": {
+								"login": "hubot"
+							},
+							"authorAssociation": "COLLABORATOR",
+							"body": "looks good",
+							"submittedAt": "2020-08-31T15:44:24+02:00",
+							"includesCreatedEdit": false,
+							"reactionGroups": [],
+							"state": "APPROVED",
+							"commit": {
+								"oid": ""
+							}
+						},
+						{
+							"id": "",
+							"author": {
+								"login": "monalisa"
+							},
+							"authorAssociation": "MEMBER",
+							"body": "needs work",
+							"submittedAt": "2020-09-01T10:00:00+02:00",
+							"includesCreatedEdit": false,
+							"reactionGroups": [],
+							"state": "CHANGES_REQUESTED",
+							"commit": {
+								"oid": ""
+							}
+						}
+					]
+				}
+			`),
+		},
+		{
+			name:   "auto-merge request",
+			fields: []string{"autoMergeRequest"},
+			inputJSON: heredoc.Doc(`
+				{ "autoMergeRequest": {
+					"authorEmail": null,
+					"commitBody": null,
+					"commitHeadline": null,
+					"mergeMethod": "SQUASH",
+					"enabledAt": "2021-02-19T06:34:58Z",
+					"enabledBy": { "id": "MDQ6VXNlcjE=", "login": "hubot" }
+				} }
+			`),
+			outputJSON: heredoc.Doc(`
+				{
+					"autoMergeRequest": {
+						"authorEmail": null,
+						"commitBody": null,
+						"commitHeadline": null,
+						"mergeMethod": "SQUASH",
+						"enabledAt": "2021-02-19T06:34:58Z",
+						"enabledBy": {
+							"id": "MDQ6VXNlcjE=",
+							"login": "hubot",
+							"name": "",
+							"is_bot": false
+						}
+					}
+				}
+			`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
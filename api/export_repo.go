@@ -15,7 +15,7 @@ func (repo *Repository) ExportData(fields []string) map[string]interface{} {
 		case "templateRepository":
 			data[f] = miniRepoExport(repo.TemplateRepository)
 		case "languages":
-			data[f] = repo.Languages.Edges
+			data[f] = exportLanguages(repo.Languages.Edges)
 		case "labels":
 			data[f] = repo.Labels.Nodes
 		case "assignableUsers":
@@ -41,6 +41,35 @@ func (repo *Repository) ExportData(fields []string) map[string]interface{} {
 	return data
 }
 
+// RepositoryLanguage is a single language's share of a repository, with its
+// percentage of the total computed client-side from the byte sizes GitHub
+// reports for each language.
+type RepositoryLanguage struct {
+	Name       string  `json:"name"`
+	Size       int     `json:"size"`
+	Percentage float64 `json:"percentage"`
+}
+
+func exportLanguages(edges []RepositoryLanguageEdge) []RepositoryLanguage {
+	languages := make([]RepositoryLanguage, len(edges))
+	var total int
+	for _, e := range edges {
+		total += e.Size
+	}
+	for i, e := range edges {
+		var percentage float64
+		if total > 0 {
+			percentage = float64(e.Size) / float64(total) * 100
+		}
+		languages[i] = RepositoryLanguage{
+			Name:       e.Node.Name,
+			Size:       e.Size,
+			Percentage: percentage,
+		}
+	}
+	return languages
+}
+
 func miniRepoExport(r *Repository) map[string]interface{} {
 	if r == nil {
 		return nil
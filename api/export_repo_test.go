@@ -0,0 +1,36 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportData_languages(t *testing.T) {
+	repo := &Repository{
+		Languages: struct {
+			Edges []RepositoryLanguageEdge
+		}{
+			Edges: []RepositoryLanguageEdge{
+				{Size: 300, Node: CodingLanguage{Name: "Go", Color: "#00ADD8"}},
+				{Size: 100, Node: CodingLanguage{Name: "Shell", Color: "#89e051"}},
+			},
+		},
+	}
+
+	data := repo.ExportData([]string{"languages"})
+	languages, ok := data["languages"].([]RepositoryLanguage)
+	assert.True(t, ok)
+	assert.Equal(t, []RepositoryLanguage{
+		{Name: "Go", Size: 300, Percentage: 75},
+		{Name: "Shell", Size: 100, Percentage: 25},
+	}, languages)
+}
+
+func TestExportData_languagesEmpty(t *testing.T) {
+	repo := &Repository{}
+	data := repo.ExportData([]string{"languages"})
+	languages, ok := data["languages"].([]RepositoryLanguage)
+	assert.True(t, ok)
+	assert.Empty(t, languages)
+}
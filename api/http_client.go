@@ -1,10 +1,18 @@
 package api
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghinstance"
@@ -16,6 +24,22 @@ type tokenGetter interface {
 	ActiveToken(string) (string, string)
 }
 
+// hostTLSGetter resolves per-host TLS material for connecting to GitHub
+// Enterprise Server instances that sit behind a private CA or require a
+// client certificate.
+type hostTLSGetter interface {
+	CABundle(string) string
+	ClientCertificate(string) string
+	ClientKey(string) string
+}
+
+// hostProxyGetter resolves the proxy URL configured for a given host, so
+// that e.g. a GitHub Enterprise Server instance can be reached through a
+// bastion while github.com is dialed directly.
+type hostProxyGetter interface {
+	HTTPProxy(string) string
+}
+
 type HTTPClientOptions struct {
 	AppVersion     string
 	CacheTTL       time.Duration
@@ -24,15 +48,45 @@ type HTTPClientOptions struct {
 	Log            io.Writer
 	LogColorize    bool
 	LogVerboseHTTP bool
+	Timeout        time.Duration
+
+	// RequestTimeout bounds how long a single request attempt may run,
+	// enforced via a context deadline rather than Client.Timeout so that a
+	// caller streaming a large response body (e.g. a release asset) can
+	// switch it to an idle timeout by marking the request's context with
+	// WithIdleRequestTimeout.
+	RequestTimeout time.Duration
+
+	// UnixSocket, when set, routes all API requests through the given Unix
+	// domain socket instead of dialing the API host directly.
+	UnixSocket string
+
+	// HostTLSConfig, when set, is consulted on every request for a
+	// per-host CA bundle and/or client certificate to use instead of the
+	// system defaults.
+	HostTLSConfig hostTLSGetter
+
+	// HostProxyConfig, when set, is consulted on every request for a
+	// per-host proxy URL, falling back to the environment proxy variables
+	// (HTTPS_PROXY, NO_PROXY, etc.) for hosts with none configured.
+	HostProxyConfig hostProxyGetter
 }
 
 func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
+	if opts.UnixSocket != "" {
+		if _, err := os.Stat(opts.UnixSocket); err != nil {
+			return nil, fmt.Errorf("unix socket %q is not accessible: %w", opts.UnixSocket, err)
+		}
+	}
+
 	// Provide invalid host, and token values so gh.HTTPClient will not automatically resolve them.
 	// The real host and token are inserted at request time.
 	clientOpts := ghAPI.ClientOptions{
-		Host:         "none",
-		AuthToken:    "none",
-		LogIgnoreEnv: true,
+		Host:             "none",
+		AuthToken:        "none",
+		LogIgnoreEnv:     true,
+		Timeout:          opts.Timeout,
+		UnixDomainSocket: opts.UnixSocket,
 	}
 
 	debugEnabled, debugValue := utils.IsDebugEnabled()
@@ -61,10 +115,18 @@ func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
 		return nil, err
 	}
 
+	if opts.HostTLSConfig != nil || opts.HostProxyConfig != nil {
+		client.Transport = addHostTransportOverrides(client.Transport, opts.UnixSocket, opts.HostTLSConfig, opts.HostProxyConfig)
+	}
+
 	if opts.Config != nil {
 		client.Transport = AddAuthTokenHeader(client.Transport, opts.Config)
 	}
 
+	if opts.RequestTimeout > 0 {
+		client.Transport = AddRequestTimeout(client.Transport, opts.RequestTimeout)
+	}
+
 	return client, nil
 }
 
@@ -86,6 +148,93 @@ func AddCacheTTLHeader(rt http.RoundTripper, ttl time.Duration) http.RoundTrippe
 	}}
 }
 
+// ErrOffline is returned when a request is blocked because GH_OFFLINE mode
+// is active and the request cannot be served from the cache.
+var ErrOffline = errors.New("unable to perform this action while offline")
+
+// offlineCacheTTL is the TTL applied to requests in offline mode. It is set
+// far longer than any real cache entry's age so that a stale cache hit is
+// always preferred over an error.
+const offlineCacheTTL = 365 * 24 * time.Hour
+
+// IsOfflineMode reports whether GH_OFFLINE is set, in which case cacheable
+// requests should be served from the local cache store regardless of age,
+// and mutating requests should be refused before they reach the network.
+func IsOfflineMode() bool {
+	switch os.Getenv("GH_OFFLINE") {
+	case "", "0", "false", "no":
+		return false
+	default:
+		return true
+	}
+}
+
+// OfflineStatus reports how stale the most recent response served by a
+// client wrapped with NewOfflineHTTPClient is, so that a command can let the
+// user know the results it displays are not live.
+type OfflineStatus struct {
+	mu       sync.Mutex
+	servedAt time.Time
+	served   bool
+}
+
+// Served reports the timestamp the most recently served cached response was
+// originally generated at, and whether any response has been served yet.
+func (s *OfflineStatus) Served() (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.servedAt, s.served
+}
+
+func (s *OfflineStatus) record(servedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.served = true
+	s.servedAt = servedAt
+}
+
+// NewOfflineHTTPClient wraps httpClient so that GET and GraphQL requests are
+// always served from the on-disk response cache regardless of its age,
+// reusing the same cache store as --cache, and any other request fails fast
+// with ErrOffline instead of reaching the network. The returned
+// OfflineStatus can be inspected afterwards to tell the user how stale the
+// data they were shown is.
+func NewOfflineHTTPClient(httpClient *http.Client) (*http.Client, *OfflineStatus) {
+	status := &OfflineStatus{}
+	newClient := *httpClient
+	newClient.Transport = AddOfflineMode(httpClient.Transport, status)
+	return &newClient, status
+}
+
+// AddOfflineMode wraps rt so that cacheable requests are forced to be read
+// from the cache no matter how old the entry is, recording the age of
+// whatever gets served into status, while any other request is rejected
+// with ErrOffline before it reaches the network.
+func AddOfflineMode(rt http.RoundTripper, status *OfflineStatus) http.RoundTripper {
+	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		if !isCacheableOfflineRequest(req) {
+			return nil, ErrOffline
+		}
+		if req.Header.Get(cacheTTL) == "" {
+			req.Header.Set(cacheTTL, offlineCacheTTL.String())
+		}
+		res, err := rt.RoundTrip(req)
+		if err == nil {
+			if servedAt, dateErr := http.ParseTime(res.Header.Get("Date")); dateErr == nil {
+				status.record(servedAt)
+			}
+		}
+		return res, err
+	}}
+}
+
+func isCacheableOfflineRequest(req *http.Request) bool {
+	if strings.EqualFold(req.Method, http.MethodGet) || strings.EqualFold(req.Method, http.MethodHead) {
+		return true
+	}
+	return strings.EqualFold(req.Method, http.MethodPost) && strings.Contains(req.URL.Path, "graphql")
+}
+
 // AddAuthToken adds an authentication token header for the host specified by the request.
 func AddAuthTokenHeader(rt http.RoundTripper, cfg tokenGetter) http.RoundTripper {
 	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
@@ -108,6 +257,272 @@ func AddAuthTokenHeader(rt http.RoundTripper, cfg tokenGetter) http.RoundTripper
 	}}
 }
 
+// AddHostTLSConfig wraps rt so that requests to a host with a configured CA
+// bundle and/or client certificate are routed through a transport built with
+// that TLS material, instead of the system defaults. This is intended for
+// GitHub Enterprise Server instances that sit behind a private CA or require
+// mutual TLS.
+func AddHostTLSConfig(rt http.RoundTripper, unixSocket string, cfg hostTLSGetter) http.RoundTripper {
+	return addHostTransportOverrides(rt, unixSocket, cfg, nil)
+}
+
+// AddHostProxy wraps rt so that requests to a host with a configured proxy
+// URL are routed through it, instead of relying solely on the environment
+// proxy variables. The scheme may be http, https, or socks5, and may embed
+// userinfo for proxy authentication. Hosts without a configured proxy fall
+// through to rt unchanged, so environment variables like HTTPS_PROXY and
+// NO_PROXY continue to apply for them.
+func AddHostProxy(rt http.RoundTripper, unixSocket string, cfg hostProxyGetter) http.RoundTripper {
+	return addHostTransportOverrides(rt, unixSocket, nil, cfg)
+}
+
+// addHostTransportOverrides wraps rt so that requests to a host with a
+// configured TLS material and/or proxy apply both onto a single per-host
+// transport, rather than each override building an unrelated one. That
+// transport is seeded with the same dialer NewHTTPClient would otherwise
+// use for unixSocket (see newUnixSocketTransport), not a clone of rt itself:
+// by the time rt reaches here it has already been wrapped by go-gh's
+// caching and ASCII-sanitizing round-trippers, so it is no longer a
+// *http.Transport we could clone, and a type-switch fallback to
+// http.DefaultTransport would silently stop routing through the socket.
+// Layering TLS and/or proxy overrides as two independently-constructed
+// transports has the same problem in miniature: whichever one isn't
+// innermost loses the other's settings, so both are applied here in one
+// pass.
+func addHostTransportOverrides(rt http.RoundTripper, unixSocket string, tlsCfg hostTLSGetter, proxyCfg hostProxyGetter) http.RoundTripper {
+	var mu sync.Mutex
+	transports := map[string]http.RoundTripper{}
+	isVerbose, _ := utils.IsDebugEnabled()
+
+	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		hostname := ghinstance.NormalizeHostname(getHost(req))
+
+		var caBundle, certFile, keyFile string
+		if tlsCfg != nil {
+			caBundle = tlsCfg.CABundle(hostname)
+			certFile = tlsCfg.ClientCertificate(hostname)
+			keyFile = tlsCfg.ClientKey(hostname)
+		}
+
+		var proxyURLString string
+		if proxyCfg != nil {
+			proxyURLString = proxyCfg.HTTPProxy(hostname)
+			if proxyURLString == "" && isVerbose {
+				fmt.Fprintf(os.Stderr, "* proxy for %s: none configured, using environment\n", hostname)
+			}
+		}
+
+		if caBundle == "" && certFile == "" && keyFile == "" && proxyURLString == "" {
+			return rt.RoundTrip(req)
+		}
+
+		mu.Lock()
+		hostTransport, ok := transports[hostname]
+		mu.Unlock()
+		if !ok {
+			transport := newUnixSocketTransport(unixSocket)
+
+			if caBundle != "" || certFile != "" || keyFile != "" {
+				tlsConfig, err := buildTLSConfig(caBundle, certFile, keyFile)
+				if err != nil {
+					return nil, err
+				}
+				transport.TLSClientConfig = tlsConfig
+			}
+
+			if proxyURLString != "" {
+				proxyURL, err := url.Parse(proxyURLString)
+				if err != nil {
+					return nil, fmt.Errorf("invalid http_proxy %q for %s: %w", proxyURLString, hostname, err)
+				}
+				if isVerbose {
+					fmt.Fprintf(os.Stderr, "* proxy for %s: %s\n", hostname, proxyURL.Redacted())
+				}
+				transport.Proxy = http.ProxyURL(proxyURL)
+			}
+
+			hostTransport = transport
+			mu.Lock()
+			transports[hostname] = hostTransport
+			mu.Unlock()
+		}
+		return hostTransport.RoundTrip(req)
+	}}
+}
+
+// newUnixSocketTransport returns the base *http.Transport that a per-host
+// TLS/proxy override should build on. When unixSocket is set it dials that
+// socket for every connection, mirroring go-gh's own
+// newUnixDomainSocketRoundTripper, so that configuring --unix-socket
+// together with a CA bundle, client certificate, or proxy for the same host
+// still routes through the socket instead of falling back to a real network
+// dial. Otherwise it clones http.DefaultTransport.
+func newUnixSocketTransport(unixSocket string) *http.Transport {
+	if unixSocket == "" {
+		return http.DefaultTransport.(*http.Transport).Clone()
+	}
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", unixSocket)
+	}
+	return &http.Transport{
+		DialContext:       dial,
+		DialTLSContext:    dial,
+		DisableKeepAlives: true,
+	}
+}
+
+// buildTLSConfig assembles a tls.Config from an optional PEM-encoded CA
+// bundle, trusted in addition to the system roots, and an optional
+// PEM-encoded client certificate and key pair for mutual TLS.
+func buildTLSConfig(caBundle, certFile, keyFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if caBundle != "" {
+		pem, err := os.ReadFile(caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle %q: %w", caBundle, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to parse CA bundle %q: no certificates found", caBundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, errors.New("client_certificate and client_key must both be set")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+type idleRequestTimeoutKey struct{}
+
+// WithIdleRequestTimeout marks ctx so that, if AddRequestTimeout is in
+// effect, the configured duration is enforced as an idle timeout (reset on
+// every byte read from the response body) rather than a deadline on the
+// request as a whole. Use this for requests that stream a large body, like
+// an artifact or release asset download, which can legitimately take longer
+// than the timeout as long as they're still making progress.
+func WithIdleRequestTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idleRequestTimeoutKey{}, true)
+}
+
+// AddRequestTimeout bounds every request made through rt by a per-attempt
+// deadline. Unlike setting Client.Timeout, the deadline is carried on the
+// request's context, so a caller can opt out of the total deadline in favor
+// of an idle one via WithIdleRequestTimeout.
+func AddRequestTimeout(rt http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		if idle, _ := req.Context().Value(idleRequestTimeoutKey{}).(bool); idle {
+			return roundTripWithIdleTimeout(rt, req, timeout)
+		}
+
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		req = req.WithContext(ctx)
+
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			cancel()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("request to %s timed out after %s", req.URL, timeout)
+			}
+			return nil, err
+		}
+
+		// The deadline must stay active until the response body has been
+		// fully read, so defer canceling it to the body's Close call.
+		resp.Body = &timeoutReader{ReadCloser: resp.Body, ctx: ctx, url: req.URL.String(), timeout: timeout, cancel: cancel}
+		return resp, nil
+	}}
+}
+
+// timeoutReader wraps a response body so that a deadline exceeded while
+// reading the body is reported the same way as one hit during the round
+// trip, and so the deadline's resources are always released on Close.
+type timeoutReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	url     string
+	timeout time.Duration
+	cancel  context.CancelFunc
+}
+
+func (r *timeoutReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err != nil && errors.Is(r.ctx.Err(), context.DeadlineExceeded) {
+		return n, fmt.Errorf("request to %s timed out after %s", r.url, r.timeout)
+	}
+	return n, err
+}
+
+func (r *timeoutReader) Close() error {
+	defer r.cancel()
+	return r.ReadCloser.Close()
+}
+
+// roundTripWithIdleTimeout performs req and, once a response is received,
+// resets a timer on every successful read from the body, canceling the
+// request only once no bytes have arrived for the idle duration.
+func roundTripWithIdleTimeout(rt http.RoundTripper, req *http.Request, idle time.Duration) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(ctx)
+	timer := time.AfterFunc(idle, cancel)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		timedOut := errors.Is(ctx.Err(), context.Canceled)
+		timer.Stop()
+		cancel()
+		if timedOut {
+			return nil, fmt.Errorf("request to %s timed out after %s of inactivity", req.URL, idle)
+		}
+		return nil, err
+	}
+
+	resp.Body = &idleTimeoutReader{ReadCloser: resp.Body, timer: timer, idle: idle, cancel: cancel, url: req.URL.String()}
+	return resp, nil
+}
+
+// idleTimeoutReader wraps a response body, resetting an idle timer on every
+// successful read and translating the resulting cancellation into an error
+// that names the endpoint and the idle duration that elapsed.
+type idleTimeoutReader struct {
+	io.ReadCloser
+	timer  *time.Timer
+	idle   time.Duration
+	cancel context.CancelFunc
+	url    string
+}
+
+func (r *idleTimeoutReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.timer.Reset(r.idle)
+	}
+	if err != nil && errors.Is(err, context.Canceled) {
+		return n, fmt.Errorf("request to %s timed out after %s of inactivity", r.url, r.idle)
+	}
+	return n, err
+}
+
+func (r *idleTimeoutReader) Close() error {
+	r.timer.Stop()
+	r.cancel()
+	return r.ReadCloser.Close()
+}
+
 // ExtractHeader extracts a named header from any response received by this client and,
 // if non-blank, saves it to dest.
 func ExtractHeader(name string, dest *string) func(http.RoundTripper) http.RoundTripper {
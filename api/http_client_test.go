@@ -2,13 +2,19 @@ package api
 
 import (
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -157,6 +163,321 @@ func TestNewHTTPClient(t *testing.T) {
 	}
 }
 
+func TestNewHTTPClientTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	ios, _, _, _ := iostreams.Test()
+	client, err := NewHTTPClient(HTTPClientOptions{
+		Config:  tinyConfig{},
+		Log:     ios.ErrOut,
+		Timeout: 1 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	require.Error(t, err)
+	assert.True(t, os.IsTimeout(err) || strings.Contains(err.Error(), "Client.Timeout"), err.Error())
+}
+
+func TestNewHTTPClientRequestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	ios, _, _, _ := iostreams.Test()
+	client, err := NewHTTPClient(HTTPClientOptions{
+		Config:         tinyConfig{},
+		Log:            ios.ErrOut,
+		RequestTimeout: 1 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("request to %s timed out after 1ms", ts.URL))
+}
+
+func TestNewHTTPClientIdleRequestTimeoutToleratesProgress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		for i := 0; i < 3; i++ {
+			_, _ = w.Write([]byte("x"))
+			flusher.Flush()
+			time.Sleep(15 * time.Millisecond)
+		}
+	}))
+	defer ts.Close()
+
+	ios, _, _, _ := iostreams.Test()
+	client, err := NewHTTPClient(HTTPClientOptions{
+		Config:         tinyConfig{},
+		Log:            ios.ErrOut,
+		RequestTimeout: 30 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+	req = req.WithContext(WithIdleRequestTimeout(req.Context()))
+
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "xxx", string(body))
+}
+
+func TestNewHTTPClientIdleRequestTimeoutFiresOnStall(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		_, _ = w.Write([]byte("x"))
+		flusher.Flush()
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte("y"))
+	}))
+	defer ts.Close()
+
+	ios, _, _, _ := iostreams.Test()
+	client, err := NewHTTPClient(HTTPClientOptions{
+		Config:         tinyConfig{},
+		Log:            ios.ErrOut,
+		RequestTimeout: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+	req = req.WithContext(WithIdleRequestTimeout(req.Context()))
+
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	_, err = io.ReadAll(res.Body)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("request to %s timed out after 10ms of inactivity", ts.URL))
+}
+
+func TestNewHTTPClientUnixSocketMissing(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	_, err := NewHTTPClient(HTTPClientOptions{
+		Config:     tinyConfig{},
+		Log:        ios.ErrOut,
+		UnixSocket: filepath.Join(t.TempDir(), "nonexistent.sock"),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not accessible")
+}
+
+func TestNewHTTPClientUnixSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "gh.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	ios, _, _, _ := iostreams.Test()
+	client, err := NewHTTPClient(HTTPClientOptions{
+		Config:     tinyConfig{},
+		Log:        ios.ErrOut,
+		UnixSocket: socketPath,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "https://example.com", nil)
+	require.NoError(t, err)
+
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestNewHTTPClientUnixSocketWithHostTLSConfig(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "gh.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go http.Serve(listener, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	// The CA bundle doesn't need to match a real server's certificate here:
+	// the unix socket transport never performs an actual TLS handshake, so
+	// this is only exercising that the socket dialer survives being
+	// combined with a host TLS override for the same host.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caBundle, pemBytes, 0600))
+
+	ios, _, _, _ := iostreams.Test()
+	client, err := NewHTTPClient(HTTPClientOptions{
+		Config:        tinyConfig{},
+		Log:           ios.ErrOut,
+		UnixSocket:    socketPath,
+		HostTLSConfig: tinyTLSConfig{"example.com": caBundle},
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "https://example.com", nil)
+	require.NoError(t, err)
+
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestAddHostTLSConfigCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caBundle, pemBytes, 0600))
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := &http.Client{
+		Transport: AddHostTLSConfig(http.DefaultTransport, "", tinyTLSConfig{serverURL.Host: caBundle}),
+	}
+
+	res, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestAddHostTLSConfigCABundleMissingFile(t *testing.T) {
+	client := &http.Client{
+		Transport: AddHostTLSConfig(http.DefaultTransport, "", tinyTLSConfig{"example.com": filepath.Join(t.TempDir(), "nonexistent.pem")}),
+	}
+
+	_, err := client.Get("https://example.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read CA bundle")
+}
+
+func TestAddHostTransportOverridesProxiesAndVerifiesTLSForSameHost(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer tlsServer.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caBundle, pemBytes, 0600))
+
+	var connected bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodConnect, r.Method)
+		connected = true
+
+		destConn, err := net.Dial("tcp", r.Host)
+		require.NoError(t, err)
+		defer destConn.Close()
+
+		clientConn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		defer clientConn.Close()
+		clientConn.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n"))
+
+		go io.Copy(destConn, clientConn)
+		io.Copy(clientConn, destConn)
+	}))
+	defer proxy.Close()
+
+	serverURL, err := url.Parse(tlsServer.URL)
+	require.NoError(t, err)
+
+	// Both overrides target the same host: if either one built its own
+	// unrelated transport instead of the two composing on a single one,
+	// this would either bypass the proxy (no CONNECT observed) or fail the
+	// TLS handshake against the self-signed tlsServer certificate.
+	rt := addHostTransportOverrides(http.DefaultTransport, "",
+		tinyTLSConfig{serverURL.Host: caBundle},
+		tinyProxyConfig{serverURL.Host: proxy.URL},
+	)
+	client := &http.Client{Transport: rt}
+
+	res, err := client.Get(tlsServer.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.True(t, connected)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestAddHostProxy(t *testing.T) {
+	var proxied bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	client := &http.Client{
+		Transport: AddHostProxy(http.DefaultTransport, "", tinyProxyConfig{"example.com": proxy.URL}),
+	}
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.True(t, proxied)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestAddHostProxyFallsThroughWhenUnconfigured(t *testing.T) {
+	var called bool
+	rt := funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: make(http.Header)}, nil
+	}}
+
+	client := &http.Client{Transport: AddHostProxy(rt, "", tinyProxyConfig{})}
+
+	req, err := http.NewRequest("GET", "http://example.com", nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.True(t, called)
+}
+
+func TestAddHostProxyInvalidURL(t *testing.T) {
+	client := &http.Client{
+		Transport: AddHostProxy(http.DefaultTransport, "", tinyProxyConfig{"example.com": "://bad-url"}),
+	}
+
+	_, err := client.Get("http://example.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid http_proxy")
+}
+
 func TestHTTPClientRedirectAuthenticationHeaderHandling(t *testing.T) {
 	var request *http.Request
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -263,12 +584,94 @@ func TestHTTPClientSanitizeControlCharactersC1(t *testing.T) {
 	assert.Equal(t, "monalisa¡", issue.Author.Login)
 }
 
+func TestIsOfflineMode(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		unset bool
+		want  bool
+	}{
+		{name: "unset", unset: true, want: false},
+		{name: "empty", value: "", want: false},
+		{name: "zero", value: "0", want: false},
+		{name: "false", value: "false", want: false},
+		{name: "no", value: "no", want: false},
+		{name: "one", value: "1", want: true},
+		{name: "true", value: "true", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.unset {
+				os.Unsetenv("GH_OFFLINE")
+			} else {
+				t.Setenv("GH_OFFLINE", tt.value)
+			}
+			assert.Equal(t, tt.want, IsOfflineMode())
+		})
+	}
+}
+
+func TestAddOfflineModeBlocksMutations(t *testing.T) {
+	status := &OfflineStatus{}
+	called := false
+	rt := AddOfflineMode(&funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		called = true
+		return nil, nil
+	}}, status)
+
+	req, err := http.NewRequest("POST", "https://api.github.com/repos/OWNER/REPO/issues", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	assert.ErrorIs(t, err, ErrOffline)
+	assert.False(t, called, "mutating request should not reach the network")
+
+	if _, ok := status.Served(); ok {
+		t.Fatal("blocked request should not be recorded as served")
+	}
+}
+
+func TestAddOfflineModeServesCacheableRequests(t *testing.T) {
+	status := &OfflineStatus{}
+	servedAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	rt := AddOfflineMode(&funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, offlineCacheTTL.String(), req.Header.Get(cacheTTL))
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Date": []string{servedAt.Format(http.TimeFormat)}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	}}, status)
+
+	req, err := http.NewRequest("GET", "https://api.github.com/repos/OWNER/REPO/issues", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	age, ok := status.Served()
+	require.True(t, ok)
+	assert.True(t, age.Equal(servedAt), "expected %v, got %v", servedAt, age)
+}
+
 type tinyConfig map[string]string
 
 func (c tinyConfig) ActiveToken(host string) (string, string) {
 	return c[fmt.Sprintf("%s:%s", host, "oauth_token")], "oauth_token"
 }
 
+// tinyTLSConfig maps a hostname to the CA bundle to trust for it, for
+// testing AddHostTLSConfig.
+type tinyTLSConfig map[string]string
+
+func (c tinyTLSConfig) CABundle(host string) string          { return c[host] }
+func (c tinyTLSConfig) ClientCertificate(host string) string { return "" }
+func (c tinyTLSConfig) ClientKey(host string) string         { return "" }
+
+// tinyProxyConfig maps a hostname to the proxy URL to use for it, for
+// testing AddHostProxy.
+type tinyProxyConfig map[string]string
+
+func (c tinyProxyConfig) HTTPProxy(host string) string { return c[host] }
+
 var requestAtRE = regexp.MustCompile(`(?m)^\* Request at .+`)
 var dateRE = regexp.MustCompile(`(?m)^< Date: .+`)
 var hostWithPortRE = regexp.MustCompile(`127\.0\.0\.1:\d+`)
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -44,6 +45,12 @@ type Issue struct {
 	Milestone        *Milestone
 	ReactionGroups   ReactionGroups
 	IsPinned         bool
+	TrackedIssues    struct {
+		TotalCount int `json:"totalCount"`
+	}
+	TrackedInIssues struct {
+		TotalCount int `json:"totalCount"`
+	}
 }
 
 // return values for Issue.Typename
@@ -107,8 +114,9 @@ type ProjectV2Item struct {
 }
 
 type ProjectV2ItemProject struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Number int    `json:"number"`
 }
 
 type ProjectV2ItemStatus struct {
@@ -238,6 +246,53 @@ func IssueCreate(client *Client, repo *Repository, params map[string]interface{}
 	return issue, nil
 }
 
+// AddSubIssue adds the issue identified by subIssueNumber as a sub-issue of the issue
+// identified by issueNumber, using the REST sub-issues API. Both issues must belong to repo.
+func AddSubIssue(client *Client, repo ghrepo.Interface, issueNumber, subIssueNumber int) error {
+	subIssueID, err := issueDatabaseID(client, repo, subIssueNumber)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/sub_issues", repo.RepoOwner(), repo.RepoName(), issueNumber)
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]int64{"sub_issue_id": subIssueID}); err != nil {
+		return err
+	}
+
+	return client.REST(repo.RepoHost(), "POST", path, body, nil)
+}
+
+// RemoveSubIssue removes the issue identified by subIssueNumber as a sub-issue of the issue
+// identified by issueNumber, using the REST sub-issues API.
+func RemoveSubIssue(client *Client, repo ghrepo.Interface, issueNumber, subIssueNumber int) error {
+	subIssueID, err := issueDatabaseID(client, repo, subIssueNumber)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/issues/%d/sub_issue", repo.RepoOwner(), repo.RepoName(), issueNumber)
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]int64{"sub_issue_id": subIssueID}); err != nil {
+		return err
+	}
+
+	return client.REST(repo.RepoHost(), "DELETE", path, body, nil)
+}
+
+// issueDatabaseID resolves an issue number to the numeric database ID required by the REST
+// sub-issues API, which does not accept issue numbers directly.
+func issueDatabaseID(client *Client, repo ghrepo.Interface, issueNumber int) (int64, error) {
+	path := fmt.Sprintf("repos/%s/%s/issues/%d", repo.RepoOwner(), repo.RepoName(), issueNumber)
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return 0, fmt.Errorf("could not find issue #%d in %s: %w", issueNumber, ghrepo.FullName(repo), err)
+	}
+	return result.ID, nil
+}
+
 type IssueStatusOptions struct {
 	Username string
 	Fields   []string
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddSubIssue(t *testing.T) {
+	tests := []struct {
+		name        string
+		httpStubs   func(*httpmock.Registry)
+		expectError bool
+	}{
+		{
+			name: "success",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/issues/2"),
+					httpmock.StringResponse(`{"id": 987}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/issues/1/sub_issues"),
+					httpmock.RESTPayload(201, "{}", func(payload map[string]interface{}) {
+						assert.Equal(t, float64(987), payload["sub_issue_id"])
+					}))
+			},
+		},
+		{
+			name: "issue lookup error",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/issues/2"),
+					httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+
+			client := newTestClient(reg)
+			repo, _ := ghrepo.FromFullName("OWNER/REPO")
+
+			err := AddSubIssue(client, repo, 1, 2)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRemoveSubIssue(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/issues/2"),
+		httpmock.StringResponse(`{"id": 987}`))
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/issues/1/sub_issue"),
+		httpmock.RESTPayload(204, "", func(payload map[string]interface{}) {
+			assert.Equal(t, float64(987), payload["sub_issue_id"])
+		}))
+
+	client := newTestClient(reg)
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+
+	err := RemoveSubIssue(client, repo, 1, 2)
+	assert.NoError(t, err)
+}
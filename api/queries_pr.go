@@ -34,6 +34,7 @@ type PullRequest struct {
 	MergeStateStatus    string
 	IsInMergeQueue      bool
 	IsMergeQueueEnabled bool // Indicates whether the pull request's base ref has a merge queue enabled.
+	MergeQueueEntry     *MergeQueueEntry
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 	ClosedAt            *time.Time
@@ -83,6 +84,39 @@ type PullRequest struct {
 	Reviews        PullRequestReviews
 	LatestReviews  PullRequestReviews
 	ReviewRequests ReviewRequests
+	TimelineItems  struct {
+		Nodes []PullRequestTimelineItem
+	}
+}
+
+// PullRequestTimelineItem is a flattened view of a single node from a pull
+// request's timelineItems connection. Only the event types relevant to
+// tracking review churn (comments, reviews, review requests, labels, and
+// commits) are queried, so fields that don't apply to a given TypeName are
+// left at their zero value.
+type PullRequestTimelineItem struct {
+	TypeName  string    `json:"__typename"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LabeledEvent, UnlabeledEvent, ReviewRequestedEvent, ReviewRequestRemovedEvent
+	Actor Author `json:"actor"`
+
+	// IssueComment, PullRequestReview
+	Author Author `json:"author"`
+
+	// PullRequestReview
+	State string `json:"state"`
+
+	// LabeledEvent, UnlabeledEvent
+	Label struct {
+		Name string `json:"name"`
+	} `json:"label"`
+
+	// ReviewRequestedEvent, ReviewRequestRemovedEvent
+	RequestedReviewer RequestedReviewer `json:"requestedReviewer"`
+
+	// PullRequestCommit
+	Commit PullRequestCommitCommit `json:"commit"`
 }
 
 type StatusCheckRollupNode struct {
@@ -237,6 +271,11 @@ type Commit struct {
 	OID string `json:"oid"`
 }
 
+// MergeQueueEntry describes a pull request's position in its base ref's merge queue.
+type MergeQueueEntry struct {
+	Position int `json:"position"`
+}
+
 type PullRequestCommit struct {
 	Commit PullRequestCommitCommit
 }
@@ -258,9 +297,48 @@ type PullRequestCommitCommit struct {
 }
 
 type PullRequestFile struct {
-	Path      string `json:"path"`
-	Additions int    `json:"additions"`
-	Deletions int    `json:"deletions"`
+	Path      string  `json:"path"`
+	Additions int     `json:"additions"`
+	Deletions int     `json:"deletions"`
+	Patch     *string `json:"patch,omitempty"`
+}
+
+// maxPullRequestFilePatchSize is the largest patch, in bytes, that PullRequestFilesWithPatch
+// will attach to a file. Larger patches are left out to keep `--json files` output manageable.
+const maxPullRequestFilePatchSize = 20000
+
+// PullRequestFilesWithPatch fetches the unified diff hunk for each of a pull request's changed
+// files from the REST API and attaches it to the matching entry in files. Patches larger than
+// maxPullRequestFilePatchSize are left unset.
+func PullRequestFilesWithPatch(client *Client, repo ghrepo.Interface, prNumber int, files []PullRequestFile) error {
+	type restFile struct {
+		Filename string `json:"filename"`
+		Patch    string `json:"patch"`
+	}
+
+	patchesByPath := map[string]string{}
+	path := fmt.Sprintf("repos/%s/pulls/%d/files?per_page=100", ghrepo.FullName(repo), prNumber)
+	for path != "" {
+		var page []restFile
+		var err error
+		path, err = client.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return err
+		}
+		for _, f := range page {
+			patchesByPath[f.Filename] = f.Patch
+		}
+	}
+
+	for i := range files {
+		patch, ok := patchesByPath[files[i].Path]
+		if !ok || len(patch) > maxPullRequestFilePatchSize {
+			continue
+		}
+		files[i].Patch = &patch
+	}
+
+	return nil
 }
 
 type ReviewRequests struct {
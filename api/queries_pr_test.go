@@ -2,11 +2,13 @@ package api
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBranchDeleteRemote(t *testing.T) {
@@ -136,3 +138,42 @@ func Test_Logins(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestFilesWithPatch(t *testing.T) {
+	hugePatch := strings.Repeat("+x\n", maxPullRequestFilePatchSize)
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/1/files"),
+		httpmock.WithHeader(
+			httpmock.JSONResponse([]map[string]string{
+				{"filename": "small.go", "patch": "@@ -1 +1 @@\n-old\n+new\n"},
+			}),
+			"Link",
+			`<http://example.com/page/2>; rel="next"`,
+		),
+	)
+	http.Register(
+		httpmock.REST("GET", "page/2"),
+		httpmock.JSONResponse([]map[string]string{
+			{"filename": "huge.go", "patch": hugePatch},
+		}),
+	)
+
+	client := newTestClient(http)
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+	files := []PullRequestFile{
+		{Path: "small.go"},
+		{Path: "huge.go"},
+		{Path: "missing.go"},
+	}
+
+	err := PullRequestFilesWithPatch(client, repo, 1, files)
+	assert.NoError(t, err)
+
+	require.NotNil(t, files[0].Patch)
+	assert.Equal(t, "@@ -1 +1 @@\n-old\n+new\n", *files[0].Patch)
+	assert.Nil(t, files[1].Patch)
+	assert.Nil(t, files[2].Patch)
+}
@@ -71,8 +71,9 @@ func ProjectsV2ItemsForIssue(client *Client, repo ghrepo.Interface, issue *Issue
 	type projectV2Item struct {
 		ID      string `json:"id"`
 		Project struct {
-			ID    string `json:"id"`
-			Title string `json:"title"`
+			ID     string `json:"id"`
+			Title  string `json:"title"`
+			Number int    `json:"number"`
 		}
 		Status projectV2ItemStatus `graphql:"status:fieldValueByName(name: \"Status\")"`
 	}
@@ -107,8 +108,9 @@ func ProjectsV2ItemsForIssue(client *Client, repo ghrepo.Interface, issue *Issue
 			items.Nodes = append(items.Nodes, &ProjectV2Item{
 				ID: projectItemNode.ID,
 				Project: ProjectV2ItemProject{
-					ID:    projectItemNode.Project.ID,
-					Title: projectItemNode.Project.Title,
+					ID:     projectItemNode.Project.ID,
+					Title:  projectItemNode.Project.Title,
+					Number: projectItemNode.Project.Number,
 				},
 				Status: ProjectV2ItemStatus{
 					OptionID: projectItemNode.Status.StatusFragment.OptionID,
@@ -138,8 +140,9 @@ func ProjectsV2ItemsForPullRequest(client *Client, repo ghrepo.Interface, pr *Pu
 	type projectV2Item struct {
 		ID      string `json:"id"`
 		Project struct {
-			ID    string `json:"id"`
-			Title string `json:"title"`
+			ID     string `json:"id"`
+			Title  string `json:"title"`
+			Number int    `json:"number"`
 		}
 		Status projectV2ItemStatus `graphql:"status:fieldValueByName(name: \"Status\")"`
 	}
@@ -175,8 +178,9 @@ func ProjectsV2ItemsForPullRequest(client *Client, repo ghrepo.Interface, pr *Pu
 			items.Nodes = append(items.Nodes, &ProjectV2Item{
 				ID: projectItemNode.ID,
 				Project: ProjectV2ItemProject{
-					ID:    projectItemNode.Project.ID,
-					Title: projectItemNode.Project.Title,
+					ID:     projectItemNode.Project.ID,
+					Title:  projectItemNode.Project.Title,
+					Number: projectItemNode.Project.Number,
 				},
 				Status: ProjectV2ItemStatus{
 					OptionID: projectItemNode.Status.StatusFragment.OptionID,
@@ -201,7 +201,8 @@ func TestProjectsV2ItemsForPullRequest(t *testing.T) {
                                     "id": "PVTI_lADOB-vozM4AVk16zgK6U50",
                                     "project": {
                                       "id": "PVT_kwDOB-vozM4AVk16",
-                                      "title": "Test Project"
+                                      "title": "Test Project",
+                                      "number": 3
                                     },
                                     "status": {
                                       "optionId": "47fc9ee4",
@@ -230,8 +231,9 @@ func TestProjectsV2ItemsForPullRequest(t *testing.T) {
 					{
 						ID: "PVTI_lADOB-vozM4AVk16zgK6U50",
 						Project: ProjectV2ItemProject{
-							ID:    "PVT_kwDOB-vozM4AVk16",
-							Title: "Test Project",
+							ID:     "PVT_kwDOB-vozM4AVk16",
+							Title:  "Test Project",
+							Number: 3,
 						},
 						Status: ProjectV2ItemStatus{
 							OptionID: "47fc9ee4",
@@ -100,10 +100,7 @@ type Repository struct {
 	}
 	PrimaryLanguage *CodingLanguage
 	Languages       struct {
-		Edges []struct {
-			Size int            `json:"size"`
-			Node CodingLanguage `json:"node"`
-		}
+		Edges []RepositoryLanguageEdge
 	}
 	IssueTemplates       []IssueTemplate
 	PullRequestTemplates []PullRequestTemplate
@@ -125,10 +122,30 @@ type Repository struct {
 		Nodes []RepoProject
 	}
 
+	// ContributorCount and TopContributors are populated separately via the
+	// REST contributors endpoint rather than the GraphQL query above, since
+	// GitHub's GraphQL schema has no contributors field on Repository.
+	ContributorCount int
+	TopContributors  []RepositoryContributor
+
 	// pseudo-field that keeps track of host name of this repo
 	hostname string
 }
 
+// RepositoryLanguageEdge pairs a language with the number of bytes of code
+// written in it, as returned by the languages connection.
+type RepositoryLanguageEdge struct {
+	Size int            `json:"size"`
+	Node CodingLanguage `json:"node"`
+}
+
+// RepositoryContributor is a single entry from the REST contributors
+// endpoint, i.e. a user and how many commits they've contributed.
+type RepositoryContributor struct {
+	Login         string `json:"login"`
+	Contributions int    `json:"contributions"`
+}
+
 // RepositoryOwner is the owner of a GitHub repository
 type RepositoryOwner struct {
 	ID    string `json:"id"`
@@ -170,7 +187,8 @@ type FundingLink struct {
 }
 
 type CodingLanguage struct {
-	Name string `json:"name"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
 }
 
 type IssueTemplate struct {
@@ -353,6 +371,36 @@ func CanPushToRepo(httpClient *http.Client, repo ghrepo.Interface) (bool, error)
 	return r.ViewerCanPush(), nil
 }
 
+// BranchProtectionRule describes the branch protection settings, if any, that apply to a ref.
+type BranchProtectionRule struct {
+	RequiresApprovingReviews bool
+	RequiresStatusChecks     bool
+}
+
+// RepoBranchProtectionRule looks up the branch protection rule, if any, that applies to the given
+// branch. It returns a nil rule when the branch is unprotected.
+func RepoBranchProtectionRule(client *Client, repo ghrepo.Interface, branch string) (*BranchProtectionRule, error) {
+	var query struct {
+		Repository struct {
+			Ref struct {
+				BranchProtectionRule *BranchProtectionRule
+			} `graphql:"ref(qualifiedName: $branch)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(repo.RepoOwner()),
+		"name":   githubv4.String(repo.RepoName()),
+		"branch": githubv4.String(branch),
+	}
+
+	if err := client.Query(repo.RepoHost(), "PullRequestBaseBranchProtection", &query, variables); err != nil {
+		return nil, err
+	}
+
+	return query.Repository.Ref.BranchProtectionRule, nil
+}
+
 // RepoParent finds out the parent repository of a fork
 func RepoParent(client *Client, repo ghrepo.Interface) (ghrepo.Interface, error) {
 	var query struct {
@@ -664,6 +712,7 @@ type RepoMetadataResult struct {
 
 func (m *RepoMetadataResult) MembersToIDs(names []string) ([]string, error) {
 	var ids []string
+	var missing []string
 	for _, assigneeLogin := range names {
 		found := false
 		for _, u := range m.AssignableUsers {
@@ -674,14 +723,18 @@ func (m *RepoMetadataResult) MembersToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", assigneeLogin)
+			missing = append(missing, assigneeLogin)
 		}
 	}
+	if len(missing) > 0 {
+		return nil, notFoundError(missing)
+	}
 	return ids, nil
 }
 
 func (m *RepoMetadataResult) TeamsToIDs(names []string) ([]string, error) {
 	var ids []string
+	var missing []string
 	for _, teamSlug := range names {
 		found := false
 		slug := teamSlug[strings.IndexRune(teamSlug, '/')+1:]
@@ -693,14 +746,18 @@ func (m *RepoMetadataResult) TeamsToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", teamSlug)
+			missing = append(missing, teamSlug)
 		}
 	}
+	if len(missing) > 0 {
+		return nil, notFoundError(missing)
+	}
 	return ids, nil
 }
 
 func (m *RepoMetadataResult) LabelsToIDs(names []string) ([]string, error) {
 	var ids []string
+	var missing []string
 	for _, labelName := range names {
 		found := false
 		for _, l := range m.Labels {
@@ -711,12 +768,29 @@ func (m *RepoMetadataResult) LabelsToIDs(names []string) ([]string, error) {
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("'%s' not found", labelName)
+			missing = append(missing, labelName)
 		}
 	}
+	if len(missing) > 0 {
+		return nil, notFoundError(missing)
+	}
 	return ids, nil
 }
 
+// notFoundError reports one or more names that couldn't be resolved to an
+// ID as a single error, rather than only the first miss, so that e.g. `gh
+// issue edit --add-label one --add-label two` names every bad label at once.
+func notFoundError(names []string) error {
+	if len(names) == 1 {
+		return fmt.Errorf("'%s' not found", names[0])
+	}
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = fmt.Sprintf("'%s'", n)
+	}
+	return fmt.Errorf("%s not found", strings.Join(quoted, ", "))
+}
+
 // ProjectsToIDs returns two arrays:
 // - the first contains IDs of projects V1
 // - the second contains IDs of projects V2
@@ -808,13 +882,35 @@ func ProjectsToPaths(projects []RepoProject, projectsV2 []ProjectV2, names []str
 	return paths, nil
 }
 
+// MilestoneToID resolves a milestone name to its ID. An exact,
+// case-insensitive match is preferred; otherwise, if the given title is a
+// substring of exactly one open milestone's title, that milestone is used.
+// An ambiguous partial match is an error.
 func (m *RepoMetadataResult) MilestoneToID(title string) (string, error) {
-	for _, m := range m.Milestones {
-		if strings.EqualFold(title, m.Title) {
-			return m.ID, nil
+	for _, ms := range m.Milestones {
+		if strings.EqualFold(title, ms.Title) {
+			return ms.ID, nil
+		}
+	}
+
+	var matches []RepoMilestone
+	for _, ms := range m.Milestones {
+		if strings.Contains(strings.ToLower(ms.Title), strings.ToLower(title)) {
+			matches = append(matches, ms)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("'%s' not found", title)
+	case 1:
+		return matches[0].ID, nil
+	default:
+		var titles []string
+		for _, ms := range matches {
+			titles = append(titles, fmt.Sprintf("%q", ms.Title))
 		}
+		return "", fmt.Errorf("'%s' matches multiple milestones: %s", title, strings.Join(titles, ", "))
 	}
-	return "", fmt.Errorf("'%s' not found", title)
 }
 
 func (m *RepoMetadataResult) Merge(m2 *RepoMetadataResult) {
@@ -961,14 +1057,34 @@ func RepoResolveMetadataIDs(client *Client, repo ghrepo.Interface, input RepoRes
 		return result, nil
 	}
 
+	err = resolveMetadataIDsBatched(client, repo, users, teams, input.Labels, result)
+	var gqlErr GraphQLError
+	if errors.As(err, &gqlErr) {
+		// Some GitHub Enterprise Server versions reject the combined,
+		// aliased query during schema validation; fall back to one smaller
+		// query per entity type rather than failing the whole lookup.
+		err = resolveMetadataIDsPerType(client, repo, users, teams, input.Labels, result)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// resolveMetadataIDsBatched resolves users, teams, and labels by name in a
+// single GraphQL request using aliased fields, so that e.g. `gh issue edit
+// --add-label a --add-label b --add-assignee x` costs one round trip instead
+// of one per entity type.
+func resolveMetadataIDsBatched(client *Client, repo ghrepo.Interface, users, teams, labels []string, result *RepoMetadataResult) error {
 	query := &bytes.Buffer{}
 	fmt.Fprint(query, "query RepositoryResolveMetadataIDs {\n")
 	for i, u := range users {
 		fmt.Fprintf(query, "u%03d: user(login:%q){id,login}\n", i, u)
 	}
-	if len(input.Labels) > 0 {
+	if len(labels) > 0 {
 		fmt.Fprintf(query, "repository(owner:%q,name:%q){\n", repo.RepoOwner(), repo.RepoName())
-		for i, l := range input.Labels {
+		for i, l := range labels {
 			fmt.Fprintf(query, "l%03d: label(name:%q){id,name}\n", i, l)
 		}
 		fmt.Fprint(query, "}\n")
@@ -983,42 +1099,116 @@ func RepoResolveMetadataIDs(client *Client, repo ghrepo.Interface, input RepoRes
 	fmt.Fprint(query, "}\n")
 
 	response := make(map[string]json.RawMessage)
-	err = client.GraphQL(repo.RepoHost(), query.String(), nil, &response)
-	if err != nil {
-		return result, err
+	if err := client.GraphQL(repo.RepoHost(), query.String(), nil, &response); err != nil {
+		return err
 	}
 
 	for key, v := range response {
 		switch key {
 		case "repository":
 			repoResponse := make(map[string]RepoLabel)
-			err := json.Unmarshal(v, &repoResponse)
-			if err != nil {
-				return result, err
+			if err := json.Unmarshal(v, &repoResponse); err != nil {
+				return err
 			}
 			for _, l := range repoResponse {
 				result.Labels = append(result.Labels, l)
 			}
 		case "organization":
 			orgResponse := make(map[string]OrgTeam)
-			err := json.Unmarshal(v, &orgResponse)
-			if err != nil {
-				return result, err
+			if err := json.Unmarshal(v, &orgResponse); err != nil {
+				return err
 			}
 			for _, t := range orgResponse {
 				result.Teams = append(result.Teams, t)
 			}
 		default:
 			user := RepoAssignee{}
-			err := json.Unmarshal(v, &user)
-			if err != nil {
-				return result, err
+			if err := json.Unmarshal(v, &user); err != nil {
+				return err
 			}
 			result.AssignableUsers = append(result.AssignableUsers, user)
 		}
 	}
 
-	return result, nil
+	return nil
+}
+
+// resolveMetadataIDsPerType is the fallback for GitHub Enterprise Server
+// versions whose GraphQL schema rejects the combined query that
+// resolveMetadataIDsBatched sends; it resolves each entity type with its own
+// aliased query instead.
+func resolveMetadataIDsPerType(client *Client, repo ghrepo.Interface, users, teams, labels []string, result *RepoMetadataResult) error {
+	result.AssignableUsers = nil
+	result.Labels = nil
+	result.Teams = nil
+
+	var g errgroup.Group
+
+	if len(users) > 0 {
+		g.Go(func() error {
+			query := &bytes.Buffer{}
+			fmt.Fprint(query, "query RepositoryResolveUserIDs {\n")
+			for i, u := range users {
+				fmt.Fprintf(query, "u%03d: user(login:%q){id,login}\n", i, u)
+			}
+			fmt.Fprint(query, "}\n")
+
+			response := make(map[string]RepoAssignee)
+			if err := client.GraphQL(repo.RepoHost(), query.String(), nil, &response); err != nil {
+				return err
+			}
+			for _, u := range response {
+				result.AssignableUsers = append(result.AssignableUsers, u)
+			}
+			return nil
+		})
+	}
+
+	if len(labels) > 0 {
+		g.Go(func() error {
+			query := &bytes.Buffer{}
+			fmt.Fprintf(query, "query RepositoryResolveLabelIDs {\nrepository(owner:%q,name:%q){\n", repo.RepoOwner(), repo.RepoName())
+			for i, l := range labels {
+				fmt.Fprintf(query, "l%03d: label(name:%q){id,name}\n", i, l)
+			}
+			fmt.Fprint(query, "}\n}\n")
+
+			var response struct {
+				Repository map[string]RepoLabel
+			}
+			if err := client.GraphQL(repo.RepoHost(), query.String(), nil, &response); err != nil {
+				return err
+			}
+			for _, l := range response.Repository {
+				result.Labels = append(result.Labels, l)
+			}
+			return nil
+		})
+	}
+
+	if len(teams) > 0 {
+		g.Go(func() error {
+			query := &bytes.Buffer{}
+			fmt.Fprintf(query, "query RepositoryResolveTeamIDs {\norganization(login:%q){\n", repo.RepoOwner())
+			for i, t := range teams {
+				fmt.Fprintf(query, "t%03d: team(slug:%q){id,slug}\n", i, t)
+			}
+			fmt.Fprint(query, "}\n}\n")
+
+			var response struct {
+				Organization map[string]OrgTeam
+			}
+			if err := client.GraphQL(repo.RepoHost(), query.String(), nil, &response); err != nil {
+				return err
+			}
+			for _, t := range response.Organization {
+				result.Teams = append(result.Teams, t)
+			}
+			return nil
+		})
+	}
+
+	return g.Wait()
 }
 
 type RepoProject struct {
@@ -384,6 +384,61 @@ t001: team(slug:"robots"){id,slug}
 	}
 }
 
+// Test_RepoResolveMetadataIDs_fallback covers the case where the combined,
+// aliased query is rejected by the GraphQL schema validator -- as seen on
+// some GitHub Enterprise Server versions -- and the lookup falls back to one
+// smaller query per entity type.
+func Test_RepoResolveMetadataIDs_fallback(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := newTestClient(http)
+
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+	input := RepoResolveInput{
+		Assignees: []string{"monalisa"},
+		Labels:    []string{"bug"},
+		Reviewers: []string{"OWNER/core"},
+	}
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`{"data":{}, "errors": [{"message": "some gql error"}]}`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveUserIDs\b`),
+		httpmock.StringResponse(`{ "data": { "u000": { "login": "MonaLisa", "id": "MONAID" } } }`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveLabelIDs\b`),
+		httpmock.StringResponse(`{ "data": { "repository": { "l000": { "name": "bug", "id": "BUGID" } } } }`))
+	http.Register(
+		httpmock.GraphQL(`query RepositoryResolveTeamIDs\b`),
+		httpmock.StringResponse(`{ "data": { "organization": { "t000": { "slug": "core", "id": "COREID" } } } }`))
+
+	result, err := RepoResolveMetadataIDs(client, repo, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	memberIDs, err := result.MembersToIDs([]string{"monalisa"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MONAID"}, memberIDs)
+
+	labelIDs, err := result.LabelsToIDs([]string{"bug"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BUGID"}, labelIDs)
+
+	teamIDs, err := result.TeamsToIDs([]string{"OWNER/core"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"COREID"}, teamIDs)
+}
+
+func TestLabelsToIDs_multipleNotFound(t *testing.T) {
+	result := &RepoMetadataResult{
+		Labels: []RepoLabel{{Name: "bug", ID: "BUGID"}},
+	}
+
+	_, err := result.LabelsToIDs([]string{"bug", "enhancement", "docs"})
+	require.EqualError(t, err, "'enhancement', 'docs' not found")
+}
+
 func sliceEqual(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -446,6 +501,28 @@ func Test_RepoMilestones(t *testing.T) {
 	}
 }
 
+func TestMilestoneToID(t *testing.T) {
+	result := &RepoMetadataResult{
+		Milestones: []RepoMilestone{
+			{Title: "GA", ID: "GAID"},
+			{Title: "Big One.oh", ID: "BIGONEID"},
+			{Title: "Big Two.oh", ID: "BIGTWOID"},
+		},
+	}
+
+	id, err := result.MilestoneToID("one.oh")
+	require.NoError(t, err)
+	assert.Equal(t, "BIGONEID", id)
+
+	_, err = result.MilestoneToID("big")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Big One.oh")
+	assert.Contains(t, err.Error(), "Big Two.oh")
+
+	_, err = result.MilestoneToID("nonexistent")
+	require.EqualError(t, err, "'nonexistent' not found")
+}
+
 func TestDisplayName(t *testing.T) {
 	tests := []struct {
 		name     string
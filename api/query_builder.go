@@ -132,6 +132,22 @@ var prCommits = shortenQuery(`
 	}
 `)
 
+var prTimelineItems = shortenQuery(`
+	timelineItems(first: 100, itemTypes: [LABELED_EVENT, UNLABELED_EVENT, REVIEW_REQUESTED_EVENT, REVIEW_REQUEST_REMOVED_EVENT, PULL_REQUEST_REVIEW, PULL_REQUEST_COMMIT, ISSUE_COMMENT]) {
+		nodes {
+			__typename,
+			...on LabeledEvent{actor{login},createdAt,label{name}},
+			...on UnlabeledEvent{actor{login},createdAt,label{name}},
+			...on ReviewRequestedEvent{actor{login},createdAt,requestedReviewer{__typename,...on User{login},...on Team{name,slug}}},
+			...on ReviewRequestRemovedEvent{actor{login},createdAt,requestedReviewer{__typename,...on User{login},...on Team{name,slug}}},
+			...on PullRequestReview{author{login},createdAt,state},
+			...on PullRequestCommit{commit{oid,committedDate}},
+			...on IssueComment{author{login},createdAt}
+		},
+		totalCount
+	}
+`)
+
 var autoMergeRequest = shortenQuery(`
 	autoMergeRequest {
 		authorEmail,
@@ -258,6 +274,7 @@ var IssueFields = []string{
 	"createdAt",
 	"closedAt",
 	"id",
+	"isPinned",
 	"labels",
 	"milestone",
 	"number",
@@ -266,6 +283,8 @@ var IssueFields = []string{
 	"reactionGroups",
 	"state",
 	"title",
+	"trackedInIssues",
+	"trackedIssues",
 	"updatedAt",
 	"url",
 }
@@ -291,11 +310,13 @@ var PullRequestFields = append(IssueFields,
 	"mergedAt",
 	"mergedBy",
 	"mergeStateStatus",
+	"mergeQueueEntry",
 	"potentialMergeCommit",
 	"reviewDecision",
 	"reviewRequests",
 	"reviews",
 	"statusCheckRollup",
+	"timelineItems",
 )
 
 // IssueGraphQL constructs a GraphQL query fragment for a set of issue fields.
@@ -318,15 +339,21 @@ func IssueGraphQL(fields []string) string {
 		case "projectCards":
 			q = append(q, `projectCards(first:100){nodes{project{name}column{name}},totalCount}`)
 		case "projectItems":
-			q = append(q, `projectItems(first:100){nodes{id, project{id,title}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`)
+			q = append(q, `projectItems(first:100){nodes{id, project{id,title,number}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`)
 		case "milestone":
 			q = append(q, `milestone{number,title,description,dueOn}`)
 		case "reactionGroups":
 			q = append(q, `reactionGroups{content,users{totalCount}}`)
+		case "trackedIssues":
+			q = append(q, `trackedIssues{totalCount}`)
+		case "trackedInIssues":
+			q = append(q, `trackedInIssues{totalCount}`)
 		case "mergeCommit":
 			q = append(q, `mergeCommit{oid}`)
 		case "potentialMergeCommit":
 			q = append(q, `potentialMergeCommit{oid}`)
+		case "mergeQueueEntry":
+			q = append(q, `mergeQueueEntry{position}`)
 		case "autoMergeRequest":
 			q = append(q, autoMergeRequest)
 		case "comments":
@@ -353,6 +380,8 @@ func IssueGraphQL(fields []string) string {
 			q = append(q, StatusCheckRollupGraphQLWithoutCountByState(""))
 		case "statusCheckRollupWithCountByState": // pseudo-field
 			q = append(q, StatusCheckRollupGraphQLWithCountByState())
+		case "timelineItems":
+			q = append(q, prTimelineItems)
 		default:
 			q = append(q, field)
 		}
@@ -474,9 +503,9 @@ func RepositoryGraphQL(fields []string) string {
 		case "labels":
 			q = append(q, "labels(first:100){nodes{id,color,name,description}}")
 		case "languages":
-			q = append(q, "languages(first:100){edges{size,node{name}}}")
+			q = append(q, "languages(first:100,orderBy:{field:SIZE,direction:DESC}){edges{size,node{name,color}}}")
 		case "primaryLanguage":
-			q = append(q, "primaryLanguage{name}")
+			q = append(q, "primaryLanguage{name,color}")
 		case "latestRelease":
 			q = append(q, "latestRelease{publishedAt,tagName,name,url}")
 		case "milestones":
@@ -36,7 +36,7 @@ func TestPullRequestGraphQL(t *testing.T) {
 		{
 			name:   "projectItems",
 			fields: []string{"projectItems"},
-			want:   `projectItems(first:100){nodes{id, project{id,title}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`,
+			want:   `projectItems(first:100){nodes{id, project{id,title,number}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`,
 		},
 	}
 	for _, tt := range tests {
@@ -77,7 +77,7 @@ func TestIssueGraphQL(t *testing.T) {
 		{
 			name:   "projectItems",
 			fields: []string{"projectItems"},
-			want:   `projectItems(first:100){nodes{id, project{id,title}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`,
+			want:   `projectItems(first:100){nodes{id, project{id,title,number}, status:fieldValueByName(name: "Status") { ... on ProjectV2ItemFieldSingleSelectValue{optionId,name}}},totalCount}`,
 		},
 	}
 	for _, tt := range tests {
@@ -65,7 +65,9 @@ func mainRun() exitCode {
 		}
 	}
 
-	updateCtx, updateCancel := context.WithCancel(ctx)
+	// give the update check a hard deadline so a slow or unreachable network
+	// never delays command output
+	updateCtx, updateCancel := context.WithTimeout(ctx, 3*time.Second)
 	defer updateCancel()
 	updateMessageChan := make(chan *update.ReleaseInfo)
 	go func() {
@@ -210,6 +212,12 @@ func printError(out io.Writer, err error, cmd *cobra.Command, debug bool) {
 		return
 	}
 
+	var flagRequiredErr *cmdutil.FlagRequiredInNonInteractiveError
+	if errors.As(err, &flagRequiredErr) {
+		fmt.Fprintln(out, err)
+		return
+	}
+
 	fmt.Fprintln(out, err)
 
 	var flagError *cmdutil.FlagError
@@ -250,9 +258,17 @@ func checkForUpdate(ctx context.Context, f *cmdutil.Factory, currentVersion stri
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	channel := cfg.UpdateReleaseChannel()
+	if channel == "" {
+		channel = update.ChannelStable
+	}
 	repo := updaterEnabled
 	stateFilePath := filepath.Join(config.StateDir(), "state.yml")
-	return update.CheckForUpdate(ctx, httpClient, stateFilePath, repo, currentVersion)
+	return update.CheckForUpdate(ctx, httpClient, stateFilePath, repo, currentVersion, channel)
 }
 
 func isRecentRelease(publishedAt time.Time) bool {
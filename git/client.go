@@ -309,6 +309,14 @@ func (c *Client) CommitBody(ctx context.Context, sha string) (string, error) {
 	return string(output), err
 }
 
+// SignatureStatus reports the signature verification status of a commit,
+// corresponding to git's `%G?` pretty-format placeholder (e.g. "G" for a
+// good signature, "N" for no signature).
+func (c *Client) SignatureStatus(ctx context.Context, sha string) (string, error) {
+	output, err := c.lookupCommit(ctx, sha, "%G?")
+	return string(output), err
+}
+
 func (c *Client) lookupCommit(ctx context.Context, sha, format string) ([]byte, error) {
 	args := []string{"-c", "log.ShowSignature=false", "show", "-s", "--pretty=format:" + format, sha}
 	cmd, err := c.Command(ctx, args...)
@@ -508,6 +516,19 @@ func (c *Client) SetRemoteBranches(ctx context.Context, remote string, refspec s
 	return nil
 }
 
+func (c *Client) SparseCheckoutSet(ctx context.Context, paths []string) error {
+	args := append([]string{"sparse-checkout", "set"}, paths...)
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *Client) AddRemote(ctx context.Context, name, urlStr string, trackingBranches []string) (*Remote, error) {
 	args := []string{"remote", "add"}
 	for _, branch := range trackingBranches {
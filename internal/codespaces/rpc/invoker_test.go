@@ -177,8 +177,10 @@ func TestRebuildContainerIncremental(t *testing.T) {
 		RebuildContainer: true,
 	}
 
+	var gotRequest *codespace.RebuildContainerRequest
 	server := newMockServer()
-	server.RebuildContainerAsyncFunc = func(context.Context, *codespace.RebuildContainerRequest) (*codespace.RebuildContainerResponse, error) {
+	server.RebuildContainerAsyncFunc = func(_ context.Context, req *codespace.RebuildContainerRequest) (*codespace.RebuildContainerResponse, error) {
+		gotRequest = req
 		return &resp, nil
 	}
 
@@ -193,6 +195,10 @@ func TestRebuildContainerIncremental(t *testing.T) {
 		t.Fatalf("expected %v, got %v", nil, err)
 	}
 
+	if gotRequest.GetIncremental() != true {
+		t.Fatalf("expected an incremental rebuild request, got full rebuild")
+	}
+
 	verifyNotifyCodespaceOfClientActivity(t, server)
 }
 
@@ -202,8 +208,10 @@ func TestRebuildContainerFull(t *testing.T) {
 		RebuildContainer: true,
 	}
 
+	var gotRequest *codespace.RebuildContainerRequest
 	server := newMockServer()
-	server.RebuildContainerAsyncFunc = func(context.Context, *codespace.RebuildContainerRequest) (*codespace.RebuildContainerResponse, error) {
+	server.RebuildContainerAsyncFunc = func(_ context.Context, req *codespace.RebuildContainerRequest) (*codespace.RebuildContainerResponse, error) {
+		gotRequest = req
 		return &resp, nil
 	}
 
@@ -218,6 +226,10 @@ func TestRebuildContainerFull(t *testing.T) {
 		t.Fatalf("expected %v, got %v", nil, err)
 	}
 
+	if gotRequest.GetIncremental() != false {
+		t.Fatalf("expected a full rebuild request, got incremental rebuild")
+	}
+
 	verifyNotifyCodespaceOfClientActivity(t, server)
 }
 
@@ -7,24 +7,39 @@ import (
 	"path/filepath"
 	"slices"
 
-	"github.com/cli/cli/v2/internal/keyring"
 	ghAuth "github.com/cli/go-gh/v2/pkg/auth"
 	ghConfig "github.com/cli/go-gh/v2/pkg/config"
 )
 
 const (
-	aliasesKey        = "aliases"
-	browserKey        = "browser"
-	editorKey         = "editor"
-	gitProtocolKey    = "git_protocol"
-	hostsKey          = "hosts"
-	httpUnixSocketKey = "http_unix_socket"
-	oauthTokenKey     = "oauth_token"
-	pagerKey          = "pager"
-	promptKey         = "prompt"
-	userKey           = "user"
-	usersKey          = "users"
-	versionKey        = "version"
+	aliasesKey                  = "aliases"
+	browserKey                  = "browser"
+	caBundleKey                 = "ca_bundle"
+	clientCertificateKey        = "client_certificate"
+	clientKeyKey                = "client_key"
+	colorThemeKey               = "color_theme"
+	completionNetworkKey        = "completion_network"
+	credentialBackendKey        = "credential_backend"
+	credentialHelperKey         = "credential_helper"
+	displayTruncate             = "display.truncate"
+	displayHyperlinks           = "display.hyperlinks"
+	displayTimestamps           = "display.timestamps"
+	editorKey                   = "editor"
+	glamourStyleKey             = "glamour_style"
+	gitProtocolKey              = "git_protocol"
+	hostsKey                    = "hosts"
+	httpProxyKey                = "http_proxy"
+	httpUnixSocketKey           = "http_unix_socket"
+	oauthTokenKey               = "oauth_token"
+	pagerKey                    = "pager"
+	prCheckoutBranchTemplateKey = "pr_checkout_branch_template"
+	promptKey                   = "prompt"
+	requestTimeoutKey           = "request_timeout"
+	statusExcludeKey            = "status_exclude"
+	updateReleaseChannelKey     = "update_release_channel"
+	userKey                     = "user"
+	usersKey                    = "users"
+	versionKey                  = "version"
 )
 
 // This interface describes interacting with some persistent configuration for gh.
@@ -39,11 +54,27 @@ type Config interface {
 	Aliases() *AliasConfig
 	Authentication() *AuthConfig
 	Browser(string) string
+	CABundle(string) string
+	ClientCertificate(string) string
+	ClientKey(string) string
+	ColorTheme(string) string
+	CompletionNetwork(string) string
+	CredentialBackend() string
+	CredentialHelper() string
+	DisplayTruncate(string) string
+	DisplayHyperlinks(string) string
+	DisplayTimestamps(string) string
 	Editor(string) string
+	GlamourStyle(string) string
 	GitProtocol(string) string
+	HTTPProxy(string) string
 	HTTPUnixSocket(string) string
 	Pager(string) string
+	PRCheckoutBranchTemplate(string) string
 	Prompt(string) string
+	RequestTimeout(string) string
+	StatusExclude(string) string
+	UpdateReleaseChannel() string
 	Version() string
 }
 
@@ -126,7 +157,7 @@ func (c *cfg) Aliases() *AliasConfig {
 }
 
 func (c *cfg) Authentication() *AuthConfig {
-	return &AuthConfig{cfg: c.cfg}
+	return &AuthConfig{cfg: c.cfg, credentialBackend: credentialBackendFor(c)}
 }
 
 func (c *cfg) Browser(hostname string) string {
@@ -139,11 +170,91 @@ func (c *cfg) Editor(hostname string) string {
 	return val
 }
 
+// CABundle returns the path to a PEM-encoded certificate bundle to trust as a
+// root CA when connecting to hostname, in addition to the system trust store.
+// This is intended for GitHub Enterprise Server instances behind an internal CA.
+func (c *cfg) CABundle(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, caBundleKey)
+	return val
+}
+
+// ClientCertificate returns the path to a PEM-encoded client certificate to
+// present when connecting to hostname. Must be set together with ClientKey.
+func (c *cfg) ClientCertificate(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, clientCertificateKey)
+	return val
+}
+
+// ClientKey returns the path to the PEM-encoded private key for ClientCertificate.
+func (c *cfg) ClientKey(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, clientKeyKey)
+	return val
+}
+
+func (c *cfg) ColorTheme(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, colorThemeKey)
+	return val
+}
+
+// CompletionNetwork reports whether shell completion is allowed to make
+// network requests, e.g. to suggest open pull requests or repository labels.
+// This is a global config that cannot be overridden by hostname.
+// Supported values: enabled, disabled
+func (c *cfg) CompletionNetwork(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, completionNetworkKey)
+	return val
+}
+
+// CredentialBackend reports where OAuth tokens are persisted outside of the
+// plaintext config file. This is a global config that cannot be overridden
+// by hostname. Supported values: keyring, file, command
+func (c *cfg) CredentialBackend() string {
+	val, _ := c.GetOrDefault("", credentialBackendKey)
+	return val
+}
+
+// CredentialHelper returns the executable invoked to get, store, and erase
+// secrets when CredentialBackend is "command". This is a global config that
+// cannot be overridden by hostname.
+func (c *cfg) CredentialHelper() string {
+	val, _ := c.GetOrDefault("", credentialHelperKey)
+	return val
+}
+
+func (c *cfg) DisplayTruncate(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, displayTruncate)
+	return val
+}
+
+func (c *cfg) DisplayHyperlinks(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, displayHyperlinks)
+	return val
+}
+
+func (c *cfg) DisplayTimestamps(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, displayTimestamps)
+	return val
+}
+
+func (c *cfg) GlamourStyle(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, glamourStyleKey)
+	return val
+}
+
 func (c *cfg) GitProtocol(hostname string) string {
 	val, _ := c.GetOrDefault(hostname, gitProtocolKey)
 	return val
 }
 
+// HTTPProxy returns the proxy URL to use for requests to hostname, accepting
+// http, https, and socks5 schemes (with optional userinfo for proxy auth).
+// Blank means no per-host proxy is configured and environment proxy
+// variables (HTTPS_PROXY, NO_PROXY, etc.) apply as usual.
+func (c *cfg) HTTPProxy(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, httpProxyKey)
+	return val
+}
+
 func (c *cfg) HTTPUnixSocket(hostname string) string {
 	val, _ := c.GetOrDefault(hostname, httpUnixSocketKey)
 	return val
@@ -154,11 +265,40 @@ func (c *cfg) Pager(hostname string) string {
 	return val
 }
 
+func (c *cfg) PRCheckoutBranchTemplate(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, prCheckoutBranchTemplateKey)
+	return val
+}
+
 func (c *cfg) Prompt(hostname string) string {
 	val, _ := c.GetOrDefault(hostname, promptKey)
 	return val
 }
 
+// RequestTimeout returns the duration string to bound how long a single HTTP
+// request is allowed to run before it's canceled, e.g. "30s". Blank means no
+// timeout. This can be overridden per-request with the GH_REQUEST_TIMEOUT
+// environment variable.
+func (c *cfg) RequestTimeout(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, requestTimeoutKey)
+	return val
+}
+
+// StatusExclude returns the comma-separated list of repositories, in
+// owner/name format, to exclude from `gh status` by default.
+func (c *cfg) StatusExclude(hostname string) string {
+	val, _ := c.GetOrDefault(hostname, statusExcludeKey)
+	return val
+}
+
+// UpdateReleaseChannel reports which release channel the update checker
+// should look for new versions in. This is a global config that cannot be
+// overridden by hostname.
+func (c *cfg) UpdateReleaseChannel() string {
+	val, _ := c.GetOrDefault("", updateReleaseChannelKey)
+	return val
+}
+
 func (c *cfg) Version() string {
 	val, _ := c.GetOrDefault("", versionKey)
 	return val
@@ -205,11 +345,22 @@ func defaultFor(key string) (string, bool) {
 // Behavior is scoped to authentication specific tasks.
 type AuthConfig struct {
 	cfg                 *ghConfig.Config
+	credentialBackend   credentialBackend
 	defaultHostOverride func() (string, string)
 	hostsOverride       func() []string
 	tokenOverride       func(string) (string, string)
 }
 
+// secureStorage returns the credentialBackend used to read and write
+// encrypted tokens, defaulting to the OS keyring for an AuthConfig that was
+// constructed without going through cfg.Authentication().
+func (c *AuthConfig) secureStorage() credentialBackend {
+	if c.credentialBackend != nil {
+		return c.credentialBackend
+	}
+	return keyringBackend{}
+}
+
 // ActiveToken will retrieve the active auth token for the given hostname,
 // searching environment variables, plain text config, and
 // lastly encrypted storage.
@@ -260,7 +411,7 @@ func (c *AuthConfig) SetActiveToken(token, source string) {
 // TokenFromKeyring will retrieve the auth token for the given hostname,
 // only searching in encrypted storage.
 func (c *AuthConfig) TokenFromKeyring(hostname string) (string, error) {
-	return keyring.Get(keyringServiceName(hostname), "")
+	return c.secureStorage().Get(keyringServiceName(hostname), "")
 }
 
 // TokenFromKeyringForUser will retrieve the auth token for the given hostname
@@ -274,7 +425,7 @@ func (c *AuthConfig) TokenFromKeyringForUser(hostname, username string) (string,
 		return "", errors.New("username cannot be blank")
 	}
 
-	return keyring.Get(keyringServiceName(hostname), username)
+	return c.secureStorage().Get(keyringServiceName(hostname), username)
 }
 
 // ActiveUser will retrieve the username for the active user at the given hostname.
@@ -321,7 +472,7 @@ func (c *AuthConfig) Login(hostname, username, token, gitProtocol string, secure
 	var setErr error
 	if secureStorage {
 		// Try to set the token for this user in the encrypted storage for later switching
-		setErr = keyring.Set(keyringServiceName(hostname), username, token)
+		setErr = c.secureStorage().Set(keyringServiceName(hostname), username, token)
 		if setErr == nil {
 			// Clean up the previous oauth_token from the config file, if there were one
 			_ = c.cfg.Remove([]string{hostsKey, hostname, usersKey, username, oauthTokenKey})
@@ -370,7 +521,7 @@ func (c *AuthConfig) SwitchUser(hostname, user string) error {
 		// to its previous clean state just in case something else tries to make use of the config, or tries
 		// to write it again.
 		if previousSource == "keyring" {
-			if setErr := keyring.Set(keyringServiceName(hostname), "", previouslyActiveToken); setErr != nil {
+			if setErr := c.secureStorage().Set(keyringServiceName(hostname), "", previouslyActiveToken); setErr != nil {
 				err = errors.Join(err, setErr)
 			}
 		}
@@ -395,8 +546,8 @@ func (c *AuthConfig) Logout(hostname, username string) error {
 	// and unset the keyring tokens.
 	if len(users) < 2 {
 		_ = c.cfg.Remove([]string{hostsKey, hostname})
-		_ = keyring.Delete(keyringServiceName(hostname), "")
-		_ = keyring.Delete(keyringServiceName(hostname), username)
+		_ = c.secureStorage().Delete(keyringServiceName(hostname), "")
+		_ = c.secureStorage().Delete(keyringServiceName(hostname), username)
 		return ghConfig.Write(c.cfg)
 	}
 
@@ -422,7 +573,7 @@ func (c *AuthConfig) Logout(hostname, username string) error {
 
 func (c *AuthConfig) activateUser(hostname, user string) error {
 	// We first need to idempotently clear out any set tokens for the host
-	_ = keyring.Delete(keyringServiceName(hostname), "")
+	_ = c.secureStorage().Delete(keyringServiceName(hostname), "")
 	_ = c.cfg.Remove([]string{hostsKey, hostname, oauthTokenKey})
 
 	// Then we'll move the keyring token or insecure token as necessary, only one of the
@@ -430,8 +581,8 @@ func (c *AuthConfig) activateUser(hostname, user string) error {
 
 	// If there is a token in the secure keyring for the user, move it to the active slot
 	var tokenSwitched bool
-	if token, err := keyring.Get(keyringServiceName(hostname), user); err == nil {
-		if err = keyring.Set(keyringServiceName(hostname), "", token); err != nil {
+	if token, err := c.secureStorage().Get(keyringServiceName(hostname), user); err == nil {
+		if err = c.secureStorage().Set(keyringServiceName(hostname), "", token); err != nil {
 			return fmt.Errorf("failed to move active token in keyring: %v", err)
 		}
 		tokenSwitched = true
@@ -463,7 +614,7 @@ func (c *AuthConfig) UsersForHost(hostname string) []string {
 }
 
 func (c *AuthConfig) TokenForUser(hostname, user string) (string, string, error) {
-	if token, err := keyring.Get(keyringServiceName(hostname), user); err == nil {
+	if token, err := c.secureStorage().Get(keyringServiceName(hostname), user); err == nil {
 		return token, "keyring", nil
 	}
 
@@ -526,8 +677,14 @@ git_protocol: https
 editor:
 # When to interactively prompt. This is a global config that cannot be overridden by hostname. Supported values: enabled, disabled
 prompt: enabled
+# Whether shell completion is allowed to make network requests, e.g. to suggest open pull requests
+# or repository labels. This is a global config that cannot be overridden by hostname. Supported values: enabled, disabled
+completion_network: enabled
 # A pager program to send command output to, e.g. "less". If blank, will refer to environment. Set the value to "cat" to disable the pager.
 pager:
+# Template for the local branch name that 'gh pr checkout' creates for a pull request. Supports
+# the placeholders {number} and {head}. If blank, 'gh pr checkout' falls back to its default naming.
+pr_checkout_branch_template:
 # Aliases allow you to create nicknames for gh commands
 aliases:
   co: pr checkout
@@ -535,6 +692,10 @@ aliases:
 http_unix_socket:
 # What web browser gh should use when opening URLs. If blank, will refer to environment.
 browser:
+# Which release channel the update checker looks for new versions in. Supported values: stable, prerelease
+update_release_channel: stable
+# The maximum duration, e.g. "30s", a single HTTP request is allowed to run before it's canceled. If blank, requests are not bound by a timeout.
+request_timeout:
 `
 
 type ConfigOption struct {
@@ -568,16 +729,103 @@ func ConfigOptions() []ConfigOption {
 			Description:  "the terminal pager program to send standard output to",
 			DefaultValue: "",
 		},
+		{
+			Key:          prCheckoutBranchTemplateKey,
+			Description:  "the template 'gh pr checkout' uses to name the local branch for a pull request, e.g. \"pr/{number}-{head}\"",
+			DefaultValue: "",
+		},
 		{
 			Key:          httpUnixSocketKey,
 			Description:  "the path to a Unix socket through which to make an HTTP connection",
 			DefaultValue: "",
 		},
+		{
+			Key:          httpProxyKey,
+			Description:  "the proxy URL (http, https, or socks5) to route requests through, with optional userinfo for proxy auth",
+			DefaultValue: "",
+		},
 		{
 			Key:          browserKey,
 			Description:  "the web browser to use for opening URLs",
 			DefaultValue: "",
 		},
+		{
+			Key:           colorThemeKey,
+			Description:   "the color theme to use for terminal output",
+			DefaultValue:  "default",
+			AllowedValues: []string{"default", "light", "high-contrast"},
+		},
+		{
+			Key:           completionNetworkKey,
+			Description:   "toggle network-backed suggestions (e.g. pull request numbers, repository labels) in shell completion",
+			DefaultValue:  "enabled",
+			AllowedValues: []string{"enabled", "disabled"},
+		},
+		{
+			Key:           credentialBackendKey,
+			Description:   "where to store OAuth tokens outside of the plaintext config file; 'command' delegates to credential_helper",
+			DefaultValue:  "keyring",
+			AllowedValues: []string{"keyring", "file", "command"},
+		},
+		{
+			Key:          credentialHelperKey,
+			Description:  "the executable invoked to get, store, and erase secrets when credential_backend is 'command'",
+			DefaultValue: "",
+		},
+		{
+			Key:           displayTruncate,
+			Description:   "whether to truncate long fields in table output",
+			DefaultValue:  "true",
+			AllowedValues: []string{"true", "false"},
+		},
+		{
+			Key:          glamourStyleKey,
+			Description:  "the glamour style (built-in name or path to a custom style JSON file) to use for rendering markdown",
+			DefaultValue: "",
+		},
+		{
+			Key:           displayHyperlinks,
+			Description:   "whether to render clickable terminal hyperlinks in list output",
+			DefaultValue:  "auto",
+			AllowedValues: []string{"always", "never", "auto"},
+		},
+		{
+			Key:           displayTimestamps,
+			Description:   "how to render timestamps in table output",
+			DefaultValue:  "relative",
+			AllowedValues: []string{"relative", "absolute", "iso8601"},
+		},
+		{
+			Key:          statusExcludeKey,
+			Description:  "comma-separated list of repositories, in owner/name format, to exclude from 'gh status' by default",
+			DefaultValue: "",
+		},
+		{
+			Key:           updateReleaseChannelKey,
+			Description:   "which release channel the update checker looks for new versions in",
+			DefaultValue:  "stable",
+			AllowedValues: []string{"stable", "prerelease"},
+		},
+		{
+			Key:          requestTimeoutKey,
+			Description:  "the maximum duration (e.g. \"30s\") a single HTTP request is allowed to run before it's canceled; overridable with GH_REQUEST_TIMEOUT",
+			DefaultValue: "",
+		},
+		{
+			Key:          caBundleKey,
+			Description:  "the path to a PEM-encoded certificate bundle to trust as a root CA, in addition to the system trust store",
+			DefaultValue: "",
+		},
+		{
+			Key:          clientCertificateKey,
+			Description:  "the path to a PEM-encoded client certificate to present for mutual TLS; must be set together with client_key",
+			DefaultValue: "",
+		},
+		{
+			Key:          clientKeyKey,
+			Description:  "the path to the PEM-encoded private key for client_certificate",
+			DefaultValue: "",
+		},
 	}
 }
 
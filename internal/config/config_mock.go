@@ -26,6 +26,36 @@ var _ Config = &ConfigMock{}
 //			BrowserFunc: func(s string) string {
 //				panic("mock out the Browser method")
 //			},
+//			CABundleFunc: func(s string) string {
+//				panic("mock out the CABundle method")
+//			},
+//			ClientCertificateFunc: func(s string) string {
+//				panic("mock out the ClientCertificate method")
+//			},
+//			ClientKeyFunc: func(s string) string {
+//				panic("mock out the ClientKey method")
+//			},
+//			ColorThemeFunc: func(s string) string {
+//				panic("mock out the ColorTheme method")
+//			},
+//			CompletionNetworkFunc: func(s string) string {
+//				panic("mock out the CompletionNetwork method")
+//			},
+//			CredentialBackendFunc: func() string {
+//				panic("mock out the CredentialBackend method")
+//			},
+//			CredentialHelperFunc: func() string {
+//				panic("mock out the CredentialHelper method")
+//			},
+//			DisplayHyperlinksFunc: func(s string) string {
+//				panic("mock out the DisplayHyperlinks method")
+//			},
+//			DisplayTimestampsFunc: func(s string) string {
+//				panic("mock out the DisplayTimestamps method")
+//			},
+//			DisplayTruncateFunc: func(s string) string {
+//				panic("mock out the DisplayTruncate method")
+//			},
 //			EditorFunc: func(s string) string {
 //				panic("mock out the Editor method")
 //			},
@@ -35,6 +65,12 @@ var _ Config = &ConfigMock{}
 //			GitProtocolFunc: func(s string) string {
 //				panic("mock out the GitProtocol method")
 //			},
+//			GlamourStyleFunc: func(s string) string {
+//				panic("mock out the GlamourStyle method")
+//			},
+//			HTTPProxyFunc: func(s string) string {
+//				panic("mock out the HTTPProxy method")
+//			},
 //			HTTPUnixSocketFunc: func(s string) string {
 //				panic("mock out the HTTPUnixSocket method")
 //			},
@@ -44,12 +80,24 @@ var _ Config = &ConfigMock{}
 //			PagerFunc: func(s string) string {
 //				panic("mock out the Pager method")
 //			},
+//			PRCheckoutBranchTemplateFunc: func(s string) string {
+//				panic("mock out the PRCheckoutBranchTemplate method")
+//			},
 //			PromptFunc: func(s string) string {
 //				panic("mock out the Prompt method")
 //			},
+//			RequestTimeoutFunc: func(s string) string {
+//				panic("mock out the RequestTimeout method")
+//			},
 //			SetFunc: func(s1 string, s2 string, s3 string)  {
 //				panic("mock out the Set method")
 //			},
+//			StatusExcludeFunc: func(s string) string {
+//				panic("mock out the StatusExclude method")
+//			},
+//			UpdateReleaseChannelFunc: func() string {
+//				panic("mock out the UpdateReleaseChannel method")
+//			},
 //			VersionFunc: func() string {
 //				panic("mock out the Version method")
 //			},
@@ -72,6 +120,36 @@ type ConfigMock struct {
 	// BrowserFunc mocks the Browser method.
 	BrowserFunc func(s string) string
 
+	// CABundleFunc mocks the CABundle method.
+	CABundleFunc func(s string) string
+
+	// ClientCertificateFunc mocks the ClientCertificate method.
+	ClientCertificateFunc func(s string) string
+
+	// ClientKeyFunc mocks the ClientKey method.
+	ClientKeyFunc func(s string) string
+
+	// ColorThemeFunc mocks the ColorTheme method.
+	ColorThemeFunc func(s string) string
+
+	// CompletionNetworkFunc mocks the CompletionNetwork method.
+	CompletionNetworkFunc func(s string) string
+
+	// CredentialBackendFunc mocks the CredentialBackend method.
+	CredentialBackendFunc func() string
+
+	// CredentialHelperFunc mocks the CredentialHelper method.
+	CredentialHelperFunc func() string
+
+	// DisplayHyperlinksFunc mocks the DisplayHyperlinks method.
+	DisplayHyperlinksFunc func(s string) string
+
+	// DisplayTimestampsFunc mocks the DisplayTimestamps method.
+	DisplayTimestampsFunc func(s string) string
+
+	// DisplayTruncateFunc mocks the DisplayTruncate method.
+	DisplayTruncateFunc func(s string) string
+
 	// EditorFunc mocks the Editor method.
 	EditorFunc func(s string) string
 
@@ -81,6 +159,12 @@ type ConfigMock struct {
 	// GitProtocolFunc mocks the GitProtocol method.
 	GitProtocolFunc func(s string) string
 
+	// GlamourStyleFunc mocks the GlamourStyle method.
+	GlamourStyleFunc func(s string) string
+
+	// HTTPProxyFunc mocks the HTTPProxy method.
+	HTTPProxyFunc func(s string) string
+
 	// HTTPUnixSocketFunc mocks the HTTPUnixSocket method.
 	HTTPUnixSocketFunc func(s string) string
 
@@ -90,12 +174,24 @@ type ConfigMock struct {
 	// PagerFunc mocks the Pager method.
 	PagerFunc func(s string) string
 
+	// PRCheckoutBranchTemplateFunc mocks the PRCheckoutBranchTemplate method.
+	PRCheckoutBranchTemplateFunc func(s string) string
+
 	// PromptFunc mocks the Prompt method.
 	PromptFunc func(s string) string
 
+	// RequestTimeoutFunc mocks the RequestTimeout method.
+	RequestTimeoutFunc func(s string) string
+
 	// SetFunc mocks the Set method.
 	SetFunc func(s1 string, s2 string, s3 string)
 
+	// StatusExcludeFunc mocks the StatusExclude method.
+	StatusExcludeFunc func(s string) string
+
+	// UpdateReleaseChannelFunc mocks the UpdateReleaseChannel method.
+	UpdateReleaseChannelFunc func() string
+
 	// VersionFunc mocks the Version method.
 	VersionFunc func() string
 
@@ -115,6 +211,52 @@ type ConfigMock struct {
 			// S is the s argument value.
 			S string
 		}
+		// CABundle holds details about calls to the CABundle method.
+		CABundle []struct {
+			// S is the s argument value.
+			S string
+		}
+		// ClientCertificate holds details about calls to the ClientCertificate method.
+		ClientCertificate []struct {
+			// S is the s argument value.
+			S string
+		}
+		// ClientKey holds details about calls to the ClientKey method.
+		ClientKey []struct {
+			// S is the s argument value.
+			S string
+		}
+		// ColorTheme holds details about calls to the ColorTheme method.
+		ColorTheme []struct {
+			// S is the s argument value.
+			S string
+		}
+		// CompletionNetwork holds details about calls to the CompletionNetwork method.
+		CompletionNetwork []struct {
+			// S is the s argument value.
+			S string
+		}
+		// CredentialBackend holds details about calls to the CredentialBackend method.
+		CredentialBackend []struct {
+		}
+		// CredentialHelper holds details about calls to the CredentialHelper method.
+		CredentialHelper []struct {
+		}
+		// DisplayHyperlinks holds details about calls to the DisplayHyperlinks method.
+		DisplayHyperlinks []struct {
+			// S is the s argument value.
+			S string
+		}
+		// DisplayTimestamps holds details about calls to the DisplayTimestamps method.
+		DisplayTimestamps []struct {
+			// S is the s argument value.
+			S string
+		}
+		// DisplayTruncate holds details about calls to the DisplayTruncate method.
+		DisplayTruncate []struct {
+			// S is the s argument value.
+			S string
+		}
 		// Editor holds details about calls to the Editor method.
 		Editor []struct {
 			// S is the s argument value.
@@ -132,6 +274,16 @@ type ConfigMock struct {
 			// S is the s argument value.
 			S string
 		}
+		// GlamourStyle holds details about calls to the GlamourStyle method.
+		GlamourStyle []struct {
+			// S is the s argument value.
+			S string
+		}
+		// HTTPProxy holds details about calls to the HTTPProxy method.
+		HTTPProxy []struct {
+			// S is the s argument value.
+			S string
+		}
 		// HTTPUnixSocket holds details about calls to the HTTPUnixSocket method.
 		HTTPUnixSocket []struct {
 			// S is the s argument value.
@@ -147,11 +299,21 @@ type ConfigMock struct {
 			// S is the s argument value.
 			S string
 		}
+		// PRCheckoutBranchTemplate holds details about calls to the PRCheckoutBranchTemplate method.
+		PRCheckoutBranchTemplate []struct {
+			// S is the s argument value.
+			S string
+		}
 		// Prompt holds details about calls to the Prompt method.
 		Prompt []struct {
 			// S is the s argument value.
 			S string
 		}
+		// RequestTimeout holds details about calls to the RequestTimeout method.
+		RequestTimeout []struct {
+			// S is the s argument value.
+			S string
+		}
 		// Set holds details about calls to the Set method.
 		Set []struct {
 			// S1 is the s1 argument value.
@@ -161,6 +323,14 @@ type ConfigMock struct {
 			// S3 is the s3 argument value.
 			S3 string
 		}
+		// StatusExclude holds details about calls to the StatusExclude method.
+		StatusExclude []struct {
+			// S is the s argument value.
+			S string
+		}
+		// UpdateReleaseChannel holds details about calls to the UpdateReleaseChannel method.
+		UpdateReleaseChannel []struct {
+		}
 		// Version holds details about calls to the Version method.
 		Version []struct {
 		}
@@ -168,19 +338,35 @@ type ConfigMock struct {
 		Write []struct {
 		}
 	}
-	lockAliases        sync.RWMutex
-	lockAuthentication sync.RWMutex
-	lockBrowser        sync.RWMutex
-	lockEditor         sync.RWMutex
-	lockGetOrDefault   sync.RWMutex
-	lockGitProtocol    sync.RWMutex
-	lockHTTPUnixSocket sync.RWMutex
-	lockMigrate        sync.RWMutex
-	lockPager          sync.RWMutex
-	lockPrompt         sync.RWMutex
-	lockSet            sync.RWMutex
-	lockVersion        sync.RWMutex
-	lockWrite          sync.RWMutex
+	lockAliases                  sync.RWMutex
+	lockAuthentication           sync.RWMutex
+	lockBrowser                  sync.RWMutex
+	lockCABundle                 sync.RWMutex
+	lockClientCertificate        sync.RWMutex
+	lockClientKey                sync.RWMutex
+	lockColorTheme               sync.RWMutex
+	lockCompletionNetwork        sync.RWMutex
+	lockCredentialBackend        sync.RWMutex
+	lockCredentialHelper         sync.RWMutex
+	lockDisplayHyperlinks        sync.RWMutex
+	lockDisplayTimestamps        sync.RWMutex
+	lockDisplayTruncate          sync.RWMutex
+	lockEditor                   sync.RWMutex
+	lockGetOrDefault             sync.RWMutex
+	lockGitProtocol              sync.RWMutex
+	lockGlamourStyle             sync.RWMutex
+	lockHTTPProxy                sync.RWMutex
+	lockHTTPUnixSocket           sync.RWMutex
+	lockMigrate                  sync.RWMutex
+	lockPager                    sync.RWMutex
+	lockPRCheckoutBranchTemplate sync.RWMutex
+	lockPrompt                   sync.RWMutex
+	lockRequestTimeout           sync.RWMutex
+	lockSet                      sync.RWMutex
+	lockStatusExclude            sync.RWMutex
+	lockUpdateReleaseChannel     sync.RWMutex
+	lockVersion                  sync.RWMutex
+	lockWrite                    sync.RWMutex
 }
 
 // Aliases calls AliasesFunc.
@@ -269,6 +455,316 @@ func (mock *ConfigMock) BrowserCalls() []struct {
 	return calls
 }
 
+// CABundle calls CABundleFunc.
+func (mock *ConfigMock) CABundle(s string) string {
+	if mock.CABundleFunc == nil {
+		panic("ConfigMock.CABundleFunc: method is nil but Config.CABundle was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockCABundle.Lock()
+	mock.calls.CABundle = append(mock.calls.CABundle, callInfo)
+	mock.lockCABundle.Unlock()
+	return mock.CABundleFunc(s)
+}
+
+// CABundleCalls gets all the calls that were made to CABundle.
+// Check the length with:
+//
+//	len(mockedConfig.CABundleCalls())
+func (mock *ConfigMock) CABundleCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockCABundle.RLock()
+	calls = mock.calls.CABundle
+	mock.lockCABundle.RUnlock()
+	return calls
+}
+
+// ClientCertificate calls ClientCertificateFunc.
+func (mock *ConfigMock) ClientCertificate(s string) string {
+	if mock.ClientCertificateFunc == nil {
+		panic("ConfigMock.ClientCertificateFunc: method is nil but Config.ClientCertificate was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockClientCertificate.Lock()
+	mock.calls.ClientCertificate = append(mock.calls.ClientCertificate, callInfo)
+	mock.lockClientCertificate.Unlock()
+	return mock.ClientCertificateFunc(s)
+}
+
+// ClientCertificateCalls gets all the calls that were made to ClientCertificate.
+// Check the length with:
+//
+//	len(mockedConfig.ClientCertificateCalls())
+func (mock *ConfigMock) ClientCertificateCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockClientCertificate.RLock()
+	calls = mock.calls.ClientCertificate
+	mock.lockClientCertificate.RUnlock()
+	return calls
+}
+
+// ClientKey calls ClientKeyFunc.
+func (mock *ConfigMock) ClientKey(s string) string {
+	if mock.ClientKeyFunc == nil {
+		panic("ConfigMock.ClientKeyFunc: method is nil but Config.ClientKey was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockClientKey.Lock()
+	mock.calls.ClientKey = append(mock.calls.ClientKey, callInfo)
+	mock.lockClientKey.Unlock()
+	return mock.ClientKeyFunc(s)
+}
+
+// ClientKeyCalls gets all the calls that were made to ClientKey.
+// Check the length with:
+//
+//	len(mockedConfig.ClientKeyCalls())
+func (mock *ConfigMock) ClientKeyCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockClientKey.RLock()
+	calls = mock.calls.ClientKey
+	mock.lockClientKey.RUnlock()
+	return calls
+}
+
+// ColorTheme calls ColorThemeFunc.
+func (mock *ConfigMock) ColorTheme(s string) string {
+	if mock.ColorThemeFunc == nil {
+		panic("ConfigMock.ColorThemeFunc: method is nil but Config.ColorTheme was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockColorTheme.Lock()
+	mock.calls.ColorTheme = append(mock.calls.ColorTheme, callInfo)
+	mock.lockColorTheme.Unlock()
+	return mock.ColorThemeFunc(s)
+}
+
+// ColorThemeCalls gets all the calls that were made to ColorTheme.
+// Check the length with:
+//
+//	len(mockedConfig.ColorThemeCalls())
+func (mock *ConfigMock) ColorThemeCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockColorTheme.RLock()
+	calls = mock.calls.ColorTheme
+	mock.lockColorTheme.RUnlock()
+	return calls
+}
+
+// CompletionNetwork calls CompletionNetworkFunc.
+func (mock *ConfigMock) CompletionNetwork(s string) string {
+	if mock.CompletionNetworkFunc == nil {
+		panic("ConfigMock.CompletionNetworkFunc: method is nil but Config.CompletionNetwork was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockCompletionNetwork.Lock()
+	mock.calls.CompletionNetwork = append(mock.calls.CompletionNetwork, callInfo)
+	mock.lockCompletionNetwork.Unlock()
+	return mock.CompletionNetworkFunc(s)
+}
+
+// CompletionNetworkCalls gets all the calls that were made to CompletionNetwork.
+// Check the length with:
+//
+//	len(mockedConfig.CompletionNetworkCalls())
+func (mock *ConfigMock) CompletionNetworkCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockCompletionNetwork.RLock()
+	calls = mock.calls.CompletionNetwork
+	mock.lockCompletionNetwork.RUnlock()
+	return calls
+}
+
+// CredentialBackend calls CredentialBackendFunc.
+func (mock *ConfigMock) CredentialBackend() string {
+	if mock.CredentialBackendFunc == nil {
+		panic("ConfigMock.CredentialBackendFunc: method is nil but Config.CredentialBackend was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockCredentialBackend.Lock()
+	mock.calls.CredentialBackend = append(mock.calls.CredentialBackend, callInfo)
+	mock.lockCredentialBackend.Unlock()
+	return mock.CredentialBackendFunc()
+}
+
+// CredentialBackendCalls gets all the calls that were made to CredentialBackend.
+// Check the length with:
+//
+//	len(mockedConfig.CredentialBackendCalls())
+func (mock *ConfigMock) CredentialBackendCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockCredentialBackend.RLock()
+	calls = mock.calls.CredentialBackend
+	mock.lockCredentialBackend.RUnlock()
+	return calls
+}
+
+// CredentialHelper calls CredentialHelperFunc.
+func (mock *ConfigMock) CredentialHelper() string {
+	if mock.CredentialHelperFunc == nil {
+		panic("ConfigMock.CredentialHelperFunc: method is nil but Config.CredentialHelper was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockCredentialHelper.Lock()
+	mock.calls.CredentialHelper = append(mock.calls.CredentialHelper, callInfo)
+	mock.lockCredentialHelper.Unlock()
+	return mock.CredentialHelperFunc()
+}
+
+// CredentialHelperCalls gets all the calls that were made to CredentialHelper.
+// Check the length with:
+//
+//	len(mockedConfig.CredentialHelperCalls())
+func (mock *ConfigMock) CredentialHelperCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockCredentialHelper.RLock()
+	calls = mock.calls.CredentialHelper
+	mock.lockCredentialHelper.RUnlock()
+	return calls
+}
+
+// DisplayHyperlinks calls DisplayHyperlinksFunc.
+func (mock *ConfigMock) DisplayHyperlinks(s string) string {
+	if mock.DisplayHyperlinksFunc == nil {
+		panic("ConfigMock.DisplayHyperlinksFunc: method is nil but Config.DisplayHyperlinks was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockDisplayHyperlinks.Lock()
+	mock.calls.DisplayHyperlinks = append(mock.calls.DisplayHyperlinks, callInfo)
+	mock.lockDisplayHyperlinks.Unlock()
+	return mock.DisplayHyperlinksFunc(s)
+}
+
+// DisplayHyperlinksCalls gets all the calls that were made to DisplayHyperlinks.
+// Check the length with:
+//
+//	len(mockedConfig.DisplayHyperlinksCalls())
+func (mock *ConfigMock) DisplayHyperlinksCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockDisplayHyperlinks.RLock()
+	calls = mock.calls.DisplayHyperlinks
+	mock.lockDisplayHyperlinks.RUnlock()
+	return calls
+}
+
+// DisplayTimestamps calls DisplayTimestampsFunc.
+func (mock *ConfigMock) DisplayTimestamps(s string) string {
+	if mock.DisplayTimestampsFunc == nil {
+		panic("ConfigMock.DisplayTimestampsFunc: method is nil but Config.DisplayTimestamps was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockDisplayTimestamps.Lock()
+	mock.calls.DisplayTimestamps = append(mock.calls.DisplayTimestamps, callInfo)
+	mock.lockDisplayTimestamps.Unlock()
+	return mock.DisplayTimestampsFunc(s)
+}
+
+// DisplayTimestampsCalls gets all the calls that were made to DisplayTimestamps.
+// Check the length with:
+//
+//	len(mockedConfig.DisplayTimestampsCalls())
+func (mock *ConfigMock) DisplayTimestampsCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockDisplayTimestamps.RLock()
+	calls = mock.calls.DisplayTimestamps
+	mock.lockDisplayTimestamps.RUnlock()
+	return calls
+}
+
+// DisplayTruncate calls DisplayTruncateFunc.
+func (mock *ConfigMock) DisplayTruncate(s string) string {
+	if mock.DisplayTruncateFunc == nil {
+		panic("ConfigMock.DisplayTruncateFunc: method is nil but Config.DisplayTruncate was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockDisplayTruncate.Lock()
+	mock.calls.DisplayTruncate = append(mock.calls.DisplayTruncate, callInfo)
+	mock.lockDisplayTruncate.Unlock()
+	return mock.DisplayTruncateFunc(s)
+}
+
+// DisplayTruncateCalls gets all the calls that were made to DisplayTruncate.
+// Check the length with:
+//
+//	len(mockedConfig.DisplayTruncateCalls())
+func (mock *ConfigMock) DisplayTruncateCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockDisplayTruncate.RLock()
+	calls = mock.calls.DisplayTruncate
+	mock.lockDisplayTruncate.RUnlock()
+	return calls
+}
+
 // Editor calls EditorFunc.
 func (mock *ConfigMock) Editor(s string) string {
 	if mock.EditorFunc == nil {
@@ -369,6 +865,70 @@ func (mock *ConfigMock) GitProtocolCalls() []struct {
 	return calls
 }
 
+// GlamourStyle calls GlamourStyleFunc.
+func (mock *ConfigMock) GlamourStyle(s string) string {
+	if mock.GlamourStyleFunc == nil {
+		panic("ConfigMock.GlamourStyleFunc: method is nil but Config.GlamourStyle was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockGlamourStyle.Lock()
+	mock.calls.GlamourStyle = append(mock.calls.GlamourStyle, callInfo)
+	mock.lockGlamourStyle.Unlock()
+	return mock.GlamourStyleFunc(s)
+}
+
+// GlamourStyleCalls gets all the calls that were made to GlamourStyle.
+// Check the length with:
+//
+//	len(mockedConfig.GlamourStyleCalls())
+func (mock *ConfigMock) GlamourStyleCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockGlamourStyle.RLock()
+	calls = mock.calls.GlamourStyle
+	mock.lockGlamourStyle.RUnlock()
+	return calls
+}
+
+// HTTPProxy calls HTTPProxyFunc.
+func (mock *ConfigMock) HTTPProxy(s string) string {
+	if mock.HTTPProxyFunc == nil {
+		panic("ConfigMock.HTTPProxyFunc: method is nil but Config.HTTPProxy was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockHTTPProxy.Lock()
+	mock.calls.HTTPProxy = append(mock.calls.HTTPProxy, callInfo)
+	mock.lockHTTPProxy.Unlock()
+	return mock.HTTPProxyFunc(s)
+}
+
+// HTTPProxyCalls gets all the calls that were made to HTTPProxy.
+// Check the length with:
+//
+//	len(mockedConfig.HTTPProxyCalls())
+func (mock *ConfigMock) HTTPProxyCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockHTTPProxy.RLock()
+	calls = mock.calls.HTTPProxy
+	mock.lockHTTPProxy.RUnlock()
+	return calls
+}
+
 // HTTPUnixSocket calls HTTPUnixSocketFunc.
 func (mock *ConfigMock) HTTPUnixSocket(s string) string {
 	if mock.HTTPUnixSocketFunc == nil {
@@ -465,6 +1025,38 @@ func (mock *ConfigMock) PagerCalls() []struct {
 	return calls
 }
 
+// PRCheckoutBranchTemplate calls PRCheckoutBranchTemplateFunc.
+func (mock *ConfigMock) PRCheckoutBranchTemplate(s string) string {
+	if mock.PRCheckoutBranchTemplateFunc == nil {
+		panic("ConfigMock.PRCheckoutBranchTemplateFunc: method is nil but Config.PRCheckoutBranchTemplate was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockPRCheckoutBranchTemplate.Lock()
+	mock.calls.PRCheckoutBranchTemplate = append(mock.calls.PRCheckoutBranchTemplate, callInfo)
+	mock.lockPRCheckoutBranchTemplate.Unlock()
+	return mock.PRCheckoutBranchTemplateFunc(s)
+}
+
+// PRCheckoutBranchTemplateCalls gets all the calls that were made to PRCheckoutBranchTemplate.
+// Check the length with:
+//
+//	len(mockedConfig.PRCheckoutBranchTemplateCalls())
+func (mock *ConfigMock) PRCheckoutBranchTemplateCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockPRCheckoutBranchTemplate.RLock()
+	calls = mock.calls.PRCheckoutBranchTemplate
+	mock.lockPRCheckoutBranchTemplate.RUnlock()
+	return calls
+}
+
 // Prompt calls PromptFunc.
 func (mock *ConfigMock) Prompt(s string) string {
 	if mock.PromptFunc == nil {
@@ -497,6 +1089,38 @@ func (mock *ConfigMock) PromptCalls() []struct {
 	return calls
 }
 
+// RequestTimeout calls RequestTimeoutFunc.
+func (mock *ConfigMock) RequestTimeout(s string) string {
+	if mock.RequestTimeoutFunc == nil {
+		panic("ConfigMock.RequestTimeoutFunc: method is nil but Config.RequestTimeout was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockRequestTimeout.Lock()
+	mock.calls.RequestTimeout = append(mock.calls.RequestTimeout, callInfo)
+	mock.lockRequestTimeout.Unlock()
+	return mock.RequestTimeoutFunc(s)
+}
+
+// RequestTimeoutCalls gets all the calls that were made to RequestTimeout.
+// Check the length with:
+//
+//	len(mockedConfig.RequestTimeoutCalls())
+func (mock *ConfigMock) RequestTimeoutCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockRequestTimeout.RLock()
+	calls = mock.calls.RequestTimeout
+	mock.lockRequestTimeout.RUnlock()
+	return calls
+}
+
 // Set calls SetFunc.
 func (mock *ConfigMock) Set(s1 string, s2 string, s3 string) {
 	if mock.SetFunc == nil {
@@ -537,6 +1161,65 @@ func (mock *ConfigMock) SetCalls() []struct {
 	return calls
 }
 
+// StatusExclude calls StatusExcludeFunc.
+func (mock *ConfigMock) StatusExclude(s string) string {
+	if mock.StatusExcludeFunc == nil {
+		panic("ConfigMock.StatusExcludeFunc: method is nil but Config.StatusExclude was just called")
+	}
+	callInfo := struct {
+		S string
+	}{
+		S: s,
+	}
+	mock.lockStatusExclude.Lock()
+	mock.calls.StatusExclude = append(mock.calls.StatusExclude, callInfo)
+	mock.lockStatusExclude.Unlock()
+	return mock.StatusExcludeFunc(s)
+}
+
+// StatusExcludeCalls gets all the calls that were made to StatusExclude.
+// Check the length with:
+//
+//	len(mockedConfig.StatusExcludeCalls())
+func (mock *ConfigMock) StatusExcludeCalls() []struct {
+	S string
+} {
+	var calls []struct {
+		S string
+	}
+	mock.lockStatusExclude.RLock()
+	calls = mock.calls.StatusExclude
+	mock.lockStatusExclude.RUnlock()
+	return calls
+}
+
+// UpdateReleaseChannel calls UpdateReleaseChannelFunc.
+func (mock *ConfigMock) UpdateReleaseChannel() string {
+	if mock.UpdateReleaseChannelFunc == nil {
+		panic("ConfigMock.UpdateReleaseChannelFunc: method is nil but Config.UpdateReleaseChannel was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockUpdateReleaseChannel.Lock()
+	mock.calls.UpdateReleaseChannel = append(mock.calls.UpdateReleaseChannel, callInfo)
+	mock.lockUpdateReleaseChannel.Unlock()
+	return mock.UpdateReleaseChannelFunc()
+}
+
+// UpdateReleaseChannelCalls gets all the calls that were made to UpdateReleaseChannel.
+// Check the length with:
+//
+//	len(mockedConfig.UpdateReleaseChannelCalls())
+func (mock *ConfigMock) UpdateReleaseChannelCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockUpdateReleaseChannel.RLock()
+	calls = mock.calls.UpdateReleaseChannel
+	mock.lockUpdateReleaseChannel.RUnlock()
+	return calls
+}
+
 // Version calls VersionFunc.
 func (mock *ConfigMock) Version() string {
 	if mock.VersionFunc == nil {
@@ -108,6 +108,7 @@ func TestGetOrDefaultApplicationDefaults(t *testing.T) {
 		{pagerKey, ""},
 		{httpUnixSocketKey, ""},
 		{browserKey, ""},
+		{colorThemeKey, "default"},
 	}
 
 	for _, tt := range tests {
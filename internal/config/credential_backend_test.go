@@ -0,0 +1,141 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/keyring"
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCredentialHelperScript writes a shell script that speaks the same
+// get/store/erase protocol as commandBackend, backing its storage with a
+// flat file so that state is visible across separate invocations of the
+// script, the way a real helper like `pass` or `op` would be.
+func fakeCredentialHelperScript(t *testing.T) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake credential helper is a shell script")
+	}
+
+	dir := t.TempDir()
+	store := filepath.Join(dir, "store")
+	script := filepath.Join(dir, "helper.sh")
+
+	const body = `#!/bin/sh
+set -e
+action=$1
+store=%q
+
+service=
+user=
+secret=
+while IFS='=' read -r key value; do
+	[ -z "$key" ] && break
+	case "$key" in
+		service) service=$value ;;
+		user) user=$value ;;
+		secret) secret=$value ;;
+	esac
+done
+
+entry="$service:$user"
+
+case "$action" in
+	get)
+		touch "$store"
+		grep -F "$entry=" "$store" | tail -n1 | cut -d= -f2- | sed 's/^/secret=/'
+		;;
+	store)
+		touch "$store"
+		grep -vF "$entry=" "$store" > "$store.tmp" || true
+		mv "$store.tmp" "$store"
+		echo "$entry=$secret" >> "$store"
+		;;
+	erase)
+		touch "$store"
+		grep -vF "$entry=" "$store" > "$store.tmp" || true
+		mv "$store.tmp" "$store"
+		;;
+	*)
+		echo "unsupported action: $action" >&2
+		exit 1
+		;;
+esac
+`
+	require.NoError(t, os.WriteFile(script, []byte(fmt.Sprintf(body, store)), 0o755))
+	return script
+}
+
+func TestCommandBackendProtocol(t *testing.T) {
+	backend := commandBackend{helper: fakeCredentialHelperScript(t)}
+
+	// Getting a secret that was never stored returns ErrNotFound.
+	_, err := backend.Get("gh:github.com", "monalisa")
+	require.ErrorIs(t, err, keyring.ErrNotFound)
+
+	// Storing and then getting round-trips the secret.
+	require.NoError(t, backend.Set("gh:github.com", "monalisa", "test-token"))
+	secret, err := backend.Get("gh:github.com", "monalisa")
+	require.NoError(t, err)
+	require.Equal(t, "test-token", secret)
+
+	// A different user on the same service is independent.
+	_, err = backend.Get("gh:github.com", "hubot")
+	require.ErrorIs(t, err, keyring.ErrNotFound)
+
+	// Erasing removes the secret.
+	require.NoError(t, backend.Delete("gh:github.com", "monalisa"))
+	_, err = backend.Get("gh:github.com", "monalisa")
+	require.ErrorIs(t, err, keyring.ErrNotFound)
+}
+
+func TestCommandBackendRequiresHelper(t *testing.T) {
+	backend := commandBackend{}
+
+	_, err := backend.Get("gh:github.com", "monalisa")
+	require.ErrorContains(t, err, "credential_helper to be set")
+}
+
+func TestCredentialBackendForSelectsBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		helper   string
+		expected credentialBackend
+	}{
+		{name: "defaults to keyring", expected: keyringBackend{}},
+		{name: "keyring", backend: "keyring", expected: keyringBackend{}},
+		{name: "file", backend: "file", expected: fileBackend{}},
+		{name: "command", backend: "command", helper: "pass show gh", expected: commandBackend{helper: "pass show gh"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &cfg{ghConfig.ReadFromString("")}
+			if tt.backend != "" {
+				c.Set("", credentialBackendKey, tt.backend)
+			}
+			if tt.helper != "" {
+				c.Set("", credentialHelperKey, tt.helper)
+			}
+			require.Equal(t, tt.expected, credentialBackendFor(c))
+		})
+	}
+}
+
+func TestFileBackendDisablesSecureStorage(t *testing.T) {
+	var backend fileBackend
+
+	_, err := backend.Get("gh:github.com", "monalisa")
+	require.ErrorIs(t, err, keyring.ErrNotFound)
+
+	err = backend.Set("gh:github.com", "monalisa", "test-token")
+	require.ErrorIs(t, err, errSecureStorageDisabled)
+
+	require.NoError(t, backend.Delete("gh:github.com", "monalisa"))
+}
@@ -53,6 +53,38 @@ func NewFromString(cfgStr string) *ConfigMock {
 		val, _ := cfg.GetOrDefault(hostname, browserKey)
 		return val
 	}
+	mock.CABundleFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, caBundleKey)
+		return val
+	}
+	mock.ClientCertificateFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, clientCertificateKey)
+		return val
+	}
+	mock.ClientKeyFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, clientKeyKey)
+		return val
+	}
+	mock.ColorThemeFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, colorThemeKey)
+		return val
+	}
+	mock.CredentialBackendFunc = func() string {
+		val, _ := cfg.GetOrDefault("", credentialBackendKey)
+		return val
+	}
+	mock.CredentialHelperFunc = func() string {
+		val, _ := cfg.GetOrDefault("", credentialHelperKey)
+		return val
+	}
+	mock.DisplayTimestampsFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, displayTimestamps)
+		return val
+	}
+	mock.DisplayTruncateFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, displayTruncate)
+		return val
+	}
 	mock.EditorFunc = func(hostname string) string {
 		val, _ := cfg.GetOrDefault(hostname, editorKey)
 		return val
@@ -61,6 +93,14 @@ func NewFromString(cfgStr string) *ConfigMock {
 		val, _ := cfg.GetOrDefault(hostname, gitProtocolKey)
 		return val
 	}
+	mock.GlamourStyleFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, glamourStyleKey)
+		return val
+	}
+	mock.HTTPProxyFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, httpProxyKey)
+		return val
+	}
 	mock.HTTPUnixSocketFunc = func(hostname string) string {
 		val, _ := cfg.GetOrDefault(hostname, httpUnixSocketKey)
 		return val
@@ -69,10 +109,22 @@ func NewFromString(cfgStr string) *ConfigMock {
 		val, _ := cfg.GetOrDefault(hostname, pagerKey)
 		return val
 	}
+	mock.PRCheckoutBranchTemplateFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, prCheckoutBranchTemplateKey)
+		return val
+	}
 	mock.PromptFunc = func(hostname string) string {
 		val, _ := cfg.GetOrDefault(hostname, promptKey)
 		return val
 	}
+	mock.RequestTimeoutFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, requestTimeoutKey)
+		return val
+	}
+	mock.StatusExcludeFunc = func(hostname string) string {
+		val, _ := cfg.GetOrDefault(hostname, statusExcludeKey)
+		return val
+	}
 	mock.VersionFunc = func() string {
 		val, _ := cfg.GetOrDefault("", versionKey)
 		return val
@@ -19,6 +19,11 @@ type Prompter interface {
 	Password(string) (string, error)
 	Confirm(string, bool) (bool, error)
 
+	// SelectWithFilter prompts the user to select an option from a list of
+	// options using a fuzzy, type-to-filter select. It is meant for lists
+	// that are too long to scan as a plain numbered list.
+	SelectWithFilter(string, string, []string) (int, error)
+
 	// gh specific prompts
 	AuthToken() (string, error)
 	ConfirmDeletion(string) error
@@ -64,6 +69,26 @@ func (p *surveyPrompter) Confirm(prompt string, defaultValue bool) (bool, error)
 	return p.prompter.Confirm(prompt, defaultValue)
 }
 
+func (p *surveyPrompter) SelectWithFilter(prompt, defaultValue string, options []string) (int, error) {
+	var result int
+	q := &survey.Select{
+		Message:  fmt.Sprintf("%s (%d options, type to filter)", prompt, len(options)),
+		Options:  options,
+		PageSize: 10,
+		Filter:   FuzzyMatchingFilter,
+	}
+	if defaultValue != "" {
+		for _, o := range options {
+			if o == defaultValue {
+				q.Default = defaultValue
+				break
+			}
+		}
+	}
+	err := p.ask(q, &result)
+	return result, err
+}
+
 func (p *surveyPrompter) AuthToken() (string, error) {
 	var result string
 	err := p.ask(&survey.Password{
@@ -123,3 +148,27 @@ func (p *surveyPrompter) ask(q survey.Prompt, response interface{}, opts ...surv
 	}
 	return fmt.Errorf("could not prompt: %w", err)
 }
+
+// FuzzyMatchingFilter returns whether value is a fuzzy match for filter:
+// every rune of filter must appear in value, in order, but not necessarily
+// contiguously. Comparison is case-insensitive.
+func FuzzyMatchingFilter(filter, value string, index int) bool {
+	filter = strings.ToLower(filter)
+	value = strings.ToLower(value)
+
+	if filter == "" {
+		return true
+	}
+
+	filterRunes := []rune(filter)
+	i := 0
+	for _, r := range value {
+		if r == filterRunes[i] {
+			i++
+			if i == len(filterRunes) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -44,6 +44,9 @@ var _ Prompter = &PrompterMock{}
 //			SelectFunc: func(s1 string, s2 string, strings []string) (int, error) {
 //				panic("mock out the Select method")
 //			},
+//			SelectWithFilterFunc: func(s1 string, s2 string, strings []string) (int, error) {
+//				panic("mock out the SelectWithFilter method")
+//			},
 //		}
 //
 //		// use mockedPrompter in code that requires Prompter
@@ -78,6 +81,9 @@ type PrompterMock struct {
 	// SelectFunc mocks the Select method.
 	SelectFunc func(s1 string, s2 string, strings []string) (int, error)
 
+	// SelectWithFilterFunc mocks the SelectWithFilter method.
+	SelectWithFilterFunc func(s1 string, s2 string, strings []string) (int, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AuthToken holds details about calls to the AuthToken method.
@@ -137,16 +143,26 @@ type PrompterMock struct {
 			// Strings is the strings argument value.
 			Strings []string
 		}
+		// SelectWithFilter holds details about calls to the SelectWithFilter method.
+		SelectWithFilter []struct {
+			// S1 is the s1 argument value.
+			S1 string
+			// S2 is the s2 argument value.
+			S2 string
+			// Strings is the strings argument value.
+			Strings []string
+		}
 	}
-	lockAuthToken       sync.RWMutex
-	lockConfirm         sync.RWMutex
-	lockConfirmDeletion sync.RWMutex
-	lockInput           sync.RWMutex
-	lockInputHostname   sync.RWMutex
-	lockMarkdownEditor  sync.RWMutex
-	lockMultiSelect     sync.RWMutex
-	lockPassword        sync.RWMutex
-	lockSelect          sync.RWMutex
+	lockAuthToken        sync.RWMutex
+	lockConfirm          sync.RWMutex
+	lockConfirmDeletion  sync.RWMutex
+	lockInput            sync.RWMutex
+	lockInputHostname    sync.RWMutex
+	lockMarkdownEditor   sync.RWMutex
+	lockMultiSelect      sync.RWMutex
+	lockPassword         sync.RWMutex
+	lockSelect           sync.RWMutex
+	lockSelectWithFilter sync.RWMutex
 }
 
 // AuthToken calls AuthTokenFunc.
@@ -458,3 +474,43 @@ func (mock *PrompterMock) SelectCalls() []struct {
 	mock.lockSelect.RUnlock()
 	return calls
 }
+
+// SelectWithFilter calls SelectWithFilterFunc.
+func (mock *PrompterMock) SelectWithFilter(s1 string, s2 string, strings []string) (int, error) {
+	if mock.SelectWithFilterFunc == nil {
+		panic("PrompterMock.SelectWithFilterFunc: method is nil but Prompter.SelectWithFilter was just called")
+	}
+	callInfo := struct {
+		S1      string
+		S2      string
+		Strings []string
+	}{
+		S1:      s1,
+		S2:      s2,
+		Strings: strings,
+	}
+	mock.lockSelectWithFilter.Lock()
+	mock.calls.SelectWithFilter = append(mock.calls.SelectWithFilter, callInfo)
+	mock.lockSelectWithFilter.Unlock()
+	return mock.SelectWithFilterFunc(s1, s2, strings)
+}
+
+// SelectWithFilterCalls gets all the calls that were made to SelectWithFilter.
+// Check the length with:
+//
+//	len(mockedPrompter.SelectWithFilterCalls())
+func (mock *PrompterMock) SelectWithFilterCalls() []struct {
+	S1      string
+	S2      string
+	Strings []string
+} {
+	var calls []struct {
+		S1      string
+		S2      string
+		Strings []string
+	}
+	mock.lockSelectWithFilter.RLock()
+	calls = mock.calls.SelectWithFilter
+	mock.lockSelectWithFilter.RUnlock()
+	return calls
+}
@@ -11,12 +11,13 @@ import (
 
 func NewMockPrompter(t *testing.T) *MockPrompter {
 	m := &MockPrompter{
-		t:                    t,
-		PrompterMock:         *ghPrompter.NewMock(t),
-		authTokenStubs:       []authTokenStub{},
-		confirmDeletionStubs: []confirmDeletionStub{},
-		inputHostnameStubs:   []inputHostnameStub{},
-		markdownEditorStubs:  []markdownEditorStub{},
+		t:                     t,
+		PrompterMock:          *ghPrompter.NewMock(t),
+		authTokenStubs:        []authTokenStub{},
+		confirmDeletionStubs:  []confirmDeletionStub{},
+		inputHostnameStubs:    []inputHostnameStub{},
+		markdownEditorStubs:   []markdownEditorStub{},
+		selectWithFilterStubs: []selectWithFilterStub{},
 	}
 	t.Cleanup(m.Verify)
 	return m
@@ -25,10 +26,11 @@ func NewMockPrompter(t *testing.T) *MockPrompter {
 type MockPrompter struct {
 	t *testing.T
 	ghPrompter.PrompterMock
-	authTokenStubs       []authTokenStub
-	confirmDeletionStubs []confirmDeletionStub
-	inputHostnameStubs   []inputHostnameStub
-	markdownEditorStubs  []markdownEditorStub
+	authTokenStubs        []authTokenStub
+	confirmDeletionStubs  []confirmDeletionStub
+	inputHostnameStubs    []inputHostnameStub
+	markdownEditorStubs   []markdownEditorStub
+	selectWithFilterStubs []selectWithFilterStub
 }
 
 type authTokenStub struct {
@@ -49,6 +51,12 @@ type markdownEditorStub struct {
 	fn     func(string, string, bool) (string, error)
 }
 
+type selectWithFilterStub struct {
+	prompt          string
+	expectedOptions []string
+	fn              func(string, string, []string) (int, error)
+}
+
 func (m *MockPrompter) AuthToken() (string, error) {
 	var s authTokenStub
 	if len(m.authTokenStubs) == 0 {
@@ -92,6 +100,20 @@ func (m *MockPrompter) MarkdownEditor(prompt, defaultValue string, blankAllowed
 	return s.fn(prompt, defaultValue, blankAllowed)
 }
 
+func (m *MockPrompter) SelectWithFilter(prompt, defaultValue string, options []string) (int, error) {
+	var s selectWithFilterStub
+	if len(m.selectWithFilterStubs) == 0 {
+		return -1, NoSuchPromptErr(prompt)
+	}
+	s = m.selectWithFilterStubs[0]
+	m.selectWithFilterStubs = m.selectWithFilterStubs[1:len(m.selectWithFilterStubs)]
+	if s.prompt != prompt {
+		return -1, NoSuchPromptErr(prompt)
+	}
+	AssertOptions(m.t, s.expectedOptions, options)
+	return s.fn(prompt, defaultValue, options)
+}
+
 func (m *MockPrompter) RegisterAuthToken(stub func() (string, error)) {
 	m.authTokenStubs = append(m.authTokenStubs, authTokenStub{fn: stub})
 }
@@ -108,6 +130,10 @@ func (m *MockPrompter) RegisterMarkdownEditor(prompt string, stub func(string, s
 	m.markdownEditorStubs = append(m.markdownEditorStubs, markdownEditorStub{prompt: prompt, fn: stub})
 }
 
+func (m *MockPrompter) RegisterSelectWithFilter(prompt string, opts []string, stub func(_, _ string, _ []string) (int, error)) {
+	m.selectWithFilterStubs = append(m.selectWithFilterStubs, selectWithFilterStub{prompt: prompt, expectedOptions: opts, fn: stub})
+}
+
 func (m *MockPrompter) Verify() {
 	errs := []string{}
 	if len(m.authTokenStubs) > 0 {
@@ -122,6 +148,9 @@ func (m *MockPrompter) Verify() {
 	if len(m.markdownEditorStubs) > 0 {
 		errs = append(errs, "markdownEditorStubs")
 	}
+	if len(m.selectWithFilterStubs) > 0 {
+		errs = append(errs, "SelectWithFilter")
+	}
 	if len(errs) > 0 {
 		m.t.Helper()
 		m.t.Errorf("%d unmatched calls to %s", len(errs), strings.Join(errs, ","))
@@ -1,7 +1,9 @@
 package tableprinter
 
 import (
+	"encoding/csv"
 	"io"
+	"math"
 	"strings"
 	"time"
 
@@ -12,8 +14,23 @@ import (
 
 type TablePrinter struct {
 	tableprinter.TablePrinter
-	isTTY bool
-	cs    *iostreams.ColorScheme
+	isTTY           bool
+	cs              *iostreams.ColorScheme
+	timestampFormat string
+
+	// csv is non-nil when the printer is rendering comma-separated output instead of
+	// delegating to the embedded go-gh TablePrinter. CSV has no use for truncation,
+	// padding, or color, so fields are collected as plain strings.
+	csv        *csvTablePrinter
+	csvHeaders []string
+}
+
+type csvTablePrinter struct {
+	out         io.Writer
+	includeHead bool
+	headers     []string
+	rows        [][]string
+	row         []string
 }
 
 // IsTTY gets whether the TablePrinter will render to a terminal.
@@ -26,18 +43,138 @@ func (t *TablePrinter) IsTTY() bool {
 func (tp *TablePrinter) AddTimeField(now, t time.Time, c func(string) string) {
 	var tf string
 	if tp.isTTY {
-		tf = text.FuzzyAgo(now, t)
+		tf = text.FormatTimestamp(now, t, tp.timestampFormat)
 	} else {
 		tf = t.Format(time.RFC3339)
 	}
 	tp.AddField(tf, WithColor(c))
 }
 
-var (
-	WithColor    = tableprinter.WithColor
-	WithPadding  = tableprinter.WithPadding
-	WithTruncate = tableprinter.WithTruncate
-)
+// fieldOption configures a single field added via AddField or AddHeader. It mirrors
+// go-gh's own fieldOption so call sites stay unaware of which renderer is active.
+type fieldOption func(*fieldConfig)
+
+type fieldConfig struct {
+	truncateFunc func(int, string) string
+	truncateSet  bool
+	paddingFunc  func(int, string) string
+	paddingSet   bool
+	colorFunc    func(string) string
+}
+
+// WithTruncate overrides the truncation function for the field; see go-gh's tableprinter.WithTruncate.
+func WithTruncate(fn func(int, string) string) fieldOption {
+	return func(f *fieldConfig) {
+		f.truncateFunc = fn
+		f.truncateSet = true
+	}
+}
+
+// WithPadding overrides the padding function for the field; see go-gh's tableprinter.WithPadding.
+func WithPadding(fn func(int, string) string) fieldOption {
+	return func(f *fieldConfig) {
+		f.paddingFunc = fn
+		f.paddingSet = true
+	}
+}
+
+// WithColor sets the color function for the field; see go-gh's tableprinter.WithColor.
+func WithColor(fn func(string) string) fieldOption {
+	return func(f *fieldConfig) {
+		f.colorFunc = fn
+	}
+}
+
+// AddField adds a cell to the row currently being built.
+func (tp *TablePrinter) AddField(s string, opts ...fieldOption) {
+	if tp.csv != nil {
+		tp.csv.row = append(tp.csv.row, s)
+		return
+	}
+
+	var cfg fieldConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	addFieldToGh(tp.TablePrinter, s, cfg)
+}
+
+// AddHeader adds the header row, if headers haven't already been printed.
+func (tp *TablePrinter) AddHeader(columns []string, opts ...fieldOption) {
+	if tp.csv != nil {
+		if tp.csv.headers == nil {
+			tp.csv.headers = columns
+		}
+		return
+	}
+
+	var cfg fieldConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	addHeaderToGh(tp.TablePrinter, columns, cfg)
+}
+
+// EndRow finalizes the row currently being built.
+func (tp *TablePrinter) EndRow() {
+	if tp.csv != nil {
+		tp.csv.rows = append(tp.csv.rows, tp.csv.row)
+		tp.csv.row = nil
+		return
+	}
+
+	tp.TablePrinter.EndRow()
+}
+
+// Render prints the accumulated rows to the underlying writer.
+func (tp *TablePrinter) Render() error {
+	if tp.csv != nil {
+		w := csv.NewWriter(tp.csv.out)
+		if tp.csv.includeHead && len(tp.csv.headers) > 0 {
+			if err := w.Write(tp.csv.headers); err != nil {
+				return err
+			}
+		}
+		for _, row := range tp.csv.rows {
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	return tp.TablePrinter.Render()
+}
+
+// addFieldToGh forwards a field to the embedded go-gh TablePrinter. go-gh's fieldOption
+// type is unexported, so the option values can only be constructed and passed inline;
+// this enumerates the handful of combinations actually used across the CLI.
+func addFieldToGh(t tableprinter.TablePrinter, s string, cfg fieldConfig) {
+	switch {
+	case cfg.truncateSet && cfg.paddingSet:
+		t.AddField(s, tableprinter.WithTruncate(cfg.truncateFunc), tableprinter.WithPadding(cfg.paddingFunc), tableprinter.WithColor(cfg.colorFunc))
+	case cfg.truncateSet:
+		t.AddField(s, tableprinter.WithTruncate(cfg.truncateFunc), tableprinter.WithColor(cfg.colorFunc))
+	case cfg.paddingSet:
+		t.AddField(s, tableprinter.WithPadding(cfg.paddingFunc), tableprinter.WithColor(cfg.colorFunc))
+	default:
+		t.AddField(s, tableprinter.WithColor(cfg.colorFunc))
+	}
+}
+
+func addHeaderToGh(t tableprinter.TablePrinter, columns []string, cfg fieldConfig) {
+	switch {
+	case cfg.truncateSet && cfg.paddingSet:
+		t.AddHeader(columns, tableprinter.WithTruncate(cfg.truncateFunc), tableprinter.WithPadding(cfg.paddingFunc), tableprinter.WithColor(cfg.colorFunc))
+	case cfg.truncateSet:
+		t.AddHeader(columns, tableprinter.WithTruncate(cfg.truncateFunc), tableprinter.WithColor(cfg.colorFunc))
+	case cfg.paddingSet:
+		t.AddHeader(columns, tableprinter.WithPadding(cfg.paddingFunc), tableprinter.WithColor(cfg.colorFunc))
+	default:
+		t.AddHeader(columns, tableprinter.WithColor(cfg.colorFunc))
+	}
+}
 
 type headerOption struct {
 	columns []string
@@ -50,8 +187,52 @@ func New(ios *iostreams.IOStreams, headers headerOption) *TablePrinter {
 	if isTTY {
 		maxWidth = ios.TerminalWidth()
 	}
+	if ios.TableTruncationDisabled() {
+		// A field is only ever truncated to fit within maxWidth, so making it
+		// effectively unbounded means every field renders at its full length.
+		maxWidth = math.MaxInt32
+	}
 
-	return NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers)
+	switch ios.TableFormat() {
+	case "table":
+		// Force the pretty, column-aligned renderer even when stdout isn't a terminal.
+		isTTY = true
+		if maxWidth <= 0 {
+			maxWidth = iostreams.DefaultWidth
+		}
+	case "tsv":
+		// Force the plain tab-separated renderer even when stdout is a terminal.
+		isTTY = false
+	case "csv":
+		return newCSVTablePrinter(ios, headers)
+	}
+
+	tp := NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers)
+	tp.timestampFormat = ios.TableTimestampFormat()
+	return tp
+}
+
+func newCSVTablePrinter(ios *iostreams.IOStreams, headers headerOption) *TablePrinter {
+	includeHeaders := len(headers.columns) > 0
+	if show := ios.TableHeaders(); show != nil {
+		includeHeaders = *show
+	}
+
+	tp := &TablePrinter{
+		isTTY: false,
+		cs:    ios.ColorScheme(),
+		csv: &csvTablePrinter{
+			out:         ios.Out,
+			includeHead: includeHeaders,
+		},
+	}
+	if includeHeaders {
+		for i := range headers.columns {
+			headers.columns[i] = strings.ToUpper(headers.columns[i])
+		}
+		tp.AddHeader(headers.columns)
+	}
+	return tp
 }
 
 // NewWithWriter creates a TablePrinter from a Writer, whether the output is a terminal, the terminal width, and more.
@@ -0,0 +1,79 @@
+package tableprinter
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTablePrinter_csvFormat(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetTableFormat("csv")
+
+	tp := New(ios, WithHeader("name", "description"))
+	tp.AddField("cli/cli")
+	tp.AddField("GitHub's official command line tool, with \"quotes\" and a,comma")
+	tp.EndRow()
+	tp.AddField("cli/go-gh")
+	tp.AddField("")
+	tp.EndRow()
+
+	require.NoError(t, tp.Render())
+	assert.Equal(t, "NAME,DESCRIPTION\ncli/cli,\"GitHub's official command line tool, with \"\"quotes\"\" and a,comma\"\ncli/go-gh,\n", stdout.String())
+}
+
+func TestTablePrinter_csvFormat_noHeaders(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetTableFormat("csv")
+	ios.SetTableHeaders(false)
+
+	tp := New(ios, WithHeader("name"))
+	tp.AddField("cli/cli")
+	tp.EndRow()
+
+	require.NoError(t, tp.Render())
+	assert.Equal(t, "cli/cli\n", stdout.String())
+}
+
+func TestTablePrinter_tsvFormat_forcedOnTTY(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetTableFormat("tsv")
+
+	tp := New(ios, WithHeader("name"))
+	tp.AddField("cli/cli")
+	tp.EndRow()
+
+	require.NoError(t, tp.Render())
+	assert.Equal(t, "cli/cli\n", stdout.String())
+	assert.False(t, tp.IsTTY())
+}
+
+func TestTablePrinter_tableFormat_forcedOffTTY(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(false)
+	ios.SetTableFormat("table")
+
+	tp := New(ios, WithHeader("name"))
+	tp.AddField("cli/cli")
+	tp.EndRow()
+
+	require.NoError(t, tp.Render())
+	assert.True(t, tp.IsTTY())
+	assert.Equal(t, "NAME\ncli/cli\n", stdout.String())
+}
+
+func TestTablePrinter_addFieldOptions_stillRenderInTTYMode(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	tp := New(ios, NoHeader)
+	tp.AddField("a", WithTruncate(nil))
+	tp.AddField("b", WithPadding(nil))
+	tp.EndRow()
+
+	require.NoError(t, tp.Render())
+	assert.Equal(t, "a  b\n", stdout.String())
+}
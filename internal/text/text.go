@@ -45,6 +45,20 @@ func FuzzyAgo(a, b time.Time) string {
 	return text.RelativeTimeAgo(a, b)
 }
 
+// FormatTimestamp renders t according to style: "absolute" prints t in the local
+// timezone, "iso8601" prints t in UTC using RFC 3339, and "relative" (or "", the
+// default) falls back to FuzzyAgo.
+func FormatTimestamp(now, t time.Time, style string) string {
+	switch style {
+	case "absolute":
+		return t.Local().Format("2006-01-02 15:04:05")
+	case "iso8601":
+		return t.UTC().Format(time.RFC3339)
+	default:
+		return FuzzyAgo(now, t)
+	}
+}
+
 // FuzzyAgoAbbr is an abbreviated version of FuzzyAgo. It returns a human readable string of the
 // time duration between a and b that is estimated to the nearest unit of time.
 func FuzzyAgoAbbr(a, b time.Time) string {
@@ -54,3 +54,74 @@ func TestFuzzyAgoAbbr(t *testing.T) {
 		assert.Equal(t, expected, fuzzy)
 	}
 }
+
+func TestFormatTimestamp(t *testing.T) {
+	// America/Los_Angeles switched from PST (UTC-8) to PDT (UTC-7) at
+	// 2023-03-12T10:00:00Z. FormatTimestamp's "absolute" style uses time.Local,
+	// so pin it to that zone for the duration of the test.
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("skipping test, can't load timezone: %v", err)
+	}
+	orig := time.Local
+	time.Local = loc
+	defer func() { time.Local = orig }()
+
+	now := time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		t     time.Time
+		style string
+		want  string
+	}{
+		{
+			name:  "relative",
+			t:     now.Add(-time.Hour),
+			style: "relative",
+			want:  FuzzyAgo(now, now.Add(-time.Hour)),
+		},
+		{
+			name:  "empty style defaults to relative",
+			t:     now.Add(-time.Hour),
+			style: "",
+			want:  FuzzyAgo(now, now.Add(-time.Hour)),
+		},
+		{
+			name:  "absolute before DST boundary",
+			t:     time.Date(2023, 3, 12, 9, 59, 0, 0, time.UTC),
+			style: "absolute",
+			want:  "2023-03-12 01:59:00",
+		},
+		{
+			name:  "absolute after DST boundary",
+			t:     time.Date(2023, 3, 12, 10, 1, 0, 0, time.UTC),
+			style: "absolute",
+			want:  "2023-03-12 03:01:00",
+		},
+		{
+			name:  "iso8601 is always UTC regardless of local DST",
+			t:     time.Date(2023, 3, 12, 10, 1, 0, 0, time.UTC),
+			style: "iso8601",
+			want:  "2023-03-12T10:01:00Z",
+		},
+		{
+			name:  "zero time absolute",
+			t:     time.Time{},
+			style: "absolute",
+			want:  time.Time{}.Local().Format("2006-01-02 15:04:05"),
+		},
+		{
+			name:  "zero time iso8601",
+			t:     time.Time{},
+			style: "iso8601",
+			want:  "0001-01-01T00:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatTimestamp(now, tt.t, tt.style)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
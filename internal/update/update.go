@@ -19,6 +19,12 @@ import (
 
 var gitDescribeSuffixRE = regexp.MustCompile(`\d+-\d+-g[a-f0-9]{8}$`)
 
+// ReleaseChannel selects which releases the update checker considers.
+const (
+	ChannelStable     = "stable"
+	ChannelPrerelease = "prerelease"
+)
+
 // ReleaseInfo stores information about a release
 type ReleaseInfo struct {
 	Version     string    `json:"tag_name"`
@@ -31,14 +37,18 @@ type StateEntry struct {
 	LatestRelease      ReleaseInfo `yaml:"latest_release"`
 }
 
-// CheckForUpdate checks whether this software has had a newer release on GitHub
-func CheckForUpdate(ctx context.Context, client *http.Client, stateFilePath, repo, currentVersion string) (*ReleaseInfo, error) {
+// CheckForUpdate checks whether this software has had a newer release on GitHub.
+// Results are cached separately per channel, so switching channels doesn't reuse
+// another channel's cached answer.
+func CheckForUpdate(ctx context.Context, client *http.Client, stateFilePath, repo, currentVersion, channel string) (*ReleaseInfo, error) {
+	stateFilePath = stateFilePathForChannel(stateFilePath, channel)
+
 	stateEntry, _ := getStateEntry(stateFilePath)
 	if stateEntry != nil && time.Since(stateEntry.CheckedForUpdateAt).Hours() < 24 {
 		return nil, nil
 	}
 
-	releaseInfo, err := getLatestReleaseInfo(ctx, client, repo)
+	releaseInfo, err := getLatestReleaseInfo(ctx, client, repo, channel)
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +65,26 @@ func CheckForUpdate(ctx context.Context, client *http.Client, stateFilePath, rep
 	return nil, nil
 }
 
-func getLatestReleaseInfo(ctx context.Context, client *http.Client, repo string) (*ReleaseInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://api.github.com/repos/%s/releases/latest", repo), nil)
+// stateFilePathForChannel returns a cache path unique to the given channel, so
+// the stable and prerelease channels don't clobber each other's cached result.
+func stateFilePathForChannel(stateFilePath, channel string) string {
+	if channel == "" || channel == ChannelStable {
+		return stateFilePath
+	}
+	ext := filepath.Ext(stateFilePath)
+	base := strings.TrimSuffix(stateFilePath, ext)
+	return fmt.Sprintf("%s-%s%s", base, channel, ext)
+}
+
+func getLatestReleaseInfo(ctx context.Context, client *http.Client, repo, channel string) (*ReleaseInfo, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/releases/latest", repo)
+	if channel == ChannelPrerelease {
+		// the "latest release" endpoint never returns prereleases, so list
+		// releases instead and take the newest one, prerelease or not.
+		url = fmt.Sprintf("https://api.github.com/repos/%s/releases?per_page=1", repo)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +99,19 @@ func getLatestReleaseInfo(ctx context.Context, client *http.Client, repo string)
 	if res.StatusCode != 200 {
 		return nil, fmt.Errorf("unexpected HTTP %d", res.StatusCode)
 	}
+
 	dec := json.NewDecoder(res.Body)
+	if channel == ChannelPrerelease {
+		var releases []ReleaseInfo
+		if err := dec.Decode(&releases); err != nil {
+			return nil, err
+		}
+		if len(releases) == 0 {
+			return nil, fmt.Errorf("no releases found for %s", repo)
+		}
+		return &releases[0], nil
+	}
+
 	var latestRelease ReleaseInfo
 	if err := dec.Decode(&latestRelease); err != nil {
 		return nil, err
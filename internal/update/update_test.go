@@ -84,7 +84,7 @@ func TestCheckForUpdate(t *testing.T) {
 				}`, s.LatestVersion, s.LatestURL)),
 			)
 
-			rel, err := CheckForUpdate(context.TODO(), httpClient, tempFilePath(), "OWNER/REPO", s.CurrentVersion)
+			rel, err := CheckForUpdate(context.TODO(), httpClient, tempFilePath(), "OWNER/REPO", s.CurrentVersion, ChannelStable)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -117,6 +117,77 @@ func TestCheckForUpdate(t *testing.T) {
 	}
 }
 
+func TestCheckForUpdatePrereleaseChannel(t *testing.T) {
+	reg := &httpmock.Registry{}
+	httpClient := &http.Client{}
+	httpmock.ReplaceTripper(httpClient, reg)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/releases"),
+		httpmock.StringResponse(`[{
+			"tag_name": "v1.1.0-rc.1",
+			"html_url": "https://www.spacejam.com/archive/spacejam/movie/jam.htm"
+		}]`),
+	)
+
+	rel, err := CheckForUpdate(context.TODO(), httpClient, tempFilePath(), "OWNER/REPO", "v1.0.0", ChannelPrerelease)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reg.Requests) != 1 {
+		t.Fatalf("expected 1 HTTP request, got %d", len(reg.Requests))
+	}
+	requestPath := reg.Requests[0].URL.Path
+	if requestPath != "/repos/OWNER/REPO/releases" {
+		t.Errorf("HTTP path: %q", requestPath)
+	}
+
+	if rel == nil {
+		t.Fatal("expected to report new release")
+	}
+	if rel.Version != "v1.1.0-rc.1" {
+		t.Errorf("Version: %q", rel.Version)
+	}
+}
+
+func TestCheckForUpdateCachesPerChannel(t *testing.T) {
+	reg := &httpmock.Registry{}
+	httpClient := &http.Client{}
+	httpmock.ReplaceTripper(httpClient, reg)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/releases/latest"),
+		httpmock.StringResponse(`{"tag_name": "v1.0.0", "html_url": ""}`),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/releases"),
+		httpmock.StringResponse(`[{"tag_name": "v1.1.0-rc.1", "html_url": ""}]`),
+	)
+
+	stateFilePath := tempFilePath()
+
+	if _, err := CheckForUpdate(context.TODO(), httpClient, stateFilePath, "OWNER/REPO", "v1.0.0", ChannelStable); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CheckForUpdate(context.TODO(), httpClient, stateFilePath, "OWNER/REPO", "v1.0.0", ChannelPrerelease); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reg.Requests) != 2 {
+		t.Fatalf("expected the stable and prerelease channels to each make their own request, got %d", len(reg.Requests))
+	}
+
+	// checking the stable channel again should hit its own cache, not the one
+	// populated by the prerelease channel check above.
+	if _, err := CheckForUpdate(context.TODO(), httpClient, stateFilePath, "OWNER/REPO", "v1.0.0", ChannelStable); err != nil {
+		t.Fatal(err)
+	}
+	if len(reg.Requests) != 2 {
+		t.Fatalf("expected the cached stable channel result to be reused, got %d requests", len(reg.Requests))
+	}
+}
+
 func tempFilePath() string {
 	file, err := os.CreateTemp("", "")
 	if err != nil {
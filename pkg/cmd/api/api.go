@@ -42,17 +42,20 @@ type ApiOptions struct {
 	RequestMethodPassed bool
 	RequestPath         string
 	RequestInputFile    string
+	RequestContentType  string
 	MagicFields         []string
 	RawFields           []string
 	RequestHeaders      []string
 	Previews            []string
 	ShowResponseHeaders bool
+	ShowCost            bool
 	Paginate            bool
 	Silent              bool
 	Template            string
 	CacheTTL            time.Duration
 	FilterOutput        string
 	Verbose             bool
+	Timeout             time.Duration
 }
 
 func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command {
@@ -109,12 +112,17 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			To pass pre-constructed JSON or payloads in other formats, a request body may be read
 			from file specified by %[1]s--input%[1]s. Use %[1]s-%[1]s to read from standard input. When passing the
 			request body this way, any parameters specified via field flags are added to the query
-			string of the endpoint URL.
+			string of the endpoint URL. The %[1]sContent-Type%[1]s of the request is guessed from the
+			file's extension, or can be set explicitly with %[1]s--content-type%[1]s.
 
 			In %[1]s--paginate%[1]s mode, all pages of results will sequentially be requested until
 			there are no more pages of results. For GraphQL requests, this requires that the
 			original query accepts an %[1]s$endCursor: String%[1]s variable and that it fetches the
 			%[1]spageInfo{ hasNextPage, endCursor }%[1]s set of fields from a collection.
+
+			For GraphQL requests, use %[1]s--show-cost%[1]s to report the query's %[1]srateLimit%[1]s cost
+			alongside the response. It is added to the query under its own alias, so it won't
+			conflict with a %[1]srateLimit%[1]s selection the query already has.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# list releases in the current repository
@@ -167,6 +175,9 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			    }
 			  }
 			'
+
+			# load a large GraphQL query from a file
+			$ gh api graphql -F query=@query.graphql
 		`),
 		Annotations: map[string]string{
 			"help:environment": heredoc.Doc(`
@@ -201,6 +212,10 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 				return cmdutil.FlagErrorf("the `--paginate` option is not supported for non-GET requests")
 			}
 
+			if opts.ShowCost && opts.RequestPath != "graphql" {
+				return cmdutil.FlagErrorf("the `--show-cost` option is only supported for graphql requests")
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"the `--paginate` option is not supported with `--input`",
 				opts.Paginate,
@@ -233,13 +248,16 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().StringArrayVarP(&opts.RequestHeaders, "header", "H", nil, "Add a HTTP request header in `key:value` format")
 	cmd.Flags().StringSliceVarP(&opts.Previews, "preview", "p", nil, "GitHub API preview `names` to request (without the \"-preview\" suffix)")
 	cmd.Flags().BoolVarP(&opts.ShowResponseHeaders, "include", "i", false, "Include HTTP response status line and headers in the output")
+	cmd.Flags().BoolVar(&opts.ShowCost, "show-cost", false, "Report the GraphQL rate limit cost of the query")
 	cmd.Flags().BoolVar(&opts.Paginate, "paginate", false, "Make additional HTTP requests to fetch all pages of results")
 	cmd.Flags().StringVar(&opts.RequestInputFile, "input", "", "The `file` to use as body for the HTTP request (use \"-\" to read from standard input)")
+	cmd.Flags().StringVar(&opts.RequestContentType, "content-type", "", "The content `type` to use for the request body given by `--input`; inferred from the file extension when omitted")
 	cmd.Flags().BoolVar(&opts.Silent, "silent", false, "Do not print the response body")
 	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", "Format JSON output using a Go template; see \"gh help formatting\"")
 	cmd.Flags().StringVarP(&opts.FilterOutput, "jq", "q", "", "Query to select values from the response using jq syntax")
 	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the response, e.g. \"3600s\", \"60m\", \"1h\"")
 	cmd.Flags().BoolVar(&opts.Verbose, "verbose", false, "Include full HTTP request and response in the output")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 0, "The time `duration` to wait before giving up on a request, e.g. \"5s\", \"2m\"; 0 means no timeout")
 	return cmd
 }
 
@@ -250,6 +268,18 @@ func apiRun(opts *ApiOptions) error {
 	}
 
 	isGraphQL := opts.RequestPath == "graphql"
+	if isGraphQL && opts.ShowCost {
+		query, ok := params["query"].(string)
+		if !ok {
+			return errors.New("`--show-cost` requires a `query` field")
+		}
+		query, err := addRateLimitCostField(query)
+		if err != nil {
+			return fmt.Errorf("failed to add `--show-cost` field to query: %w", err)
+		}
+		params["query"] = query
+	}
+
 	requestPath, err := fillPlaceholders(opts.RequestPath, opts)
 	if err != nil {
 		return fmt.Errorf("unable to expand placeholder in path: %w", err)
@@ -280,6 +310,9 @@ func apiRun(opts *ApiOptions) error {
 		if size >= 0 {
 			requestHeaders = append([]string{fmt.Sprintf("Content-Length: %d", size)}, requestHeaders...)
 		}
+		if contentType := inputContentType(opts.RequestContentType, opts.RequestInputFile, requestHeaders); contentType != "" {
+			requestHeaders = append([]string{fmt.Sprintf("Content-Type: %s", contentType)}, requestHeaders...)
+		}
 	}
 
 	if len(opts.Previews) > 0 {
@@ -305,6 +338,7 @@ func apiRun(opts *ApiOptions) error {
 				Log:            log,
 				LogColorize:    opts.IO.ColorEnabled(),
 				LogVerboseHTTP: opts.Verbose,
+				Timeout:        opts.Timeout,
 			}
 			return api.NewHTTPClient(opts)
 		}
@@ -408,7 +442,8 @@ func processResponse(resp *http.Response, opts *ApiOptions, bodyWriter, headersW
 
 	var bodyCopy *bytes.Buffer
 	isGraphQLPaginate := isJSON && resp.StatusCode == 200 && opts.Paginate && opts.RequestPath == "graphql"
-	if isGraphQLPaginate {
+	wantsCost := isJSON && resp.StatusCode == 200 && opts.RequestPath == "graphql" && opts.ShowCost
+	if isGraphQLPaginate || wantsCost {
 		bodyCopy = &bytes.Buffer{}
 		responseBody = io.TeeReader(responseBody, bodyCopy)
 	}
@@ -463,6 +498,12 @@ func processResponse(resp *http.Response, opts *ApiOptions, bodyWriter, headersW
 		endCursor = findEndCursor(bodyCopy)
 	}
 
+	if wantsCost {
+		if cost := findRateLimitCost(bodyCopy); cost != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "gh: %s\n", cost)
+		}
+	}
+
 	return
 }
 
@@ -546,6 +587,40 @@ func openUserFile(fn string, stdin io.ReadCloser) (io.ReadCloser, int64, error)
 	return r, s.Size(), nil
 }
 
+// extensionContentTypes maps file extensions recognized by --input to the
+// Content-Type header that should be sent with the request body.
+var extensionContentTypes = map[string]string{
+	".json": "application/json",
+	".xml":  "application/xml",
+	".yaml": "application/yaml",
+	".yml":  "application/yaml",
+	".txt":  "text/plain",
+}
+
+// inputContentType determines the Content-Type to use for a request body
+// read via --input. An explicit `--content-type` flag wins, followed by a
+// guess based on the input file's extension. It returns "" when a
+// Content-Type header was already supplied via -H, or when neither a flag
+// nor a recognized extension is available, preserving the caller's existing
+// headers.
+func inputContentType(explicit, filename string, existingHeaders []string) string {
+	for _, h := range existingHeaders {
+		name := h
+		if idx := strings.IndexRune(h, ':'); idx != -1 {
+			name = h[:idx]
+		}
+		if strings.EqualFold(strings.TrimSpace(name), "Content-Type") {
+			return ""
+		}
+	}
+
+	if explicit != "" {
+		return explicit
+	}
+
+	return extensionContentTypes[strings.ToLower(filepath.Ext(filename))]
+}
+
 func parseErrorResponse(r io.Reader, statusCode int) (io.Reader, string, error) {
 	bodyCopy := &bytes.Buffer{}
 	b, err := io.ReadAll(io.TeeReader(r, bodyCopy))
@@ -590,8 +665,10 @@ func parseErrorResponse(r io.Reader, statusCode int) (io.Reader, string, error)
 
 	var objectError struct {
 		Message string
+		Type    string
 	}
 	var errors []string
+	seenTypes := map[string]bool{}
 	for _, rawErr := range errorObjects {
 		if len(rawErr) == 0 {
 			continue
@@ -602,6 +679,10 @@ func parseErrorResponse(r io.Reader, statusCode int) (io.Reader, string, error)
 				return bodyCopy, "", err
 			}
 			errors = append(errors, objectError.Message)
+			if guidance, ok := graphQLErrorGuidance[objectError.Type]; ok && !seenTypes[objectError.Type] {
+				errors = append(errors, guidance)
+				seenTypes[objectError.Type] = true
+			}
 		} else if rawErr[0] == '"' {
 			var stringError string
 			err := json.Unmarshal(rawErr, &stringError)
@@ -619,6 +700,13 @@ func parseErrorResponse(r io.Reader, statusCode int) (io.Reader, string, error)
 	return bodyCopy, "", nil
 }
 
+// graphQLErrorGuidance maps GraphQL error `type` codes to a follow-up hint
+// that's printed alongside the error message, pointing at the likely fix.
+var graphQLErrorGuidance = map[string]string{
+	"RATE_LIMITED":            "you've exceeded the primary rate limit; wait for it to reset or reduce the rate of requests",
+	"MAX_NODE_LIMIT_EXCEEDED": "the query requested too many nodes at once; add pagination (e.g. `first`/`after`) to one of its connections",
+}
+
 func previewNamesToMIMETypes(names []string) string {
 	types := []string{fmt.Sprintf("application/vnd.github.%s-preview+json", names[0])}
 	for _, p := range names[1:] {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -56,6 +57,29 @@ func Test_NewCmdApi(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name: "with timeout",
+			cli:  "graphql --timeout=5s",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "GET",
+				RequestMethodPassed: false,
+				RequestPath:         "graphql",
+				RequestInputFile:    "",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+				Verbose:             false,
+				Timeout:             5 * time.Second,
+			},
+			wantsErr: false,
+		},
 		{
 			name: "override method",
 			cli:  "repos/octocat/Spoon-Knife -XDELETE",
@@ -198,6 +222,34 @@ func Test_NewCmdApi(t *testing.T) {
 			cli:      "--input repos/OWNER/REPO/issues --paginate",
 			wantsErr: true,
 		},
+		{
+			name: "GraphQL show cost",
+			cli:  "graphql --show-cost",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "GET",
+				RequestMethodPassed: false,
+				RequestPath:         "graphql",
+				RequestInputFile:    "",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				ShowCost:            true,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+				Verbose:             false,
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "show cost on non-GraphQL endpoint",
+			cli:      "repos/octocat/Spoon-Knife --show-cost",
+			wantsErr: true,
+		},
 		{
 			name: "with request body from file",
 			cli:  "user --input myfile",
@@ -220,6 +272,29 @@ func Test_NewCmdApi(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name: "with content type",
+			cli:  "user --input myfile --content-type application/xml",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "GET",
+				RequestMethodPassed: false,
+				RequestPath:         "user",
+				RequestInputFile:    "myfile",
+				RequestContentType:  "application/xml",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+				Verbose:             false,
+			},
+			wantsErr: false,
+		},
 		{
 			name:     "no arguments",
 			cli:      "",
@@ -377,16 +452,19 @@ func Test_NewCmdApi(t *testing.T) {
 			assert.Equal(t, tt.wants.RequestMethodPassed, opts.RequestMethodPassed)
 			assert.Equal(t, tt.wants.RequestPath, opts.RequestPath)
 			assert.Equal(t, tt.wants.RequestInputFile, opts.RequestInputFile)
+			assert.Equal(t, tt.wants.RequestContentType, opts.RequestContentType)
 			assert.Equal(t, tt.wants.RawFields, opts.RawFields)
 			assert.Equal(t, tt.wants.MagicFields, opts.MagicFields)
 			assert.Equal(t, tt.wants.RequestHeaders, opts.RequestHeaders)
 			assert.Equal(t, tt.wants.ShowResponseHeaders, opts.ShowResponseHeaders)
+			assert.Equal(t, tt.wants.ShowCost, opts.ShowCost)
 			assert.Equal(t, tt.wants.Paginate, opts.Paginate)
 			assert.Equal(t, tt.wants.Silent, opts.Silent)
 			assert.Equal(t, tt.wants.CacheTTL, opts.CacheTTL)
 			assert.Equal(t, tt.wants.Template, opts.Template)
 			assert.Equal(t, tt.wants.FilterOutput, opts.FilterOutput)
 			assert.Equal(t, tt.wants.Verbose, opts.Verbose)
+			assert.Equal(t, tt.wants.Timeout, opts.Timeout)
 		})
 	}
 }
@@ -493,6 +571,36 @@ func Test_apiRun(t *testing.T) {
 			stderr: "gh: AGAIN\nFINE\n",
 			isatty: false,
 		},
+		{
+			name: "GraphQL rate limited error includes guidance",
+			options: ApiOptions{
+				RequestPath: "graphql",
+			},
+			httpResponse: &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"errors": [{"type":"RATE_LIMITED","message":"API rate limit exceeded"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			},
+			err:    cmdutil.SilentError,
+			stdout: `{"errors": [{"type":"RATE_LIMITED","message":"API rate limit exceeded"}]}`,
+			stderr: "gh: API rate limit exceeded\nyou've exceeded the primary rate limit; wait for it to reset or reduce the rate of requests\n",
+			isatty: false,
+		},
+		{
+			name: "GraphQL max node limit error includes guidance once",
+			options: ApiOptions{
+				RequestPath: "graphql",
+			},
+			httpResponse: &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"errors": [{"type":"MAX_NODE_LIMIT_EXCEEDED","message":"too many nodes: A"}, {"type":"MAX_NODE_LIMIT_EXCEEDED","message":"too many nodes: B"}]}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			},
+			err:    cmdutil.SilentError,
+			stdout: `{"errors": [{"type":"MAX_NODE_LIMIT_EXCEEDED","message":"too many nodes: A"}, {"type":"MAX_NODE_LIMIT_EXCEEDED","message":"too many nodes: B"}]}`,
+			stderr: "gh: too many nodes: A\nthe query requested too many nodes at once; add pagination (e.g. `first`/`after`) to one of its connections\ntoo many nodes: B\n",
+			isatty: false,
+		},
 		{
 			name: "failure",
 			httpResponse: &http.Response{
@@ -856,6 +964,74 @@ func Test_apiRun_paginationGraphQL(t *testing.T) {
 	assert.Equal(t, "PAGE1_END", endCursor)
 }
 
+func Test_apiRun_showCost(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantQuery string
+	}{
+		{
+			name:      "simple query",
+			query:     `query { viewer { login } }`,
+			wantQuery: `query { viewer { login }  __ghApiShowCost: rateLimit { cost remaining resetAt } }`,
+		},
+		{
+			name:      "query that already selects rateLimit",
+			query:     `query { viewer { login } rateLimit { cost } }`,
+			wantQuery: `query { viewer { login } rateLimit { cost }  __ghApiShowCost: rateLimit { cost remaining resetAt } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+
+			var gotRequest *http.Request
+			options := ApiOptions{
+				IO: ios,
+				HttpClient: func() (*http.Client, error) {
+					var tr roundTripper = func(req *http.Request) (*http.Response, error) {
+						gotRequest = req
+						return &http.Response{
+							Request:    req,
+							StatusCode: 200,
+							Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+							Body: io.NopCloser(bytes.NewBufferString(`{
+								"data": {
+									"viewer": {"login": "monalisa"},
+									"__ghApiShowCost": {"cost": 1, "remaining": 4999, "resetAt": "2021-01-01T00:00:00Z"}
+								}
+							}`)),
+						}, nil
+					}
+					return &http.Client{Transport: tr}, nil
+				},
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+
+				RequestPath: "graphql",
+				RawFields:   []string{"query=" + tt.query},
+				ShowCost:    true,
+			}
+
+			err := apiRun(&options)
+			require.NoError(t, err)
+
+			assert.Contains(t, stdout.String(), `"login": "monalisa"`)
+			assert.Equal(t, "gh: rate limit cost: 1 (remaining: 4999, resets at 2021-01-01T00:00:00Z)\n", stderr.String())
+
+			var requestData struct {
+				Query string
+			}
+			bb, err := io.ReadAll(gotRequest.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(bb, &requestData))
+			assert.Equal(t, tt.wantQuery, requestData.Query)
+		})
+	}
+}
+
 func Test_apiRun_paginated_template(t *testing.T) {
 	ios, _, stdout, stderr := iostreams.Test()
 	ios.SetStdoutTTY(true)
@@ -982,14 +1158,44 @@ func Test_apiRun_DELETE(t *testing.T) {
 	}
 }
 
-func Test_apiRun_inputFile(t *testing.T) {
+func Test_inputContentType(t *testing.T) {
 	tests := []struct {
-		name          string
-		inputFile     string
-		inputContents []byte
+		name            string
+		explicit        string
+		filename        string
+		existingHeaders []string
+		want            string
+	}{
+		{name: "json extension", filename: "body.json", want: "application/json"},
+		{name: "xml extension", filename: "body.xml", want: "application/xml"},
+		{name: "yaml extension", filename: "body.yaml", want: "application/yaml"},
+		{name: "unrecognized extension", filename: "body.bin", want: ""},
+		{name: "no extension", filename: "body", want: ""},
+		{name: "explicit flag wins over extension", explicit: "text/plain", filename: "body.json", want: "text/plain"},
+		{
+			name:            "existing Content-Type header is left alone",
+			filename:        "body.json",
+			existingHeaders: []string{"Content-Type: application/vnd.custom+json"},
+			want:            "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inputContentType(tt.explicit, tt.filename, tt.existingHeaders))
+		})
+	}
+}
 
-		contentLength    int64
-		expectedContents []byte
+func Test_apiRun_inputFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputFile      string
+		inputContents  []byte
+		contentType    string
+		requestHeaders []string
+
+		contentLength       int64
+		expectedContentType string
 	}{
 		{
 			name:          "stdin",
@@ -998,10 +1204,41 @@ func Test_apiRun_inputFile(t *testing.T) {
 			contentLength: 0,
 		},
 		{
-			name:          "from file",
-			inputFile:     "gh-test-file",
-			inputContents: []byte("I WORK OUT"),
-			contentLength: 10,
+			name:                "from file with unrecognized extension",
+			inputFile:           "gh-test-file",
+			inputContents:       []byte("I WORK OUT"),
+			contentLength:       10,
+			expectedContentType: "",
+		},
+		{
+			name:                "from file with .json extension",
+			inputFile:           "gh-test-file.json",
+			inputContents:       []byte(`{"body":"I WORK OUT"}`),
+			contentLength:       21,
+			expectedContentType: "application/json",
+		},
+		{
+			name:                "from file with .xml extension",
+			inputFile:           "gh-test-file.xml",
+			inputContents:       []byte(`<body>I WORK OUT</body>`),
+			contentLength:       23,
+			expectedContentType: "application/xml",
+		},
+		{
+			name:                "--content-type overrides extension detection",
+			inputFile:           "gh-test-file.json",
+			inputContents:       []byte(`not actually json`),
+			contentType:         "text/plain",
+			contentLength:       17,
+			expectedContentType: "text/plain",
+		},
+		{
+			name:                "explicit -H Content-Type wins over detection",
+			inputFile:           "gh-test-file.json",
+			inputContents:       []byte(`{}`),
+			requestHeaders:      []string{"Content-Type: application/vnd.custom+json"},
+			contentLength:       2,
+			expectedContentType: "application/vnd.custom+json",
 		},
 	}
 
@@ -1016,7 +1253,9 @@ func Test_apiRun_inputFile(t *testing.T) {
 			if tt.inputFile == "-" {
 				_, _ = stdin.Write(tt.inputContents)
 			} else {
-				f, err := os.CreateTemp(tempDir, tt.inputFile)
+				ext := filepath.Ext(tt.inputFile)
+				pattern := strings.TrimSuffix(tt.inputFile, ext) + "-*" + ext
+				f, err := os.CreateTemp(tempDir, pattern)
 				if err != nil {
 					t.Fatal(err)
 				}
@@ -1027,9 +1266,11 @@ func Test_apiRun_inputFile(t *testing.T) {
 
 			var bodyBytes []byte
 			options := ApiOptions{
-				RequestPath:      "hello",
-				RequestInputFile: inputFile,
-				RawFields:        []string{"a=b", "c=d"},
+				RequestPath:        "hello",
+				RequestInputFile:   inputFile,
+				RequestContentType: tt.contentType,
+				RequestHeaders:     tt.requestHeaders,
+				RawFields:          []string{"a=b", "c=d"},
 
 				IO: ios,
 				HttpClient: func() (*http.Client, error) {
@@ -1056,7 +1297,7 @@ func Test_apiRun_inputFile(t *testing.T) {
 			assert.Equal(t, "POST", resp.Request.Method)
 			assert.Equal(t, "/hello?a=b&c=d", resp.Request.URL.RequestURI())
 			assert.Equal(t, tt.contentLength, resp.Request.ContentLength)
-			assert.Equal(t, "", resp.Request.Header.Get("Content-Type"))
+			assert.Equal(t, tt.expectedContentType, resp.Request.Header.Get("Content-Type"))
 			assert.Equal(t, tt.inputContents, bodyBytes)
 		})
 	}
@@ -1101,6 +1342,36 @@ func Test_apiRun_cache(t *testing.T) {
 	assert.Equal(t, "", stderr.String(), "stderr")
 }
 
+func Test_apiRun_cache_onDisk(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"hello": "world"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	ios, _, _, _ := iostreams.Test()
+	options := ApiOptions{
+		IO: ios,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		RequestPath: server.URL + "/issues",
+		CacheTTL:    time.Minute,
+	}
+
+	t.Cleanup(func() {
+		cacheDir := filepath.Join(os.TempDir(), "gh-cli-cache")
+		os.RemoveAll(cacheDir)
+	})
+
+	assert.NoError(t, apiRun(&options))
+	assert.NoError(t, apiRun(&options))
+
+	assert.Equal(t, 1, requestCount, "second identical GET within the cache TTL should not hit the network")
+}
+
 func Test_openUserFile(t *testing.T) {
 	f, err := os.CreateTemp(t.TempDir(), "gh-test")
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_parseFields(t *testing.T) {
@@ -111,6 +113,26 @@ func Test_parseFields_nested(t *testing.T) {
 	`), "\n"), string(jsonData))
 }
 
+func Test_parseFields_queryFromFile(t *testing.T) {
+	queryFile := filepath.Join(t.TempDir(), "query.graphql")
+	err := os.WriteFile(queryFile, []byte("query { viewer { login } }"), 0600)
+	require.NoError(t, err)
+
+	ios, _, _, _ := iostreams.Test()
+	opts := ApiOptions{
+		IO: ios,
+		MagicFields: []string{
+			fmt.Sprintf("query=@%s", queryFile),
+		},
+	}
+
+	params, err := parseFields(&opts)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"query": "query { viewer { login } }",
+	}, params)
+}
+
 func Test_magicFieldValue(t *testing.T) {
 	f, err := os.CreateTemp(t.TempDir(), "gh-test")
 	if err != nil {
@@ -89,6 +89,73 @@ loop:
 	return ""
 }
 
+// rateLimitCostAlias is the GraphQL alias `--show-cost` requests the
+// rateLimit field's cost info under, so it never collides with a
+// `rateLimit` selection the user's own query already makes.
+const rateLimitCostAlias = "__ghApiShowCost"
+
+// addRateLimitCostField inserts a `rateLimit { cost remaining resetAt }`
+// selection, aliased via rateLimitCostAlias, into the outermost selection
+// set of a GraphQL query document. Using an alias means it's safe to add
+// even when the query already selects rateLimit under its own name.
+func addRateLimitCostField(query string) (string, error) {
+	start := strings.IndexByte(query, '{')
+	if start == -1 {
+		return "", fmt.Errorf("could not find a selection set in the query")
+	}
+
+	depth := 0
+	end := -1
+	for i := start; i < len(query); i++ {
+		switch query[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				end = i
+				break
+			}
+		}
+		if end != -1 {
+			break
+		}
+	}
+	if end == -1 {
+		return "", fmt.Errorf("could not find the end of the query's selection set")
+	}
+
+	field := fmt.Sprintf(" %s: rateLimit { cost remaining resetAt } ", rateLimitCostAlias)
+	return query[:end] + field + query[end:], nil
+}
+
+// findRateLimitCost extracts the rateLimit cost info that
+// addRateLimitCostField added to a GraphQL response body.
+func findRateLimitCost(r io.Reader) string {
+	var parsedBody struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(r).Decode(&parsedBody); err != nil {
+		return ""
+	}
+
+	raw, ok := parsedBody.Data[rateLimitCostAlias]
+	if !ok {
+		return ""
+	}
+
+	var rateLimit struct {
+		Cost      int    `json:"cost"`
+		Remaining int    `json:"remaining"`
+		ResetAt   string `json:"resetAt"`
+	}
+	if err := json.Unmarshal(raw, &rateLimit); err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("rate limit cost: %d (remaining: %d, resets at %s)", rateLimit.Cost, rateLimit.Remaining, rateLimit.ResetAt)
+}
+
 func addPerPage(p string, perPage int, params map[string]interface{}) string {
 	if _, hasPerPage := params["per_page"]; hasPerPage {
 		return p
@@ -117,6 +117,89 @@ func Test_findEndCursor(t *testing.T) {
 	}
 }
 
+func Test_addRateLimitCostField(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "simple query",
+			query: `query { viewer { login } }`,
+			want:  `query { viewer { login }  __ghApiShowCost: rateLimit { cost remaining resetAt } }`,
+		},
+		{
+			name:  "query with variables",
+			query: `query($name: String!) { repository(name: $name) { id } }`,
+			want:  `query($name: String!) { repository(name: $name) { id }  __ghApiShowCost: rateLimit { cost remaining resetAt } }`,
+		},
+		{
+			name:  "query that already selects rateLimit",
+			query: `{ viewer { login } rateLimit { cost } }`,
+			want:  `{ viewer { login } rateLimit { cost }  __ghApiShowCost: rateLimit { cost remaining resetAt } }`,
+		},
+		{
+			name:    "no selection set",
+			query:   `mutation`,
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced braces",
+			query:   `query { viewer { login }`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addRateLimitCostField(tt.query)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("addRateLimitCostField() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("addRateLimitCostField() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("addRateLimitCostField() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_findRateLimitCost(t *testing.T) {
+	tests := []struct {
+		name string
+		json io.Reader
+		want string
+	}{
+		{
+			name: "no data",
+			json: bytes.NewBufferString(`{}`),
+			want: "",
+		},
+		{
+			name: "alias not present",
+			json: bytes.NewBufferString(`{"data": {"viewer": {"login": "monalisa"}}}`),
+			want: "",
+		},
+		{
+			name: "cost present",
+			json: bytes.NewBufferString(`{"data": {"__ghApiShowCost": {"cost": 1, "remaining": 4999, "resetAt": "2021-01-01T00:00:00Z"}}}`),
+			want: "rate limit cost: 1 (remaining: 4999, resets at 2021-01-01T00:00:00Z)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findRateLimitCost(tt.json); got != tt.want {
+				t.Errorf("findRateLimitCost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_addPerPage(t *testing.T) {
 	type args struct {
 		p       string
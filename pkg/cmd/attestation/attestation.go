@@ -0,0 +1,32 @@
+package attestation
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdDownload "github.com/cli/cli/v2/pkg/cmd/attestation/download"
+	cmdVerify "github.com/cli/cli/v2/pkg/cmd/attestation/verify"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAttestation(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation <command>",
+		Short: "Work with artifact attestations",
+		Long: heredoc.Docf(`
+			Download and verify artifact attestations.
+
+			These commands check the digest and signing certificate recorded in
+			an attestation, fetched from GitHub or read from a previously
+			downloaded bundle. They do not perform full Sigstore verification
+			against the Fulcio root of trust or Rekor transparency log, so a
+			passing result means "the attestation is internally consistent", not
+			"GitHub's full chain of trust has been validated".
+		`, "`"),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+	cmd.AddCommand(cmdDownload.NewCmdDownload(f, nil))
+	cmd.AddCommand(cmdVerify.NewCmdVerify(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,149 @@
+package download
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DownloadOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+
+	Subject    string
+	Owner      string
+	Repo       string
+	OutputPath string
+}
+
+func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobra.Command {
+	opts := &DownloadOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "download [<file> | <digest>]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Download attestations for an artifact",
+		Long: heredoc.Doc(`
+			Download the attestations recorded for an artifact's digest and save
+			them to a file as newline-delimited JSON bundles.
+
+			The artifact can be identified either by a path to a local file, whose
+			sha256 digest is computed for you, or by passing the digest directly
+			as "sha256:<hex>".
+
+			The bundles saved by this command only include the signing
+			certificate and the signed in-toto statement; they do not include a
+			verified chain of trust to the Sigstore Fulcio and Rekor services.
+			Run "gh attestation verify" against the downloaded file to check the
+			statement's contents, with the caveats described there.
+		`),
+		Example: heredoc.Doc(`
+			# Download attestations for a local file
+			$ gh attestation download ./my-app --owner cli --output attestations.jsonl
+
+			# Download attestations by digest
+			$ gh attestation download sha256:1234... --owner cli --output attestations.jsonl
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Subject = args[0]
+
+			if opts.Owner == "" && opts.Repo == "" {
+				return cmdutil.FlagErrorf("one of `--owner` or `--repo` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return downloadRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Owner, "owner", "", "GitHub organization that the artifact is associated with")
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "GitHub repository that the artifact is associated with (`owner/repo`)")
+	cmd.Flags().StringVar(&opts.OutputPath, "output", "", "File path to write the downloaded bundles to (default: `<digest>.jsonl`)")
+
+	return cmd
+}
+
+func downloadRun(opts *DownloadOptions) error {
+	digest, err := shared.DigestForArg(opts.Subject)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	owner := opts.Owner
+	repo := ""
+	if opts.Repo != "" {
+		r, err := ghrepo.FromFullName(opts.Repo)
+		if err != nil {
+			return err
+		}
+		owner = r.RepoOwner()
+		repo = r.RepoName()
+		host = r.RepoHost()
+	}
+
+	attestations, err := shared.FetchAttestations(client, host, owner, repo, digest)
+	if err != nil {
+		return fmt.Errorf("failed to fetch attestations: %w", err)
+	}
+
+	outputPath := opts.OutputPath
+	if outputPath == "" {
+		outputPath = fmt.Sprintf("%s.jsonl", sanitizeDigest(digest))
+	}
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := shared.WriteBundles(f, attestations); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "Wrote %d attestation(s) to %s\n", len(attestations), outputPath)
+	}
+
+	return nil
+}
+
+func sanitizeDigest(digest string) string {
+	out := make([]rune, 0, len(digest))
+	for _, r := range digest {
+		if r == ':' {
+			out = append(out, '-')
+			continue
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
@@ -0,0 +1,120 @@
+package download
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdDownload(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wants   DownloadOptions
+		wantErr string
+	}{
+		{
+			name: "owner",
+			cli:  "sha256:abcd --owner cli",
+			wants: DownloadOptions{
+				Subject: "sha256:abcd",
+				Owner:   "cli",
+			},
+		},
+		{
+			name: "repo",
+			cli:  "sha256:abcd --repo cli/cli --output out.jsonl",
+			wants: DownloadOptions{
+				Subject:    "sha256:abcd",
+				Repo:       "cli/cli",
+				OutputPath: "out.jsonl",
+			},
+		},
+		{
+			name:    "missing owner and repo",
+			cli:     "sha256:abcd",
+			wantErr: "one of `--owner` or `--repo` is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+
+			var gotOpts *DownloadOptions
+			cmd := NewCmdDownload(f, func(opts *DownloadOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wants.Subject, gotOpts.Subject)
+			assert.Equal(t, tt.wants.Owner, gotOpts.Owner)
+			assert.Equal(t, tt.wants.Repo, gotOpts.Repo)
+			assert.Equal(t, tt.wants.OutputPath, gotOpts.OutputPath)
+		})
+	}
+}
+
+func Test_downloadRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/cli/cli/attestations/sha256:abcd"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"attestations": []map[string]interface{}{
+				{"bundle": map[string]interface{}{"mediaType": "application/vnd.dev.sigstore.bundle+json;version=0.1"}},
+			},
+		}),
+	)
+
+	dir := t.TempDir()
+	outputPath := filepath.Join(dir, "out.jsonl")
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &DownloadOptions{
+		IO:         ios,
+		Subject:    "sha256:abcd",
+		Repo:       "cli/cli",
+		OutputPath: outputPath,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	require.NoError(t, downloadRun(opts))
+	assert.Contains(t, stdout.String(), "Wrote 1 attestation(s)")
+
+	contents, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "application/vnd.dev.sigstore.bundle+json;version=0.1")
+}
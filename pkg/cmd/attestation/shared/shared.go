@@ -0,0 +1,231 @@
+// Package shared holds the types and helpers common to the gh attestation
+// subcommands: fetching bundles from the attestations API, and reading or
+// writing them as the newline-delimited JSON files used for offline storage.
+package shared
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// Bundle is a (deliberately partial) representation of a sigstore bundle: the
+// DSSE envelope carrying the in-toto attestation statement, and the signing
+// certificate that was used to produce it.
+type Bundle struct {
+	MediaType            string               `json:"mediaType"`
+	VerificationMaterial VerificationMaterial `json:"verificationMaterial"`
+	DsseEnvelope         DSSEEnvelope         `json:"dsseEnvelope"`
+}
+
+type VerificationMaterial struct {
+	Certificate Certificate `json:"certificate"`
+}
+
+// Certificate holds the raw DER bytes, base64-encoded, of the Fulcio signing
+// certificate embedded in the bundle.
+type Certificate struct {
+	RawBytes string `json:"rawBytes"`
+}
+
+// DSSEEnvelope is the signed wrapper around the in-toto attestation statement.
+type DSSEEnvelope struct {
+	Payload     string      `json:"payload"`
+	PayloadType string      `json:"payloadType"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a single signature entry of a DSSE envelope.
+type Signature struct {
+	Sig string `json:"sig"`
+}
+
+// Attestation is a single entry of the attestations API response.
+type Attestation struct {
+	Bundle Bundle `json:"bundle"`
+}
+
+type attestationsResponse struct {
+	Attestations []Attestation `json:"attestations"`
+}
+
+// Statement is an in-toto attestation statement, decoded from a bundle's DSSE
+// payload. Predicate is left undecoded since its shape depends on PredicateType.
+type Statement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []Subject       `json:"subject"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// PAE computes the DSSE v1 Pre-Authentication Encoding of payloadType and the
+// (already base64-decoded) payload, per
+// https://github.com/secure-systems-lab/dsse/blob/master/protocol.md. This is
+// the exact byte sequence a DSSE signature is produced over.
+func PAE(payloadType string, payload []byte) []byte {
+	pae := fmt.Sprintf("DSSEv1 %d %s %d ", len(payloadType), payloadType, len(payload))
+	return append([]byte(pae), payload...)
+}
+
+// VerifyEnvelopeSignature checks that at least one signature in the DSSE
+// envelope validates against cert's public key for the PAE of payloadType
+// and decodedPayload. It returns nil as soon as one signature checks out,
+// or the error from the last attempted signature if none do.
+func VerifyEnvelopeSignature(envelope DSSEEnvelope, decodedPayload []byte, cert *x509.Certificate) error {
+	if len(envelope.Signatures) == 0 {
+		return fmt.Errorf("DSSE envelope has no signatures")
+	}
+
+	algo, err := signatureAlgorithmFor(cert)
+	if err != nil {
+		return err
+	}
+
+	pae := PAE(envelope.PayloadType, decodedPayload)
+
+	var lastErr error
+	for _, sig := range envelope.Signatures {
+		raw, err := base64.StdEncoding.DecodeString(sig.Sig)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to decode signature: %w", err)
+			continue
+		}
+		if err := cert.CheckSignature(algo, pae, raw); err != nil {
+			lastErr = err
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("no signature in the DSSE envelope was produced by the certificate's private key: %w", lastErr)
+}
+
+func signatureAlgorithmFor(cert *x509.Certificate) (x509.SignatureAlgorithm, error) {
+	switch key := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return x509.ECDSAWithSHA256, nil
+		case elliptic.P384():
+			return x509.ECDSAWithSHA384, nil
+		case elliptic.P521():
+			return x509.ECDSAWithSHA512, nil
+		default:
+			return 0, fmt.Errorf("unsupported ECDSA curve %s", key.Curve.Params().Name)
+		}
+	case *rsa.PublicKey:
+		switch bits := key.Size() * 8; {
+		case bits <= 2048:
+			return x509.SHA256WithRSA, nil
+		case bits <= 3072:
+			return x509.SHA384WithRSA, nil
+		default:
+			return x509.SHA512WithRSA, nil
+		}
+	case ed25519.PublicKey:
+		return x509.PureEd25519, nil
+	default:
+		return 0, fmt.Errorf("unsupported signing key type %T", cert.PublicKey)
+	}
+}
+
+// SHA256Digest returns the "sha256:<hex>" digest of the file at path.
+func SHA256Digest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", h.Sum(nil)), nil
+}
+
+// DigestForArg resolves a user-supplied "<digest|file>" argument to a
+// "sha256:<hex>" digest, computing it from the file on disk when arg names an
+// existing file rather than being a digest already.
+func DigestForArg(arg string) (string, error) {
+	if info, err := os.Stat(arg); err == nil && !info.IsDir() {
+		return SHA256Digest(arg)
+	}
+	if len(arg) > len("sha256:") && arg[:len("sha256:")] == "sha256:" {
+		return arg, nil
+	}
+	return "", fmt.Errorf("%q is neither a readable file nor a sha256:<hex> digest", arg)
+}
+
+// FetchAttestations retrieves the attestations recorded for subjectDigest,
+// scoped to owner, or to owner/repo when repo is non-empty.
+func FetchAttestations(client *api.Client, host, owner, repo, subjectDigest string) ([]Attestation, error) {
+	var path string
+	if repo != "" {
+		path = fmt.Sprintf("repos/%s/%s/attestations/%s", owner, repo, subjectDigest)
+	} else {
+		path = fmt.Sprintf("orgs/%s/attestations/%s", owner, subjectDigest)
+	}
+
+	var resp attestationsResponse
+	if err := client.REST(host, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Attestations) == 0 {
+		return nil, fmt.Errorf("no attestations found for subject %s", subjectDigest)
+	}
+	return resp.Attestations, nil
+}
+
+// WriteBundles writes one bundle per line as newline-delimited JSON, the
+// format gh attestation download produces and gh attestation verify --bundle
+// reads back for offline use.
+func WriteBundles(w io.Writer, attestations []Attestation) error {
+	enc := json.NewEncoder(w)
+	for _, a := range attestations {
+		if err := enc.Encode(a.Bundle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadBundles reads bundles previously written by WriteBundles.
+func ReadBundles(r io.Reader) ([]Bundle, error) {
+	var bundles []Bundle
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var b Bundle
+		if err := json.Unmarshal(line, &b); err != nil {
+			return nil, fmt.Errorf("failed to parse bundle: %w", err)
+		}
+		bundles = append(bundles, b)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(bundles) == 0 {
+		return nil, fmt.Errorf("no bundles found")
+	}
+	return bundles, nil
+}
@@ -0,0 +1,144 @@
+package shared
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestForArg(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "artifact")
+	require.NoError(t, os.WriteFile(path, []byte("hello\n"), 0600))
+
+	digest, err := DigestForArg(path)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03", digest)
+
+	digest, err = DigestForArg("sha256:1234567890abcdef")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1234567890abcdef", digest)
+
+	_, err = DigestForArg("not-a-digest-and-not-a-file")
+	assert.Error(t, err)
+}
+
+func TestWriteAndReadBundles(t *testing.T) {
+	attestations := []Attestation{
+		{Bundle: Bundle{MediaType: "application/vnd.dev.sigstore.bundle+json;version=0.1"}},
+		{Bundle: Bundle{MediaType: "application/vnd.dev.sigstore.bundle+json;version=0.2"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundles(&buf, attestations))
+
+	bundles, err := ReadBundles(&buf)
+	require.NoError(t, err)
+	require.Len(t, bundles, 2)
+	assert.Equal(t, "application/vnd.dev.sigstore.bundle+json;version=0.1", bundles[0].MediaType)
+	assert.Equal(t, "application/vnd.dev.sigstore.bundle+json;version=0.2", bundles[1].MediaType)
+}
+
+func TestReadBundlesEmpty(t *testing.T) {
+	_, err := ReadBundles(bytes.NewBufferString(""))
+	assert.Error(t, err)
+}
+
+func TestSignatureAlgorithmFor(t *testing.T) {
+	tests := []struct {
+		curve elliptic.Curve
+		want  x509.SignatureAlgorithm
+	}{
+		{curve: elliptic.P256(), want: x509.ECDSAWithSHA256},
+		{curve: elliptic.P384(), want: x509.ECDSAWithSHA384},
+		{curve: elliptic.P521(), want: x509.ECDSAWithSHA512},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.curve.Params().Name, func(t *testing.T) {
+			key, err := ecdsa.GenerateKey(tt.curve, rand.Reader)
+			require.NoError(t, err)
+			cert := selfSignedCert(t, &key.PublicKey, key)
+
+			got, err := signatureAlgorithmFor(cert)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestVerifyEnvelopeSignature_nonP256Curve guards against assuming every
+// ECDSA certificate is P-256/SHA-256: a P-384 signer must be checked with
+// SHA-384, or a correctly produced signature would be rejected.
+func TestVerifyEnvelopeSignature_nonP256Curve(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	cert := selfSignedCert(t, &key.PublicKey, key)
+
+	payloadType := "application/vnd.in-toto+json"
+	payload := []byte(`{"_type":"https://in-toto.io/Statement/v1"}`)
+
+	algo, err := signatureAlgorithmFor(cert)
+	require.NoError(t, err)
+	hash := hashFuncFor(t, algo)
+	sig, err := key.Sign(rand.Reader, hashForAlgo(t, hash, PAE(payloadType, payload)), hash)
+	require.NoError(t, err)
+
+	envelope := DSSEEnvelope{
+		PayloadType: payloadType,
+		Signatures:  []Signature{{Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}
+	assert.NoError(t, VerifyEnvelopeSignature(envelope, payload, cert))
+}
+
+func hashFuncFor(t *testing.T, algo x509.SignatureAlgorithm) crypto.Hash {
+	t.Helper()
+	switch algo {
+	case x509.ECDSAWithSHA256:
+		return crypto.SHA256
+	case x509.ECDSAWithSHA384:
+		return crypto.SHA384
+	case x509.ECDSAWithSHA512:
+		return crypto.SHA512
+	default:
+		t.Fatalf("unexpected signature algorithm %v", algo)
+		return 0
+	}
+}
+
+func hashForAlgo(t *testing.T, hash crypto.Hash, data []byte) []byte {
+	t.Helper()
+	h := hash.New()
+	_, err := h.Write(data)
+	require.NoError(t, err)
+	return h.Sum(nil)
+}
+
+func selfSignedCert(t *testing.T, pub *ecdsa.PublicKey, priv *ecdsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
@@ -0,0 +1,314 @@
+package verify
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type VerifyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+
+	Subject        string
+	Owner          string
+	Repo           string
+	BundlePath     string
+	SignerWorkflow string
+	Format         string
+}
+
+// Result is the outcome of checking a single bundle against the requested
+// subject and, optionally, signer workflow. SignatureVerified reports
+// whether the DSSE envelope's signature was cryptographically verified
+// against the embedded certificate's public key. It is deliberately
+// explicit about FullChainVerified: this command does not validate that
+// certificate against the Sigstore Fulcio root, nor does it check Rekor for
+// a transparency log inclusion proof, so it never reports a bundle as fully
+// verified the way sigstore-go based tooling would.
+type Result struct {
+	DigestMatch       bool   `json:"digestMatch"`
+	SignatureVerified bool   `json:"signatureVerified"`
+	SignerWorkflow    string `json:"signerWorkflow,omitempty"`
+	SignerMatch       *bool  `json:"signerMatch,omitempty"`
+	PredicateType     string `json:"predicateType"`
+	CertificateExpiry string `json:"certificateExpiry,omitempty"`
+	FullChainVerified bool   `json:"fullChainVerified"`
+}
+
+func NewCmdVerify(f *cmdutil.Factory, runF func(*VerifyOptions) error) *cobra.Command {
+	opts := &VerifyOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify [<file> | <digest>]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify an artifact's attestation signature (not a full Sigstore chain verification)",
+		Long: heredoc.Doc(`
+			Verify that an artifact's digest matches the subject recorded in its
+			attestations, and optionally that the attestations were signed by a
+			given workflow.
+
+			IMPORTANT: this is signature verification, not full Sigstore
+			verification. This command checks that the DSSE envelope's signature
+			was produced by the private key of the certificate embedded in each
+			attestation bundle, and that the in-toto statement it wraps matches
+			the given subject. Bundles are either fetched live from GitHub or
+			read from a file previously saved with "gh attestation download" via
+			--bundle.
+
+			It does NOT validate the signing certificate itself against the
+			Sigstore Fulcio root of trust, and it does NOT check Rekor for a
+			transparency log inclusion proof, so it cannot confirm the
+			certificate was legitimately issued to the workflow it claims. A
+			passing result only means "this exact statement was signed by the
+			holder of this certificate" - do not treat it as a complete
+			chain-of-trust verification, and do not rely on it alone for
+			supply-chain policy decisions. "fullChainVerified" in --format json
+			output is always false for this reason.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Subject = args[0]
+
+			if opts.BundlePath == "" && opts.Owner == "" && opts.Repo == "" {
+				return cmdutil.FlagErrorf("specify `--bundle`, or one of `--owner`/`--repo` to fetch attestations")
+			}
+
+			if opts.Format != "" && opts.Format != "json" {
+				return cmdutil.FlagErrorf("unsupported --format %q: expected \"json\"", opts.Format)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return verifyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Owner, "owner", "", "GitHub organization that the artifact is associated with")
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "GitHub repository that the artifact is associated with (`owner/repo`)")
+	cmd.Flags().StringVar(&opts.BundlePath, "bundle", "", "Verify against bundles previously saved with `gh attestation download`, instead of fetching them")
+	cmd.Flags().StringVar(&opts.SignerWorkflow, "signer-workflow", "", "Require attestations to have been signed by this workflow (`owner/repo/.github/workflows/file.yml`)")
+	cmd.Flags().StringVar(&opts.Format, "format", "", "Output format: `json`")
+
+	return cmd
+}
+
+func verifyRun(opts *VerifyOptions) error {
+	digest, err := shared.DigestForArg(opts.Subject)
+	if err != nil {
+		return err
+	}
+
+	bundles, err := loadBundles(opts, digest)
+	if err != nil {
+		return err
+	}
+
+	results := make([]Result, 0, len(bundles))
+	for _, bundle := range bundles {
+		res, err := verifyBundle(bundle, digest, opts.SignerWorkflow)
+		if err != nil {
+			return err
+		}
+		results = append(results, res)
+	}
+
+	if opts.Format == "json" {
+		enc := json.NewEncoder(opts.IO.Out)
+		return enc.Encode(results)
+	}
+
+	return printResults(opts.IO, digest, results)
+}
+
+func loadBundles(opts *VerifyOptions, digest string) ([]shared.Bundle, error) {
+	if opts.BundlePath != "" {
+		f, err := os.Open(opts.BundlePath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return shared.ReadBundles(f)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	owner := opts.Owner
+	repo := ""
+	if opts.Repo != "" {
+		r, err := ghrepo.FromFullName(opts.Repo)
+		if err != nil {
+			return nil, err
+		}
+		owner = r.RepoOwner()
+		repo = r.RepoName()
+		host = r.RepoHost()
+	}
+
+	attestations, err := shared.FetchAttestations(client, host, owner, repo, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attestations: %w", err)
+	}
+
+	bundles := make([]shared.Bundle, 0, len(attestations))
+	for _, a := range attestations {
+		bundles = append(bundles, a.Bundle)
+	}
+	return bundles, nil
+}
+
+func verifyBundle(bundle shared.Bundle, wantDigest, signerWorkflow string) (Result, error) {
+	payload, err := base64.StdEncoding.DecodeString(bundle.DsseEnvelope.Payload)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to decode attestation payload: %w", err)
+	}
+
+	var statement shared.Statement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return Result{}, fmt.Errorf("failed to parse in-toto statement: %w", err)
+	}
+
+	res := Result{
+		PredicateType: statement.PredicateType,
+	}
+
+	wantHex := strings.TrimPrefix(wantDigest, "sha256:")
+	for _, subj := range statement.Subject {
+		if subj.Digest["sha256"] == wantHex {
+			res.DigestMatch = true
+			break
+		}
+	}
+
+	cert, err := decodeCertificate(bundle.VerificationMaterial.Certificate.RawBytes)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to parse signing certificate: %w", err)
+	}
+	if cert == nil {
+		return Result{}, fmt.Errorf("bundle is missing a signing certificate")
+	}
+
+	res.CertificateExpiry = cert.NotAfter.UTC().Format(time.RFC3339)
+	res.SignatureVerified = shared.VerifyEnvelopeSignature(bundle.DsseEnvelope, payload, cert) == nil
+
+	if signerWorkflow != "" {
+		res.SignerWorkflow = signerWorkflow
+		match := certMatchesWorkflow(cert, signerWorkflow)
+		res.SignerMatch = &match
+	}
+
+	return res, nil
+}
+
+func decodeCertificate(rawBytes string) (*x509.Certificate, error) {
+	if rawBytes == "" {
+		return nil, nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if block, _ := pem.Decode(der); block != nil {
+		der = block.Bytes
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// certMatchesWorkflow checks whether the certificate's Subject Alternative
+// Name URIs reference the given workflow. Fulcio embeds the GitHub Actions
+// job workflow ref as a SAN URI; this is a string match against that URI,
+// not a validated assertion that Fulcio itself issued the certificate.
+func certMatchesWorkflow(cert *x509.Certificate, signerWorkflow string) bool {
+	for _, u := range cert.URIs {
+		if strings.Contains(u.String(), signerWorkflow) {
+			return true
+		}
+	}
+	return false
+}
+
+func printResults(io *iostreams.IOStreams, digest string, results []Result) error {
+	cs := io.ColorScheme()
+
+	allGood := true
+	for _, res := range results {
+		if !res.DigestMatch || !res.SignatureVerified || (res.SignerMatch != nil && !*res.SignerMatch) {
+			allGood = false
+		}
+	}
+
+	fmt.Fprintf(io.Out, "%s this command only verifies the DSSE signature against the embedded certificate; it does not validate that certificate against the Sigstore Fulcio root of trust or check Rekor for a transparency log inclusion proof\n\n", cs.WarningIcon())
+	fmt.Fprintf(io.Out, "Loaded %s for %s\n\n", text(len(results), "attestation"), digest)
+
+	for i, res := range results {
+		fmt.Fprintf(io.Out, "%s #%d\n", cs.Bold("Attestation"), i+1)
+		fmt.Fprintf(io.Out, "- predicate type: %s\n", res.PredicateType)
+
+		if res.DigestMatch {
+			fmt.Fprintf(io.Out, "- %s subject digest matches\n", cs.SuccessIcon())
+		} else {
+			fmt.Fprintf(io.Out, "- %s subject digest does not match\n", cs.FailureIcon())
+		}
+
+		if res.SignatureVerified {
+			fmt.Fprintf(io.Out, "- %s signature verified against certificate\n", cs.SuccessIcon())
+		} else {
+			fmt.Fprintf(io.Out, "- %s signature could not be verified against certificate\n", cs.FailureIcon())
+		}
+
+		if res.SignerMatch != nil {
+			if *res.SignerMatch {
+				fmt.Fprintf(io.Out, "- %s signed by %s\n", cs.SuccessIcon(), res.SignerWorkflow)
+			} else {
+				fmt.Fprintf(io.Out, "- %s not signed by %s\n", cs.FailureIcon(), res.SignerWorkflow)
+			}
+		}
+
+		fmt.Fprintln(io.Out)
+	}
+
+	if !allGood {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+func text(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
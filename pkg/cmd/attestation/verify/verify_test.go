@@ -0,0 +1,243 @@
+package verify
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name: "owner",
+			cli:  "sha256:abcd --owner cli",
+		},
+		{
+			name:    "missing owner, repo and bundle",
+			cli:     "sha256:abcd",
+			wantErr: "specify `--bundle`, or one of `--owner`/`--repo` to fetch attestations",
+		},
+		{
+			name:    "unsupported format",
+			cli:     "sha256:abcd --owner cli --format yaml",
+			wantErr: `unsupported --format "yaml": expected "json"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+
+			cmd := NewCmdVerify(f, func(opts *VerifyOptions) error {
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func buildTestBundle(t *testing.T, subjectHex string, sanURI string) shared.Bundle {
+	t.Helper()
+
+	statement := shared.Statement{
+		Type:          "https://in-toto.io/Statement/v1",
+		PredicateType: "https://slsa.dev/provenance/v1",
+		Subject: []shared.Subject{
+			{Name: "artifact", Digest: map[string]string{"sha256": subjectHex}},
+		},
+	}
+	payload, err := json.Marshal(statement)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	if sanURI != "" {
+		u, err := url.Parse(sanURI)
+		require.NoError(t, err)
+		tmpl.URIs = []*url.URL{u}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	payloadType := "application/vnd.in-toto+json"
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hashPAE(payloadType, payload))
+	require.NoError(t, err)
+
+	return shared.Bundle{
+		DsseEnvelope: shared.DSSEEnvelope{
+			Payload:     base64.StdEncoding.EncodeToString(payload),
+			PayloadType: payloadType,
+			Signatures:  []shared.Signature{{Sig: base64.StdEncoding.EncodeToString(sig)}},
+		},
+		VerificationMaterial: shared.VerificationMaterial{
+			Certificate: shared.Certificate{
+				RawBytes: base64.StdEncoding.EncodeToString(der),
+			},
+		},
+	}
+}
+
+func hashPAE(payloadType string, payload []byte) []byte {
+	sum := sha256.Sum256(shared.PAE(payloadType, payload))
+	return sum[:]
+}
+
+func Test_verifyBundle(t *testing.T) {
+	bundle := buildTestBundle(t, "abcd", "https://github.com/cli/cli/.github/workflows/release.yml@refs/heads/trunk")
+
+	res, err := verifyBundle(bundle, "sha256:abcd", "cli/cli/.github/workflows/release.yml")
+	require.NoError(t, err)
+	assert.True(t, res.DigestMatch)
+	assert.True(t, res.SignatureVerified)
+	require.NotNil(t, res.SignerMatch)
+	assert.True(t, *res.SignerMatch)
+
+	res, err = verifyBundle(bundle, "sha256:deadbeef", "")
+	require.NoError(t, err)
+	assert.False(t, res.DigestMatch)
+	assert.True(t, res.SignatureVerified)
+
+	res, err = verifyBundle(bundle, "sha256:abcd", "some/other/workflow.yml")
+	require.NoError(t, err)
+	require.NotNil(t, res.SignerMatch)
+	assert.False(t, *res.SignerMatch)
+}
+
+func Test_verifyBundle_tamperedPayloadFailsSignatureCheck(t *testing.T) {
+	bundle := buildTestBundle(t, "abcd", "")
+
+	// Forge a new payload claiming a different digest, but keep the
+	// signature from the original payload: a forged bundle with a
+	// self-signed certificate should not verify.
+	forged := shared.Statement{
+		Type:          "https://in-toto.io/Statement/v1",
+		PredicateType: "https://slsa.dev/forged-predicate/v1",
+		Subject: []shared.Subject{
+			{Name: "artifact", Digest: map[string]string{"sha256": "abcd"}},
+		},
+	}
+	forgedPayload, err := json.Marshal(forged)
+	require.NoError(t, err)
+	bundle.DsseEnvelope.Payload = base64.StdEncoding.EncodeToString(forgedPayload)
+
+	res, err := verifyBundle(bundle, "sha256:abcd", "")
+	require.NoError(t, err)
+	assert.True(t, res.DigestMatch)
+	assert.False(t, res.SignatureVerified)
+}
+
+func Test_verifyBundle_missingSignature(t *testing.T) {
+	bundle := buildTestBundle(t, "abcd", "")
+	bundle.DsseEnvelope.Signatures = nil
+
+	res, err := verifyBundle(bundle, "sha256:abcd", "")
+	require.NoError(t, err)
+	assert.False(t, res.SignatureVerified)
+}
+
+func Test_verifyRun_bundleFile(t *testing.T) {
+	bundle := buildTestBundle(t, "abcd", "")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bundle.jsonl")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, shared.WriteBundles(f, []shared.Attestation{{Bundle: bundle}}))
+	require.NoError(t, f.Close())
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &VerifyOptions{
+		IO:         ios,
+		Subject:    "sha256:abcd",
+		BundlePath: path,
+		Format:     "json",
+	}
+
+	require.NoError(t, verifyRun(opts))
+
+	var results []Result
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+	require.Len(t, results, 1)
+	assert.True(t, results[0].DigestMatch)
+	assert.False(t, results[0].FullChainVerified)
+}
+
+func Test_verifyRun_fetchesFromAPI(t *testing.T) {
+	bundle := buildTestBundle(t, "abcd", "")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/cli/attestations/sha256:abcd"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"attestations": []map[string]interface{}{
+				{"bundle": bundle},
+			},
+		}),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &VerifyOptions{
+		IO:      ios,
+		Subject: "sha256:abcd",
+		Owner:   "cli",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+
+	require.NoError(t, verifyRun(opts))
+	assert.Contains(t, stdout.String(), "subject digest matches")
+	assert.Contains(t, stdout.String(), "does not validate that certificate against the Sigstore Fulcio root of trust")
+}
@@ -16,6 +16,8 @@ const tokenUser = "x-access-token"
 type config interface {
 	ActiveToken(string) (string, string)
 	ActiveUser(string) (string, error)
+	UsersForHost(string) []string
+	TokenForUser(string, string) (string, string, error)
 }
 
 type CredentialOptions struct {
@@ -112,18 +114,34 @@ func helperRun(opts *CredentialOptions) error {
 
 	lookupHost := wants["host"]
 	var gotUser string
-	gotToken, source := cfg.ActiveToken(lookupHost)
-	if gotToken == "" && strings.HasPrefix(lookupHost, "gist.") {
-		lookupHost = strings.TrimPrefix(lookupHost, "gist.")
-		gotToken, source = cfg.ActiveToken(lookupHost)
+	var gotToken string
+	var source string
+
+	if wants["username"] != "" {
+		for _, u := range cfg.UsersForHost(lookupHost) {
+			if strings.EqualFold(u, wants["username"]) {
+				if token, tokenSource, err := cfg.TokenForUser(lookupHost, u); err == nil {
+					gotUser, gotToken, source = u, token, tokenSource
+				}
+				break
+			}
+		}
 	}
 
-	if strings.HasSuffix(source, "_TOKEN") {
-		gotUser = tokenUser
-	} else {
-		gotUser, _ = cfg.ActiveUser(lookupHost)
-		if gotUser == "" {
+	if gotToken == "" {
+		gotToken, source = cfg.ActiveToken(lookupHost)
+		if gotToken == "" && strings.HasPrefix(lookupHost, "gist.") {
+			lookupHost = strings.TrimPrefix(lookupHost, "gist.")
+			gotToken, source = cfg.ActiveToken(lookupHost)
+		}
+
+		if strings.HasSuffix(source, "_TOKEN") {
 			gotUser = tokenUser
+		} else {
+			gotUser, _ = cfg.ActiveUser(lookupHost)
+			if gotUser == "" {
+				gotUser = tokenUser
+			}
 		}
 	}
 
@@ -2,6 +2,7 @@ package login
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
@@ -18,6 +19,22 @@ func (c tinyConfig) ActiveUser(host string) (string, error) {
 	return c[fmt.Sprintf("%s:%s", host, "user")], nil
 }
 
+func (c tinyConfig) UsersForHost(host string) []string {
+	usersCSV := c[fmt.Sprintf("%s:%s", host, "users")]
+	if usersCSV == "" {
+		return nil
+	}
+	return strings.Split(usersCSV, ",")
+}
+
+func (c tinyConfig) TokenForUser(host, user string) (string, string, error) {
+	token := c[fmt.Sprintf("%s:token:%s", host, user)]
+	if token == "" {
+		return "", "", fmt.Errorf("no token found for %q", user)
+	}
+	return token, "keyring", nil
+}
+
 func Test_helperRun(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -217,6 +234,83 @@ func Test_helperRun(t *testing.T) {
 			`),
 			wantStderr: "",
 		},
+		{
+			name: "multiple accounts, username matches non-active account",
+			opts: CredentialOptions{
+				Operation: "get",
+				Config: func() (config, error) {
+					return tinyConfig{
+						"_source":                 "/Users/monalisa/.config/gh/hosts.yml",
+						"example.com:user":        "monalisa",
+						"example.com:oauth_token": "OTOKEN",
+						"example.com:users":       "monalisa,hubot",
+						"example.com:token:hubot": "HTOKEN",
+					}, nil
+				},
+			},
+			input: heredoc.Doc(`
+				protocol=https
+				host=example.com
+				username=hubot
+			`),
+			wantErr: false,
+			wantStdout: heredoc.Doc(`
+				protocol=https
+				host=example.com
+				username=hubot
+				password=HTOKEN
+			`),
+			wantStderr: "",
+		},
+		{
+			name: "unknown username falls back to active account mismatch",
+			opts: CredentialOptions{
+				Operation: "get",
+				Config: func() (config, error) {
+					return tinyConfig{
+						"_source":                 "/Users/monalisa/.config/gh/hosts.yml",
+						"example.com:user":        "monalisa",
+						"example.com:oauth_token": "OTOKEN",
+						"example.com:users":       "monalisa",
+					}, nil
+				},
+			},
+			input: heredoc.Doc(`
+				protocol=https
+				host=example.com
+				username=hubot
+			`),
+			wantErr:    true,
+			wantStdout: "",
+			wantStderr: "",
+		},
+		{
+			name: "wwwauth hints are ignored",
+			opts: CredentialOptions{
+				Operation: "get",
+				Config: func() (config, error) {
+					return tinyConfig{
+						"_source":                 "/Users/monalisa/.config/gh/hosts.yml",
+						"example.com:user":        "monalisa",
+						"example.com:oauth_token": "OTOKEN",
+					}, nil
+				},
+			},
+			input: heredoc.Doc(`
+				protocol=https
+				host=example.com
+				wwwauth[]=Basic realm="GitHub"
+				wwwauth[]=Negotiate
+			`),
+			wantErr: false,
+			wantStdout: heredoc.Doc(`
+				protocol=https
+				host=example.com
+				username=monalisa
+				password=OTOKEN
+			`),
+			wantStderr: "",
+		},
 		{
 			name: "noop store operation",
 			opts: CredentialOptions{
@@ -31,20 +31,22 @@ type httpClient interface {
 	Do(*http.Request) (*http.Response, error)
 }
 
-// GetScopes performs a GitHub API request and returns the value of the X-Oauth-Scopes header.
-func GetScopes(httpClient httpClient, hostname, authToken string) (string, error) {
+// GetScopes performs a GitHub API request and returns the value of the X-Oauth-Scopes header
+// along with the value of the Github-Authentication-Token-Expiration header, which is set for
+// tokens that expire (including fine-grained personal access tokens).
+func GetScopes(httpClient httpClient, hostname, authToken string) (scopes string, expiresAt string, err error) {
 	apiEndpoint := ghinstance.RESTPrefix(hostname)
 
 	req, err := http.NewRequest("GET", apiEndpoint, nil)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	req.Header.Set("Authorization", "token "+authToken)
 
 	res, err := httpClient.Do(req)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	defer func() {
@@ -55,16 +57,16 @@ func GetScopes(httpClient httpClient, hostname, authToken string) (string, error
 	}()
 
 	if res.StatusCode != 200 {
-		return "", api.HandleHTTPError(res)
+		return "", "", api.HandleHTTPError(res)
 	}
 
-	return res.Header.Get("X-Oauth-Scopes"), nil
+	return res.Header.Get("X-Oauth-Scopes"), res.Header.Get("Github-Authentication-Token-Expiration"), nil
 }
 
 // HasMinimumScopes performs a GitHub API request and returns an error if the token used in the request
 // lacks the minimum required scopes for performing API operations with gh.
 func HasMinimumScopes(httpClient httpClient, hostname, authToken string) error {
-	scopesHeader, err := GetScopes(httpClient, hostname, authToken)
+	scopesHeader, _, err := GetScopes(httpClient, hostname, authToken)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -18,6 +19,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// tokenExpirationWarningWindow is how far in advance of a token's expiration
+// date gh auth status starts warning about it.
+const tokenExpirationWarningWindow = 30 * 24 * time.Hour
+
+// tokenExpirationLayout matches the format of the
+// Github-Authentication-Token-Expiration response header.
+const tokenExpirationLayout = "2006-01-02 15:04:05 MST"
+
 type validEntry struct {
 	active      bool
 	host        string
@@ -26,6 +35,7 @@ type validEntry struct {
 	tokenSource string
 	gitProtocol string
 	scopes      string
+	expiresAt   string
 }
 
 func (e validEntry) String(cs *iostreams.ColorScheme) string {
@@ -39,6 +49,12 @@ func (e validEntry) String(cs *iostreams.ColorScheme) string {
 	sb.WriteString(fmt.Sprintf("  - Git operations protocol: %s\n", cs.Bold(e.gitProtocol)))
 	sb.WriteString(fmt.Sprintf("  - Token: %s\n", cs.Bold(e.token)))
 
+	if expiresAt, err := time.Parse(tokenExpirationLayout, e.expiresAt); err == nil {
+		if until := time.Until(expiresAt); until <= tokenExpirationWarningWindow {
+			sb.WriteString(fmt.Sprintf("  %s Token will expire %s\n", cs.WarningIcon(), expiryPhrase(until)))
+		}
+	}
+
 	if expectScopes(e.token) {
 		sb.WriteString(fmt.Sprintf("  - Token scopes: %s\n", cs.Bold(displayScopes(e.scopes))))
 		if err := shared.HeaderHasMinimumScopes(e.scopes); err != nil {
@@ -251,6 +267,24 @@ func statusRun(opts *StatusOptions) error {
 	return nil
 }
 
+// expiryPhrase describes how far in the future (or past) a token's expiration falls,
+// given the duration remaining until it, e.g. "in 3 days" or "1 day ago".
+func expiryPhrase(until time.Duration) string {
+	days := int(until.Round(24 * time.Hour).Hours() / 24)
+	switch {
+	case days > 1:
+		return fmt.Sprintf("in %d days", days)
+	case days == 1:
+		return "in 1 day"
+	case days == 0:
+		return "today"
+	case days == -1:
+		return "1 day ago"
+	default:
+		return fmt.Sprintf("%d days ago", -days)
+	}
+}
+
 func displayToken(token string, printRaw bool) string {
 	if printRaw {
 		return token
@@ -317,8 +351,8 @@ func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
 		}
 	}
 
-	// Get scopes for token.
-	scopesHeader, err := shared.GetScopes(httpClient, opts.hostname, opts.token)
+	// Get scopes and expiration for token.
+	scopesHeader, expiresAt, err := shared.GetScopes(httpClient, opts.hostname, opts.token)
 	if err != nil {
 		var networkError net.Error
 		if errors.As(err, &networkError) && networkError.Timeout() {
@@ -347,6 +381,7 @@ func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
 		token:       displayToken(opts.token, opts.showToken),
 		tokenSource: opts.tokenSource,
 		user:        opts.username,
+		expiresAt:   expiresAt,
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/config"
@@ -75,6 +76,8 @@ func Test_NewCmdStatus(t *testing.T) {
 }
 
 func Test_statusRun(t *testing.T) {
+	nearExpiry := time.Now().Add(10*24*time.Hour + time.Hour).UTC().Format(tokenExpirationLayout)
+
 	tests := []struct {
 		name       string
 		opts       StatusOptions
@@ -262,6 +265,27 @@ func Test_statusRun(t *testing.T) {
 				  - Token: github_pat_******
 			`),
 		},
+		{
+			name: "PAT V2 token nearing expiration",
+			opts: StatusOptions{},
+			cfgStubs: func(t *testing.T, c config.Config) {
+				login(t, c, "github.com", "monalisa", "github_pat_abc123", "https")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				// mocks for HeaderHasMinimumScopes api requests to github.com
+				reg.Register(
+					httpmock.REST("GET", ""),
+					httpmock.WithHeader(httpmock.ScopesResponder(""), "Github-Authentication-Token-Expiration", nearExpiry))
+			},
+			wantOut: heredoc.Doc(`
+				github.com
+				  ✓ Logged in to github.com account monalisa (GH_CONFIG_DIR/hosts.yml)
+				  - Active account: true
+				  - Git operations protocol: https
+				  - Token: github_pat_******
+				  ! Token will expire in 10 days
+			`),
+		},
 		{
 			name: "show token",
 			opts: StatusOptions{
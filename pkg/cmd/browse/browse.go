@@ -39,10 +39,12 @@ type BrowseOptions struct {
 
 	Branch          string
 	Commit          string
+	Range           string
 	ProjectsFlag    bool
 	ReleasesFlag    bool
 	SettingsFlag    bool
 	WikiFlag        bool
+	BlameFlag       bool
 	NoBrowserFlag   bool
 	HasRepoOverride bool
 }
@@ -87,6 +89,18 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 
 			$ gh browse main.go --commit=77507cd94ccafcf568f8560cfecde965fcfa63
 			#=> Open main.go with the repository at commit 775007cd
+
+			$ gh browse --commit
+			#=> Open the current commit page
+
+			$ gh browse main.go --blame
+			#=> Open the blame view for main.go
+
+			$ gh browse main.go:312 --blame
+			#=> Open the blame view for main.go, focused on line 312
+
+			$ gh browse main.go --range 10-20
+			#=> Open main.go with lines 10 to 20 highlighted
 		`),
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Doc(`
@@ -134,6 +148,21 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("%q is an invalid argument when using `--branch` or `--commit`", opts.SelectorArg)
 			}
 
+			if opts.Range != "" && strings.Contains(opts.SelectorArg, ":") {
+				return cmdutil.FlagErrorf("`--range` is not supported when a line number is given in the file argument")
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"`--blame` is not supported with `--projects`, `--releases`, `--settings`, or `--wiki`",
+				opts.BlameFlag,
+				opts.ProjectsFlag,
+				opts.ReleasesFlag,
+				opts.SettingsFlag,
+				opts.WikiFlag,
+			); err != nil {
+				return err
+			}
+
 			if cmd.Flags().Changed("repo") || os.Getenv("GH_REPO") != "" {
 				opts.GitClient = &remoteGitClient{opts.BaseRepo, opts.HttpClient}
 				opts.HasRepoOverride = true
@@ -154,6 +183,8 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.NoBrowserFlag, "no-browser", "n", false, "Print destination URL instead of opening the browser")
 	cmd.Flags().StringVarP(&opts.Commit, "commit", "c", "", "Select another commit by passing in the commit SHA, default is the last commit")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Select another branch by passing in the branch name")
+	cmd.Flags().BoolVar(&opts.BlameFlag, "blame", false, "Open repository file in blame view at the file, line, or range")
+	cmd.Flags().StringVar(&opts.Range, "range", "", "Specify line range for the file argument, e.g. \"10-20\"")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
@@ -207,6 +238,10 @@ func runBrowse(opts *BrowseOptions) error {
 }
 
 func parseSection(baseRepo ghrepo.Interface, opts *BrowseOptions) (string, error) {
+	if opts.BlameFlag && opts.SelectorArg == "" {
+		return "", cmdutil.FlagErrorf("`--blame` requires a file argument")
+	}
+
 	if opts.ProjectsFlag {
 		return "projects", nil
 	} else if opts.ReleasesFlag {
@@ -251,6 +286,21 @@ func parseSection(baseRepo ghrepo.Interface, opts *BrowseOptions) (string, error
 		return "", err
 	}
 
+	if opts.BlameFlag && filePath == "" {
+		return "", cmdutil.FlagErrorf("`--blame` requires a file argument")
+	}
+
+	if filePath == "" && opts.Commit != "" {
+		return fmt.Sprintf("commit/%s", escapePath(ref)), nil
+	}
+
+	viewType := "tree"
+	if opts.BlameFlag {
+		viewType = "blame"
+	} else if rangeStart > 0 {
+		viewType = "blob"
+	}
+
 	if rangeStart > 0 {
 		var rangeFragment string
 		if rangeEnd > 0 && rangeStart != rangeEnd {
@@ -258,10 +308,13 @@ func parseSection(baseRepo ghrepo.Interface, opts *BrowseOptions) (string, error
 		} else {
 			rangeFragment = fmt.Sprintf("L%d", rangeStart)
 		}
-		return fmt.Sprintf("blob/%s/%s?plain=1#%s", escapePath(ref), escapePath(filePath), rangeFragment), nil
+		if opts.BlameFlag {
+			return fmt.Sprintf("%s/%s/%s#%s", viewType, escapePath(ref), escapePath(filePath), rangeFragment), nil
+		}
+		return fmt.Sprintf("%s/%s/%s?plain=1#%s", viewType, escapePath(ref), escapePath(filePath), rangeFragment), nil
 	}
 
-	return strings.TrimSuffix(fmt.Sprintf("tree/%s/%s", escapePath(ref), escapePath(filePath)), "/"), nil
+	return strings.TrimSuffix(fmt.Sprintf("%s/%s/%s", viewType, escapePath(ref), escapePath(filePath)), "/"), nil
 }
 
 // escapePath URL-encodes special characters but leaves slashes unchanged
@@ -287,6 +340,12 @@ func parseFile(opts BrowseOptions, f string) (p string, start int, end int, err
 			p = ""
 		}
 	}
+
+	if opts.Range != "" {
+		start, end, err = parseRange(opts.Range)
+		return
+	}
+
 	if len(parts) < 2 {
 		return
 	}
@@ -312,6 +371,31 @@ func parseFile(opts BrowseOptions, f string) (p string, start int, end int, err
 	return
 }
 
+// parseRange parses a "--range" value of the form "10-20" or "10" into its start and end line numbers.
+func parseRange(r string) (start int, end int, err error) {
+	idx := strings.IndexRune(r, '-')
+	if idx < 0 {
+		start, err = strconv.Atoi(r)
+		if err != nil {
+			err = fmt.Errorf("invalid range argument: %q", r)
+			return
+		}
+		end = start
+		return
+	}
+
+	start, err = strconv.Atoi(r[:idx])
+	if err != nil {
+		err = fmt.Errorf("invalid range argument: %q", r)
+		return
+	}
+	end, err = strconv.Atoi(r[idx+1:])
+	if err != nil {
+		err = fmt.Errorf("invalid range argument: %q", r)
+	}
+	return
+}
+
 func isNumber(arg string) bool {
 	_, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
 	return err == nil
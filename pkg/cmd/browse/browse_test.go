@@ -185,6 +185,34 @@ func TestNewCmdBrowse(t *testing.T) {
 			cli:      "de07febc26e19000f8c9e821207f3bc34a3c8038 --commit=12a4",
 			wantsErr: true,
 		},
+		{
+			name: "blame flag with a file",
+			cli:  "main.go --blame",
+			wants: BrowseOptions{
+				SelectorArg: "main.go",
+				BlameFlag:   true,
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "passed both blame and projects flags",
+			cli:      "--blame --projects",
+			wantsErr: true,
+		},
+		{
+			name: "range flag with a file",
+			cli:  "main.go --range 10-20",
+			wants: BrowseOptions{
+				SelectorArg: "main.go",
+				Range:       "10-20",
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "range flag with a file that also specifies a line",
+			cli:      "main.go:5 --range 10-20",
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -216,6 +244,8 @@ func TestNewCmdBrowse(t *testing.T) {
 			assert.Equal(t, tt.wants.NoBrowserFlag, opts.NoBrowserFlag)
 			assert.Equal(t, tt.wants.SettingsFlag, opts.SettingsFlag)
 			assert.Equal(t, tt.wants.Commit, opts.Commit)
+			assert.Equal(t, tt.wants.BlameFlag, opts.BlameFlag)
+			assert.Equal(t, tt.wants.Range, opts.Range)
 		})
 	}
 }
@@ -365,6 +395,25 @@ func Test_runBrowse(t *testing.T) {
 			defaultBranch: "trunk",
 			expectedURL:   "https://github.com/ravocean/angur/blob/trunk/path/to/file.txt?plain=1#L32-L40",
 		},
+		{
+			name: "file with range flag",
+			opts: BrowseOptions{
+				SelectorArg: "path/to/file.txt",
+				Range:       "10-20",
+			},
+			baseRepo:      ghrepo.New("ravocean", "angur"),
+			defaultBranch: "trunk",
+			expectedURL:   "https://github.com/ravocean/angur/blob/trunk/path/to/file.txt?plain=1#L10-L20",
+		},
+		{
+			name: "file with invalid range flag",
+			opts: BrowseOptions{
+				SelectorArg: "path/to/file.txt",
+				Range:       "10-abc",
+			},
+			baseRepo: ghrepo.New("ravocean", "angur"),
+			wantsErr: true,
+		},
 		{
 			name: "invalid default branch",
 			opts: BrowseOptions{
@@ -426,6 +475,88 @@ func Test_runBrowse(t *testing.T) {
 			wantsErr:    false,
 			expectedURL: "https://github.com/github/ThankYouGitHub/blob/first-browse-pull/browse.go?plain=1#L32",
 		},
+		{
+			name: "blame flag with file",
+			opts: BrowseOptions{
+				Branch:      "trunk",
+				SelectorArg: "browse.go",
+				BlameFlag:   true,
+			},
+			baseRepo:    ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/github/ThankYouGitHub/blame/trunk/browse.go",
+		},
+		{
+			name: "blame flag with file and line",
+			opts: BrowseOptions{
+				Branch:      "trunk",
+				SelectorArg: "browse.go:32",
+				BlameFlag:   true,
+			},
+			baseRepo:    ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/github/ThankYouGitHub/blame/trunk/browse.go#L32",
+		},
+		{
+			name: "blame flag with file and line range",
+			opts: BrowseOptions{
+				Branch:      "trunk",
+				SelectorArg: "browse.go:32-40",
+				BlameFlag:   true,
+			},
+			baseRepo:    ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/github/ThankYouGitHub/blame/trunk/browse.go#L32-L40",
+		},
+		{
+			name: "blame flag without a file",
+			opts: BrowseOptions{
+				Branch:    "trunk",
+				BlameFlag: true,
+			},
+			baseRepo: ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr: true,
+		},
+		{
+			name: "blame flag without a file or a ref",
+			opts: BrowseOptions{
+				BlameFlag: true,
+			},
+			baseRepo: ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr: true,
+		},
+		{
+			name: "blame flag with a commit",
+			opts: BrowseOptions{
+				Commit:      "12a4",
+				SelectorArg: "browse.go:32",
+				BlameFlag:   true,
+			},
+			baseRepo:    ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/github/ThankYouGitHub/blame/12a4/browse.go#L32",
+		},
+		{
+			name: "branch containing a slash with file",
+			opts: BrowseOptions{
+				Branch:      "feature/foo",
+				SelectorArg: "browse.go",
+			},
+			baseRepo:    ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/github/ThankYouGitHub/tree/feature/foo/browse.go",
+		},
+		{
+			name: "branch containing a slash with blame and line",
+			opts: BrowseOptions{
+				Branch:      "feature/foo",
+				SelectorArg: "browse.go:5",
+				BlameFlag:   true,
+			},
+			baseRepo:    ghrepo.New("github", "ThankYouGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/github/ThankYouGitHub/blame/feature/foo/browse.go#L5",
+		},
 		{
 			name: "no browser with branch file and line number",
 			opts: BrowseOptions{
@@ -451,7 +582,7 @@ func Test_runBrowse(t *testing.T) {
 			},
 			baseRepo:    ghrepo.New("vilmibm", "gh-user-status"),
 			wantsErr:    false,
-			expectedURL: "https://github.com/vilmibm/gh-user-status/tree/6f1a2405cace1633d89a79c74c65f22fe78f9659",
+			expectedURL: "https://github.com/vilmibm/gh-user-status/commit/6f1a2405cace1633d89a79c74c65f22fe78f9659",
 		},
 		{
 			name: "open last commit with a file",
@@ -472,7 +603,7 @@ func Test_runBrowse(t *testing.T) {
 			},
 			baseRepo:    ghrepo.New("yanskun", "ILoveGitHub"),
 			wantsErr:    false,
-			expectedURL: "https://github.com/yanskun/ILoveGitHub/tree/1234567890",
+			expectedURL: "https://github.com/yanskun/ILoveGitHub/commit/1234567890",
 		},
 		{
 			name: "open file with the repository state at a commit hash",
@@ -0,0 +1,214 @@
+package checks
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	cmdRerequest "github.com/cli/cli/v2/pkg/cmd/checks/rerequest"
+	"github.com/cli/cli/v2/pkg/cmd/checks/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultInterval time.Duration = 10 * time.Second
+
+type ChecksOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Ref         string
+	Watch       bool
+	Annotations bool
+	Interval    time.Duration
+}
+
+func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
+	var interval int
+	opts := &ChecksOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Interval:   defaultInterval,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "checks <ref>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Show check runs for a commit, branch, or tag",
+		Long: heredoc.Doc(`
+			Show check runs recorded for a commit, branch, or tag, independent of
+			any pull request.
+		`),
+		Example: heredoc.Doc(`
+			# Show checks for a branch
+			$ gh checks main
+
+			# Watch checks until they finish, exiting non-zero if any failed
+			$ gh checks main --watch
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Ref = args[0]
+
+			if cmd.Flags().Changed("interval") {
+				opts.Interval = time.Duration(interval) * time.Second
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return checksRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch checks until they finish")
+	cmd.Flags().BoolVar(&opts.Annotations, "annotations", false, "Display annotations for failing checks")
+	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch`")
+	cmdutil.EnableRepoOverride(cmd, f)
+	cmd.AddCommand(cmdRerequest.NewCmdRerequest(f, nil))
+
+	return cmd
+}
+
+func checksRun(opts *ChecksOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var checkRuns []shared.CheckRun
+
+	for {
+		checkRuns, err = shared.ListForRef(client, repo, opts.Ref)
+		if err != nil {
+			return err
+		}
+		if len(checkRuns) == 0 {
+			return fmt.Errorf("no checks reported on %q", opts.Ref)
+		}
+
+		if !opts.Watch || allComplete(checkRuns) {
+			break
+		}
+
+		time.Sleep(opts.Interval)
+	}
+
+	if err := printTable(opts.IO, checkRuns); err != nil {
+		return err
+	}
+
+	if opts.Annotations {
+		if err := printAnnotations(opts.IO, client, repo, checkRuns); err != nil {
+			return err
+		}
+	}
+
+	for _, cr := range checkRuns {
+		if cr.IsFailure() {
+			return cmdutil.SilentError
+		}
+	}
+	return nil
+}
+
+func allComplete(checkRuns []shared.CheckRun) bool {
+	for _, cr := range checkRuns {
+		if !cr.IsComplete() {
+			return false
+		}
+	}
+	return true
+}
+
+func printTable(io *iostreams.IOStreams, checkRuns []shared.CheckRun) error {
+	cs := io.ColorScheme()
+
+	var headers []string
+	if io.IsStdoutTTY() {
+		headers = []string{"", "NAME", "ELAPSED", "URL"}
+	} else {
+		headers = []string{"NAME", "STATUS", "CONCLUSION", "URL"}
+	}
+	tp := tableprinter.New(io, tableprinter.WithHeader(headers...))
+
+	sort.Slice(checkRuns, func(i, j int) bool {
+		return checkRuns[i].Name < checkRuns[j].Name
+	})
+
+	for _, cr := range checkRuns {
+		elapsed := ""
+		if !cr.StartedAt.IsZero() && !cr.CompletedAt.IsZero() {
+			if e := cr.CompletedAt.Sub(cr.StartedAt); e > 0 {
+				elapsed = e.String()
+			}
+		}
+
+		if io.IsStdoutTTY() {
+			mark, markColor := symbolFor(cs, cr)
+			tp.AddField(mark, tableprinter.WithColor(markColor))
+			tp.AddField(cr.Name)
+			tp.AddField(elapsed)
+			tp.AddField(cr.HTMLURL)
+		} else {
+			tp.AddField(cr.Name)
+			tp.AddField(cr.Status)
+			tp.AddField(cr.Conclusion)
+			tp.AddField(cr.HTMLURL)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func symbolFor(cs *iostreams.ColorScheme, cr shared.CheckRun) (string, func(string) string) {
+	if !cr.IsComplete() {
+		return "*", cs.Yellow
+	}
+	if cr.IsFailure() {
+		return "X", cs.Red
+	}
+	return "✓", cs.Green
+}
+
+func printAnnotations(io *iostreams.IOStreams, client *api.Client, repo ghrepo.Interface, checkRuns []shared.CheckRun) error {
+	var printed bool
+	for _, cr := range checkRuns {
+		if !cr.IsFailure() {
+			continue
+		}
+
+		annotations, err := shared.ListAnnotations(client, repo, cr.ID)
+		if err != nil {
+			return err
+		}
+		if len(annotations) == 0 {
+			continue
+		}
+
+		if !printed {
+			fmt.Fprintln(io.Out)
+			printed = true
+		}
+
+		fmt.Fprintf(io.Out, "%s\n", io.ColorScheme().Bold(cr.Name))
+		for _, a := range annotations {
+			fmt.Fprintf(io.Out, "  %s:%d: %s\n", a.Path, a.StartLine, a.Message)
+		}
+	}
+	return nil
+}
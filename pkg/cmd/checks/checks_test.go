@@ -0,0 +1,79 @@
+package checks
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdChecks(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{IOStreams: ios}
+
+	argv, err := shlex.Split("main --watch --annotations")
+	require.NoError(t, err)
+
+	var gotOpts *ChecksOptions
+	cmd := NewCmdChecks(f, func(opts *ChecksOptions) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "main", gotOpts.Ref)
+	assert.True(t, gotOpts.Watch)
+	assert.True(t, gotOpts.Annotations)
+}
+
+func Test_checksRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/main/check-runs"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"total_count": 2,
+			"check_runs": []map[string]interface{}{
+				{"id": 1, "name": "build", "status": "completed", "conclusion": "success", "html_url": "https://github.com/OWNER/REPO/runs/1"},
+				{"id": 2, "name": "test", "status": "completed", "conclusion": "failure", "html_url": "https://github.com/OWNER/REPO/runs/2"},
+			},
+		}),
+	)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/check-runs/2/annotations"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"path": "main.go", "start_line": 10, "message": "boom"},
+		}),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &ChecksOptions{
+		IO:          ios,
+		Ref:         "main",
+		Annotations: true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := checksRun(opts)
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Contains(t, stdout.String(), "build")
+	assert.Contains(t, stdout.String(), "test")
+	assert.Contains(t, stdout.String(), "main.go:10: boom")
+}
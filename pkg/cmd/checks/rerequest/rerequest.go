@@ -0,0 +1,162 @@
+package rerequest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/checks/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultInterval time.Duration = 10 * time.Second
+
+type RerequestOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Ref      string
+	SuiteID  int64
+	RunID    int64
+	Watch    bool
+	Interval time.Duration
+}
+
+func NewCmdRerequest(f *cmdutil.Factory, runF func(*RerequestOptions) error) *cobra.Command {
+	var interval int
+	var suiteID, runID int64
+	opts := &RerequestOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Interval:   defaultInterval,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rerequest <ref>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Request another check run of a commit, branch, or tag",
+		Long: heredoc.Doc(`
+			Request that GitHub re-run a check suite or check run recorded
+			against a commit, branch, or tag.
+
+			Without --suite or --run, every check suite reported for the ref is
+			re-requested.
+		`),
+		Example: heredoc.Doc(`
+			# Re-run every check suite on a branch
+			$ gh checks rerequest main
+
+			# Re-run a single check run, then watch it to completion
+			$ gh checks rerequest main --run 123456 --watch
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Ref = args[0]
+			opts.SuiteID = suiteID
+			opts.RunID = runID
+
+			if err := cmdutil.MutuallyExclusive("specify only one of `--suite` or `--run`", suiteID != 0, runID != 0); err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed("interval") {
+				opts.Interval = time.Duration(interval) * time.Second
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return rerequestRun(opts)
+		},
+	}
+
+	cmd.Flags().Int64Var(&suiteID, "suite", 0, "Re-request only this check suite ID")
+	cmd.Flags().Int64Var(&runID, "run", 0, "Re-request only this check run ID")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch the re-requested checks until they finish")
+	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch`")
+
+	return cmd
+}
+
+func rerequestRun(opts *RerequestOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	checkRuns, err := shared.ListForRef(client, repo, opts.Ref)
+	if err != nil {
+		return err
+	}
+	if len(checkRuns) == 0 {
+		return fmt.Errorf("no checks reported on %q", opts.Ref)
+	}
+
+	suiteIDs := map[int64]bool{}
+	switch {
+	case opts.RunID != 0:
+		if err := shared.RerequestRun(client, repo, opts.RunID); err != nil {
+			return fmt.Errorf("failed to rerequest check run %d: %w", opts.RunID, err)
+		}
+		fmt.Fprintf(opts.IO.Out, "Requested a new run of check run %s\n", strconv.FormatInt(opts.RunID, 10))
+	case opts.SuiteID != 0:
+		if err := shared.RerequestSuite(client, repo, opts.SuiteID); err != nil {
+			return fmt.Errorf("failed to rerequest check suite %d: %w", opts.SuiteID, err)
+		}
+		fmt.Fprintf(opts.IO.Out, "Requested a new run of check suite %s\n", strconv.FormatInt(opts.SuiteID, 10))
+	default:
+		for _, cr := range checkRuns {
+			suiteIDs[cr.CheckSuite.ID] = true
+		}
+		for suiteID := range suiteIDs {
+			if err := shared.RerequestSuite(client, repo, suiteID); err != nil {
+				return fmt.Errorf("failed to rerequest check suite %d: %w", suiteID, err)
+			}
+		}
+		fmt.Fprintf(opts.IO.Out, "Requested a new run of %d check suite(s)\n", len(suiteIDs))
+	}
+
+	if !opts.Watch {
+		return nil
+	}
+
+	for {
+		checkRuns, err = shared.ListForRef(client, repo, opts.Ref)
+		if err != nil {
+			return err
+		}
+
+		complete := true
+		for _, cr := range checkRuns {
+			if !cr.IsComplete() {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			break
+		}
+
+		time.Sleep(opts.Interval)
+	}
+
+	for _, cr := range checkRuns {
+		if cr.IsFailure() {
+			return cmdutil.SilentError
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package rerequest
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdRerequest(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{IOStreams: ios}
+
+	argv, err := shlex.Split("main --suite 1 --run 2")
+	require.NoError(t, err)
+
+	cmd := NewCmdRerequest(f, func(opts *RerequestOptions) error {
+		return nil
+	})
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err = cmd.ExecuteC()
+	assert.EqualError(t, err, "specify only one of `--suite` or `--run`")
+}
+
+func Test_rerequestRun_defaultsToAllSuites(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/main/check-runs"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"total_count": 1,
+			"check_runs": []map[string]interface{}{
+				{"id": 1, "name": "build", "status": "completed", "conclusion": "success", "check_suite": map[string]interface{}{"id": 100}},
+			},
+		}),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-suites/100/rerequest"),
+		httpmock.StatusStringResponse(201, "{}"),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &RerequestOptions{
+		IO:  ios,
+		Ref: "main",
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	require.NoError(t, rerequestRun(opts))
+	assert.Contains(t, stdout.String(), "Requested a new run of 1 check suite(s)")
+}
+
+func Test_rerequestRun_specificRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/main/check-runs"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"total_count": 1,
+			"check_runs": []map[string]interface{}{
+				{"id": 1, "name": "build", "status": "completed", "conclusion": "success"},
+			},
+		}),
+	)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-runs/1/rerequest"),
+		httpmock.StatusStringResponse(201, "{}"),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &RerequestOptions{
+		IO:    ios,
+		Ref:   "main",
+		RunID: 1,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	require.NoError(t, rerequestRun(opts))
+	assert.Contains(t, stdout.String(), "Requested a new run of check run 1")
+}
@@ -0,0 +1,91 @@
+// Package shared holds the types and API helpers common to the gh checks
+// command and its rerequest subcommand: fetching check runs for a ref,
+// their annotations, and re-requesting a check run or check suite.
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// CheckRun is a (partial) representation of the check-runs REST resource.
+type CheckRun struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	HTMLURL     string    `json:"html_url"`
+	CheckSuite  struct {
+		ID int64 `json:"id"`
+	} `json:"check_suite"`
+}
+
+// Annotation is a single annotation attached to a check run.
+type Annotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	Message         string `json:"message"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title"`
+}
+
+type checkRunsResponse struct {
+	TotalCount int        `json:"total_count"`
+	CheckRuns  []CheckRun `json:"check_runs"`
+}
+
+// IsComplete reports whether the check run has finished.
+func (c CheckRun) IsComplete() bool {
+	return c.Status == "completed"
+}
+
+// IsFailure reports whether a completed check run did not succeed.
+func (c CheckRun) IsFailure() bool {
+	switch c.Conclusion {
+	case "failure", "timed_out", "action_required", "startup_failure", "cancelled":
+		return true
+	default:
+		return false
+	}
+}
+
+// ListForRef fetches the check runs GitHub has recorded for ref, which may
+// be a branch name, tag name, or commit SHA.
+func ListForRef(client *api.Client, repo ghrepo.Interface, ref string) ([]CheckRun, error) {
+	path := fmt.Sprintf("repos/%s/%s/commits/%s/check-runs?per_page=100", repo.RepoOwner(), repo.RepoName(), url.PathEscape(ref))
+
+	var resp checkRunsResponse
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CheckRuns, nil
+}
+
+// ListAnnotations fetches the annotations recorded against a check run.
+func ListAnnotations(client *api.Client, repo ghrepo.Interface, checkRunID int64) ([]Annotation, error) {
+	path := fmt.Sprintf("repos/%s/%s/check-runs/%d/annotations", repo.RepoOwner(), repo.RepoName(), checkRunID)
+
+	var annotations []Annotation
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &annotations); err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+// RerequestRun asks GitHub to re-run a single check run.
+func RerequestRun(client *api.Client, repo ghrepo.Interface, checkRunID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/check-runs/%d/rerequest", repo.RepoOwner(), repo.RepoName(), checkRunID)
+	return client.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
+
+// RerequestSuite asks GitHub to re-run every check in a check suite.
+func RerequestSuite(client *api.Client, repo ghrepo.Interface, checkSuiteID int64) error {
+	path := fmt.Sprintf("repos/%s/%s/check-suites/%d/rerequest", repo.RepoOwner(), repo.RepoName(), checkSuiteID)
+	return client.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
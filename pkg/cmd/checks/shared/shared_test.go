@@ -0,0 +1,71 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRun_IsFailure(t *testing.T) {
+	tests := []struct {
+		conclusion string
+		want       bool
+	}{
+		{"success", false},
+		{"neutral", false},
+		{"skipped", false},
+		{"failure", true},
+		{"timed_out", true},
+		{"action_required", true},
+		{"startup_failure", true},
+		{"cancelled", true},
+	}
+
+	for _, tt := range tests {
+		cr := CheckRun{Status: "completed", Conclusion: tt.conclusion}
+		assert.Equal(t, tt.want, cr.IsFailure(), tt.conclusion)
+	}
+}
+
+func TestListForRef(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/main/check-runs"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"total_count": 1,
+			"check_runs": []map[string]interface{}{
+				{"id": 1, "name": "build", "status": "completed", "conclusion": "success"},
+			},
+		}),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+
+	checkRuns, err := ListForRef(client, repo, "main")
+	require.NoError(t, err)
+	require.Len(t, checkRuns, 1)
+	assert.Equal(t, "build", checkRuns[0].Name)
+}
+
+func TestRerequestRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/check-runs/123/rerequest"),
+		httpmock.StatusStringResponse(201, "{}"),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+
+	require.NoError(t, RerequestRun(client, repo, 123))
+}
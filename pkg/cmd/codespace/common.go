@@ -18,6 +18,7 @@ import (
 	clicontext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/codespaces/api"
+	ghprompter "github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
@@ -103,22 +104,25 @@ func chooseCodespaceFromList(ctx context.Context, codespaces []*api.Codespace, i
 		return sortedCodespaces[i].CreatedAt > sortedCodespaces[j].CreatedAt
 	})
 
+	options := formatCodespacesForSelect(sortedCodespaces, includeOwner)
 	csSurvey := []*survey.Question{
 		{
 			Name: "codespace",
 			Prompt: &survey.Select{
-				Message: "Choose codespace:",
-				Options: formatCodespacesForSelect(sortedCodespaces, includeOwner),
+				Message:  fmt.Sprintf("Choose codespace: (%d options, type to filter)", len(options)),
+				Options:  options,
+				PageSize: 10,
+				Filter:   ghprompter.FuzzyMatchingFilter,
 			},
 			Validate: survey.Required,
 		},
 	}
 
-	prompter := &Prompter{}
+	p := &Prompter{}
 	var answers struct {
 		Codespace int
 	}
-	if err := prompter.Ask(csSurvey, &answers); err != nil {
+	if err := p.Ask(csSurvey, &answers); err != nil {
 		return nil, fmt.Errorf("error getting answers: %w", err)
 	}
 
@@ -85,6 +85,11 @@ func Test_listRun(t *testing.T) {
 				cfg.Set("HOST", "pager", "less")
 				cfg.Set("HOST", "http_unix_socket", "")
 				cfg.Set("HOST", "browser", "brave")
+				cfg.Set("HOST", "color_theme", "default")
+				cfg.Set("HOST", "display.truncate", "true")
+				cfg.Set("HOST", "glamour_style", "")
+				cfg.Set("HOST", "display.hyperlinks", "auto")
+				cfg.Set("HOST", "display.timestamps", "relative")
 				return cfg
 			}(),
 			input: &ListOptions{Hostname: "HOST"},
@@ -92,8 +97,24 @@ func Test_listRun(t *testing.T) {
 editor=/usr/bin/vim
 prompt=disabled
 pager=less
+pr_checkout_branch_template=
 http_unix_socket=
+http_proxy=
 browser=brave
+color_theme=default
+completion_network=enabled
+credential_backend=keyring
+credential_helper=
+display.truncate=true
+glamour_style=
+display.hyperlinks=auto
+display.timestamps=relative
+status_exclude=
+update_release_channel=stable
+request_timeout=
+ca_bundle=
+client_certificate=
+client_key=
 `,
 		},
 	}
@@ -42,6 +42,12 @@ func TestNewCmdConfigSet(t *testing.T) {
 			output:   SetOptions{Hostname: "test.com", Key: "key", Value: "value"},
 			wantsErr: false,
 		},
+		{
+			name:     "set color_theme",
+			input:    "color_theme light",
+			output:   SetOptions{Key: "color_theme", Value: "light"},
+			wantsErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -171,6 +177,12 @@ func Test_ValidateValue(t *testing.T) {
 
 	err = ValidateValue("http_unix_socket", "really_anything/is/allowed/and/net.Dial\\(...\\)/will/ultimately/validate")
 	assert.NoError(t, err)
+
+	err = ValidateValue("color_theme", "neon")
+	assert.EqualError(t, err, "invalid value")
+
+	err = ValidateValue("color_theme", "light")
+	assert.NoError(t, err)
 }
 
 func Test_ValidateKey(t *testing.T) {
@@ -194,4 +206,7 @@ func Test_ValidateKey(t *testing.T) {
 
 	err = ValidateKey("browser")
 	assert.NoError(t, err)
+
+	err = ValidateKey("color_theme")
+	assert.NoError(t, err)
 }
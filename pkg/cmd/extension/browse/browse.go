@@ -17,6 +17,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/markdown"
 	"github.com/cli/cli/v2/pkg/search"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -312,9 +313,11 @@ func getSelectedReadme(opts ExtBrowseOpts, readme *tview.TextView, el *extList)
 
 	_, _, wrap, _ := readme.GetInnerRect()
 
+	styleOpt, _ := markdown.Style(opts.Cfg.GlamourStyle(""), "dark")
+
 	// using glamour directly because if I don't horrible things happen
 	renderer, err := glamour.NewTermRenderer(
-		glamour.WithStylePath("dark"),
+		styleOpt,
 		glamour.WithWordWrap(wrap))
 	if err != nil {
 		return "", err
@@ -34,7 +34,8 @@ func Test_getSelectedReadme(t *testing.T) {
 
 	rg := newReadmeGetter(client, time.Second)
 	opts := ExtBrowseOpts{
-		Rg: rg,
+		Rg:  rg,
+		Cfg: config.NewBlankConfig(),
 	}
 	readme := tview.NewTextView()
 	ui := uiRegistry{
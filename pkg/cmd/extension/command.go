@@ -231,7 +231,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 							}
 						}
 
-						tp.AddField(installed, tableprinter.WithColor(cs.Green))
+						tp.AddField(installed, tableprinter.WithColor(cs.SuccessColor()))
 						tp.AddField(repo.FullName, tableprinter.WithColor(cs.Bold))
 						tp.AddField(repo.Description)
 						tp.EndRow()
@@ -256,41 +256,51 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 
 			return cmd
 		}(),
-		&cobra.Command{
-			Use:     "list",
-			Short:   "List installed extension commands",
-			Aliases: []string{"ls"},
-			Args:    cobra.NoArgs,
-			RunE: func(cmd *cobra.Command, args []string) error {
-				cmds := m.List()
-				if len(cmds) == 0 {
-					return cmdutil.NewNoResultsError("no installed extensions found")
-				}
-				cs := io.ColorScheme()
-				t := tableprinter.New(io, tableprinter.WithHeader("NAME", "REPO", "VERSION"))
-				for _, c := range cmds {
-					// TODO consider a Repo() on Extension interface
-					var repo string
-					if u, err := git.ParseURL(c.URL()); err == nil {
-						if r, err := ghrepo.FromURL(u); err == nil {
-							repo = ghrepo.FullName(r)
-						}
+		func() *cobra.Command {
+			var exporter cmdutil.Exporter
+			cmd := &cobra.Command{
+				Use:     "list",
+				Short:   "List installed extension commands",
+				Aliases: []string{"ls"},
+				Args:    cobra.NoArgs,
+				RunE: func(cmd *cobra.Command, args []string) error {
+					cmds := m.List()
+					if len(cmds) == 0 {
+						return cmdutil.NewNoResultsError("no installed extensions found")
 					}
 
-					t.AddField(fmt.Sprintf("gh %s", c.Name()))
-					t.AddField(repo)
-					version := displayExtensionVersion(c, c.CurrentVersion())
-					if c.IsPinned() {
-						t.AddField(version, tableprinter.WithColor(cs.Cyan))
-					} else {
-						t.AddField(version)
+					if exporter != nil {
+						return exporter.Write(io, extensionsToJSON(cmds))
 					}
 
-					t.EndRow()
-				}
-				return t.Render()
-			},
-		},
+					cs := io.ColorScheme()
+					t := tableprinter.New(io, tableprinter.WithHeader("NAME", "REPO", "VERSION"))
+					for _, c := range cmds {
+						// TODO consider a Repo() on Extension interface
+						var repo string
+						if u, err := git.ParseURL(c.URL()); err == nil {
+							if r, err := ghrepo.FromURL(u); err == nil {
+								repo = ghrepo.FullName(r)
+							}
+						}
+
+						t.AddField(fmt.Sprintf("gh %s", c.Name()))
+						t.AddField(repo)
+						version := displayExtensionVersion(c, c.CurrentVersion())
+						if c.IsPinned() {
+							t.AddField(version, tableprinter.WithColor(cs.InfoColor()))
+						} else {
+							t.AddField(version)
+						}
+
+						t.EndRow()
+					}
+					return t.Render()
+				},
+			}
+			cmdutil.AddJSONFlags(cmd, &exporter, extensionFields)
+			return cmd
+		}(),
 		func() *cobra.Command {
 			var forceFlag bool
 			var pinFlag string
@@ -680,3 +690,31 @@ func displayExtensionVersion(ext extensions.Extension, version string) string {
 	}
 	return version
 }
+
+var extensionFields = []string{"name", "owner", "url", "version", "updateAvailable"}
+
+type extensionJSON struct {
+	Name            string `json:"name"`
+	Owner           string `json:"owner"`
+	URL             string `json:"url"`
+	Version         string `json:"version"`
+	UpdateAvailable bool   `json:"updateAvailable"`
+}
+
+func (e *extensionJSON) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(e, fields)
+}
+
+func extensionsToJSON(exts []extensions.Extension) []*extensionJSON {
+	list := make([]*extensionJSON, len(exts))
+	for i, e := range exts {
+		list[i] = &extensionJSON{
+			Name:            e.Name(),
+			Owner:           e.Owner(),
+			URL:             e.URL(),
+			Version:         e.CurrentVersion(),
+			UpdateAvailable: e.UpdateAvailable(),
+		}
+	}
+	return list
+}
@@ -585,6 +585,35 @@ func TestNewCmdExtension(t *testing.T) {
 			},
 			wantStdout: "gh test\tcli/gh-test\t1\ngh test2\tcli/gh-test2\t1\n",
 		},
+		{
+			name: "list extensions with --json",
+			args: []string{"list", "--json", "name,owner,url,version,updateAvailable"},
+			managerStubs: func(em *extensions.ExtensionManagerMock) func(*testing.T) {
+				em.ListFunc = func() []extensions.Extension {
+					return []extensions.Extension{
+						&extensions.ExtensionMock{
+							NameFunc:            func() string { return "test" },
+							OwnerFunc:           func() string { return "cli" },
+							URLFunc:             func() string { return "https://github.com/cli/gh-test" },
+							CurrentVersionFunc:  func() string { return "1" },
+							UpdateAvailableFunc: func() bool { return false },
+						},
+						&extensions.ExtensionMock{
+							NameFunc:            func() string { return "test2" },
+							OwnerFunc:           func() string { return "cli" },
+							URLFunc:             func() string { return "https://github.com/cli/gh-test2" },
+							CurrentVersionFunc:  func() string { return "2" },
+							UpdateAvailableFunc: func() bool { return true },
+						},
+					}
+				}
+				return func(t *testing.T) {
+					calls := em.ListCalls()
+					assert.Equal(t, 1, len(calls))
+				}
+			},
+			wantStdout: `[{"name":"test","owner":"cli","updateAvailable":false,"url":"https://github.com/cli/gh-test","version":"1"},{"name":"test2","owner":"cli","updateAvailable":true,"url":"https://github.com/cli/gh-test2","version":"2"}]` + "\n",
+		},
 		{
 			name: "create extension interactive",
 			args: []string{"create"},
@@ -96,11 +96,19 @@ func httpClientFunc(f *cmdutil.Factory, appVersion string) func() (*http.Client,
 		if err != nil {
 			return nil, err
 		}
+		requestTimeout, err := requestTimeoutFunc(cfg)
+		if err != nil {
+			return nil, err
+		}
 		opts := api.HTTPClientOptions{
-			Config:      cfg.Authentication(),
-			Log:         io.ErrOut,
-			LogColorize: io.ColorEnabled(),
-			AppVersion:  appVersion,
+			Config:          cfg.Authentication(),
+			Log:             io.ErrOut,
+			LogColorize:     io.ColorEnabled(),
+			AppVersion:      appVersion,
+			RequestTimeout:  requestTimeout,
+			UnixSocket:      unixSocketFunc(cfg),
+			HostTLSConfig:   cfg,
+			HostProxyConfig: cfg,
 		}
 		client, err := api.NewHTTPClient(opts)
 		if err != nil {
@@ -111,6 +119,33 @@ func httpClientFunc(f *cmdutil.Factory, appVersion string) func() (*http.Client,
 	}
 }
 
+// requestTimeoutFunc resolves the per-request timeout, preferring
+// GH_REQUEST_TIMEOUT over the request_timeout config value. A blank value
+// from either source means no timeout.
+func requestTimeoutFunc(cfg config.Config) (time.Duration, error) {
+	value, ok := os.LookupEnv("GH_REQUEST_TIMEOUT")
+	if !ok {
+		value = cfg.RequestTimeout("")
+	}
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid request timeout %q: %w", value, err)
+	}
+	return timeout, nil
+}
+
+// unixSocketFunc resolves the unix domain socket to route API requests
+// through, preferring GH_UNIX_SOCKET over the http_unix_socket config value.
+func unixSocketFunc(cfg config.Config) string {
+	if socket, ok := os.LookupEnv("GH_UNIX_SOCKET"); ok {
+		return socket
+	}
+	return cfg.HTTPUnixSocket("")
+}
+
 func newGitClient(f *cmdutil.Factory) *git.Client {
 	io := f.IOStreams
 	ghPath := f.Executable()
@@ -198,6 +233,21 @@ func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 		io.SetPager(pager)
 	}
 
+	if colorTheme := cfg.ColorTheme(""); colorTheme != "" {
+		io.SetColorTheme(colorTheme)
+	}
+	for roleKey, role := range map[string]iostreams.ColorRole{
+		"colors.success": iostreams.RoleSuccess,
+		"colors.warning": iostreams.RoleWarning,
+		"colors.danger":  iostreams.RoleDanger,
+		"colors.info":    iostreams.RoleInfo,
+		"colors.accent":  iostreams.RoleAccent,
+	} {
+		if color, err := cfg.GetOrDefault("", roleKey); err == nil && color != "" {
+			io.SetColorRoleOverride(role, color)
+		}
+	}
+
 	return io
 }
 
@@ -3,6 +3,8 @@ package factory
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"os"
 	"sort"
 
 	"github.com/cli/cli/v2/context"
@@ -17,6 +19,14 @@ const (
 	GH_HOST = "GH_HOST"
 )
 
+// noopTranslator leaves URLs untouched. It backs the GH_NO_SSH_ALIAS escape hatch for people whose
+// SSH setup doesn't play well with shelling out to `ssh -G` for alias resolution.
+type noopTranslator struct{}
+
+func (noopTranslator) Translate(u *url.URL) *url.URL {
+	return u
+}
+
 type remoteResolver struct {
 	readRemotes   func() (git.RemoteSet, error)
 	getConfig     func() (config.Config, error)
@@ -44,7 +54,11 @@ func (rr *remoteResolver) Resolver() func() (context.Remotes, error) {
 
 		sshTranslate := rr.urlTranslator
 		if sshTranslate == nil {
-			sshTranslate = ssh.NewTranslator()
+			if _, noSSHAlias := os.LookupEnv("GH_NO_SSH_ALIAS"); noSSHAlias {
+				sshTranslate = noopTranslator{}
+			} else {
+				sshTranslate = ssh.NewTranslator()
+			}
 		}
 		resolvedRemotes := context.TranslateRemotes(gitRemotes, sshTranslate)
 
@@ -286,3 +286,29 @@ func Test_remoteResolver(t *testing.T) {
 		})
 	}
 }
+
+func Test_remoteResolver_GH_NO_SSH_ALIAS(t *testing.T) {
+	t.Setenv("GH_NO_SSH_ALIAS", "1")
+
+	cfg := &config.ConfigMock{}
+	cfg.AuthenticationFunc = func() *config.AuthConfig {
+		authCfg := &config.AuthConfig{}
+		authCfg.SetHosts([]string{"github-work"})
+		authCfg.SetDefaultHost("github-work", "hosts")
+		return authCfg
+	}
+
+	rr := &remoteResolver{
+		readRemotes: func() (git.RemoteSet, error) {
+			return git.RemoteSet{
+				git.NewRemote("origin", "ssh://git@github-work/owner/repo.git"),
+			}, nil
+		},
+		getConfig: func() (config.Config, error) { return cfg, nil },
+	}
+
+	resolver := rr.Resolver()
+	remotes, err := resolver()
+	assert.NoError(t, err)
+	assert.Equal(t, "github-work", remotes[0].RepoHost())
+}
@@ -28,11 +28,12 @@ import (
 type CreateOptions struct {
 	IO *iostreams.IOStreams
 
-	Description      string
-	Public           bool
-	Filenames        []string
-	FilenameOverride string
-	WebMode          bool
+	Description       string
+	Public            bool
+	Filenames         []string
+	FilenameOverrides []string
+	WebMode           bool
+	Exporter          cmdutil.Exporter
 
 	Config     func() (config.Config, error)
 	HttpClient func() (*http.Client, error)
@@ -56,6 +57,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			Gists can be created from one or multiple files. Alternatively, pass %[1]s-%[1]s as
 			file name to read from standard input.
 
+			Use %[1]s--filename%[1]s to give a file argument a different name than its own, or to
+			name content piped from standard input; %[1]s--filename%[1]s can be repeated and is
+			matched positionally against the file arguments.
+
 			By default, gists are secret; use %[1]s--public%[1]s to make publicly listed ones.
 		`, "`"),
 		Example: heredoc.Doc(`
@@ -73,6 +78,9 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			# create a gist from output piped from another command
 			$ cat cool.txt | gh gist create
+
+			# name content piped from standard input
+			$ cat cool.txt | gh gist create --filename cool.txt -
 		`),
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -97,7 +105,8 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Description, "desc", "d", "", "A description for this gist")
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser with created gist")
 	cmd.Flags().BoolVarP(&opts.Public, "public", "p", false, "List the gist publicly (default \"secret\")")
-	cmd.Flags().StringVarP(&opts.FilenameOverride, "filename", "f", "", "Provide a filename to be used when reading from standard input")
+	cmd.Flags().StringArrayVarP(&opts.FilenameOverrides, "filename", "f", nil, "Provide a filename to override the name of a corresponding file argument, in the order given; can be repeated")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.GistFields)
 	return cmd
 }
 
@@ -107,7 +116,7 @@ func createRun(opts *CreateOptions) error {
 		fileArgs = []string{"-"}
 	}
 
-	files, err := processFiles(opts.IO.In, opts.FilenameOverride, fileArgs)
+	files, err := processFiles(opts.IO.In, opts.FilenameOverrides, fileArgs)
 	if err != nil {
 		return fmt.Errorf("failed to collect files for posting: %w", err)
 	}
@@ -163,6 +172,10 @@ func createRun(opts *CreateOptions) error {
 	}
 	fmt.Fprintf(errOut, "%s %s\n", cs.SuccessIconWithColor(cs.Green), completionMessage)
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, gist)
+	}
+
 	if opts.WebMode {
 		fmt.Fprintf(opts.IO.Out, "Opening %s in your browser.\n", text.DisplayURL(gist.HTMLURL))
 
@@ -174,21 +187,31 @@ func createRun(opts *CreateOptions) error {
 	return nil
 }
 
-func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []string) (map[string]*shared.GistFile, error) {
+func processFiles(stdin io.ReadCloser, filenameOverrides []string, filenames []string) (map[string]*shared.GistFile, error) {
 	fs := map[string]*shared.GistFile{}
 
 	if len(filenames) == 0 {
 		return nil, errors.New("no files passed")
 	}
 
+	stdinSeen := false
+	for _, f := range filenames {
+		if f == "-" {
+			if stdinSeen {
+				return nil, errors.New("only one file may be read from standard input (\"-\")")
+			}
+			stdinSeen = true
+		}
+	}
+
 	for i, f := range filenames {
 		var filename string
 		var content []byte
 		var err error
 
 		if f == "-" {
-			if filenameOverride != "" {
-				filename = filenameOverride
+			if i < len(filenameOverrides) && filenameOverrides[i] != "" {
+				filename = filenameOverrides[i]
 			} else {
 				filename = fmt.Sprintf("gistfile%d.txt", i)
 			}
@@ -199,7 +222,7 @@ func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []stri
 			stdin.Close()
 
 			if shared.IsBinaryContents(content) {
-				return nil, fmt.Errorf("binary file contents not supported")
+				return nil, errors.New("failed to upload from standard input: binary content is not supported (gists do not support base64-encoded files)")
 			}
 		} else {
 			isBinary, err := shared.IsBinaryFile(f)
@@ -207,7 +230,7 @@ func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []stri
 				return fs, fmt.Errorf("failed to read file %s: %w", f, err)
 			}
 			if isBinary {
-				return nil, fmt.Errorf("failed to upload %s: binary file not supported", f)
+				return nil, fmt.Errorf("failed to upload %s: binary content is not supported (gists do not support base64-encoded files)", f)
 			}
 
 			content, err = os.ReadFile(f)
@@ -215,7 +238,11 @@ func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []stri
 				return fs, fmt.Errorf("failed to read file %s: %w", f, err)
 			}
 
-			filename = filepath.Base(f)
+			if i < len(filenameOverrides) && filenameOverrides[i] != "" {
+				filename = filenameOverrides[i]
+			} else {
+				filename = filepath.Base(f)
+			}
 		}
 
 		fs[filename] = &shared.GistFile{
@@ -24,7 +24,7 @@ import (
 
 func Test_processFiles(t *testing.T) {
 	fakeStdin := strings.NewReader("hey cool how is it going")
-	files, err := processFiles(io.NopCloser(fakeStdin), "", []string{"-"})
+	files, err := processFiles(io.NopCloser(fakeStdin), nil, []string{"-"})
 	if err != nil {
 		t.Fatalf("unexpected error processing files: %s", err)
 	}
@@ -33,6 +33,23 @@ func Test_processFiles(t *testing.T) {
 	assert.Equal(t, "hey cool how is it going", files["gistfile0.txt"].Content)
 }
 
+func Test_processFiles_filenameOverride(t *testing.T) {
+	fakeStdin := strings.NewReader("hey cool how is it going")
+	files, err := processFiles(io.NopCloser(fakeStdin), []string{"greetings.txt"}, []string{"-"})
+	if err != nil {
+		t.Fatalf("unexpected error processing files: %s", err)
+	}
+
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "hey cool how is it going", files["greetings.txt"].Content)
+}
+
+func Test_processFiles_multipleStdinRejected(t *testing.T) {
+	fakeStdin := strings.NewReader("hey cool how is it going")
+	_, err := processFiles(io.NopCloser(fakeStdin), nil, []string{"-", "-"})
+	assert.EqualError(t, err, `only one file may be read from standard input ("-")`)
+}
+
 func Test_guessGistName_stdin(t *testing.T) {
 	files := map[string]*shared.GistFile{
 		"gistfile0.txt": {Content: "sample content"},
@@ -125,6 +142,17 @@ func TestNewCmdCreate(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name: "repeated filename override",
+			cli:  "--filename cool.txt --filename great.txt -",
+			wants: CreateOptions{
+				Description:       "",
+				Public:            false,
+				Filenames:         []string{"-"},
+				FilenameOverrides: []string{"cool.txt", "great.txt"},
+			},
+			wantsErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -159,6 +187,7 @@ func TestNewCmdCreate(t *testing.T) {
 
 			assert.Equal(t, tt.wants.Description, gotOpts.Description)
 			assert.Equal(t, tt.wants.Public, gotOpts.Public)
+			assert.Equal(t, tt.wants.FilenameOverrides, gotOpts.FilenameOverrides)
 		})
 	}
 }
@@ -311,6 +340,31 @@ func Test_createRun(t *testing.T) {
 			},
 			responseStatus: http.StatusOK,
 		},
+		{
+			name: "with json",
+			opts: &CreateOptions{
+				Filenames: []string{fixtureFile},
+				Exporter: func() cmdutil.Exporter {
+					exporter := cmdutil.NewJSONExporter()
+					exporter.SetFields([]string{"url", "gitPullUrl"})
+					return exporter
+				}(),
+			},
+			wantOut:    "{\"gitPullUrl\":\"https://gist.github.com/aa5a315d61ae9438b18d.git\",\"url\":\"https://gist.github.com/aa5a315d61ae9438b18d\"}\n",
+			wantStderr: "- Creating gist fixture.txt\n✓ Created secret gist fixture.txt\n",
+			wantErr:    false,
+			wantParams: map[string]interface{}{
+				"description": "",
+				"updated_at":  "0001-01-01T00:00:00Z",
+				"public":      false,
+				"files": map[string]interface{}{
+					"fixture.txt": map[string]interface{}{
+						"content": "{}",
+					},
+				},
+			},
+			responseStatus: http.StatusOK,
+		},
 	}
 	for _, tt := range tests {
 		reg := &httpmock.Registry{}
@@ -318,7 +372,8 @@ func Test_createRun(t *testing.T) {
 			reg.Register(
 				httpmock.REST("POST", "gists"),
 				httpmock.StringResponse(`{
-					"html_url": "https://gist.github.com/aa5a315d61ae9438b18d"
+					"html_url": "https://gist.github.com/aa5a315d61ae9438b18d",
+					"git_pull_url": "https://gist.github.com/aa5a315d61ae9438b18d.git"
 				}`))
 		} else {
 			reg.Register(
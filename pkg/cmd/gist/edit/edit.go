@@ -32,12 +32,13 @@ type EditOptions struct {
 
 	Edit func(string, string, string, *iostreams.IOStreams) (string, error)
 
-	Selector       string
-	EditFilename   string
-	AddFilename    string
-	RemoveFilename string
-	SourceFile     string
-	Description    string
+	Selector        string
+	EditFilename    string
+	AddFilenames    []string
+	RemoveFilenames []string
+	RenameFilenames []string
+	SourceFile      string
+	Description     string
 }
 
 func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
@@ -80,13 +81,15 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.AddFilename, "add", "a", "", "Add a new file to the gist")
+	cmd.Flags().StringArrayVarP(&opts.AddFilenames, "add", "a", nil, "Add a new file to the gist")
 	cmd.Flags().StringVarP(&opts.Description, "desc", "d", "", "New description for the gist")
 	cmd.Flags().StringVarP(&opts.EditFilename, "filename", "f", "", "Select a file to edit")
-	cmd.Flags().StringVarP(&opts.RemoveFilename, "remove", "r", "", "Remove a file from the gist")
+	cmd.Flags().StringArrayVarP(&opts.RemoveFilenames, "remove", "r", nil, "Remove a file from the gist")
+	cmd.Flags().StringArrayVar(&opts.RenameFilenames, "rename", nil, "Rename a file in the gist, in the `old=new` format")
 
-	cmd.MarkFlagsMutuallyExclusive("add", "remove")
-	cmd.MarkFlagsMutuallyExclusive("remove", "filename")
+	cmd.MarkFlagsMutuallyExclusive("filename", "add")
+	cmd.MarkFlagsMutuallyExclusive("filename", "remove")
+	cmd.MarkFlagsMutuallyExclusive("filename", "rename")
 
 	return cmd
 }
@@ -144,7 +147,7 @@ func editRun(opts *EditOptions) error {
 	}
 
 	if username != gist.Owner.Login {
-		return errors.New("you do not own this gist")
+		return fmt.Errorf("gist %s is owned by %s, not you", gistID, gist.Owner.Login)
 	}
 
 	// Transform our gist into the schema that the update endpoint expects
@@ -168,50 +171,82 @@ func editRun(opts *EditOptions) error {
 		gistToUpdate.Description = opts.Description
 	}
 
-	if opts.AddFilename != "" {
-		var input io.Reader
-		switch src := opts.SourceFile; {
-		case src == "-":
-			input = opts.IO.In
-		case src != "":
-			f, err := os.Open(src)
+	if len(opts.AddFilenames) > 0 || len(opts.RemoveFilenames) > 0 || len(opts.RenameFilenames) > 0 {
+		renames, err := parseRenames(opts.RenameFilenames)
+		if err != nil {
+			return err
+		}
+		for _, filename := range opts.RemoveFilenames {
+			if _, renamed := renames[filename]; renamed {
+				return fmt.Errorf("file %q can't be both removed and renamed", filename)
+			}
+		}
+
+		// A pure add (no removes or renames) only needs to send the new file(s); the
+		// rest of the gist is left untouched by the API either way.
+		if len(opts.RemoveFilenames) == 0 && len(opts.RenameFilenames) == 0 {
+			gistToUpdate.Files = map[string]*gistFileToUpdate{}
+		}
+
+		for _, addFilename := range opts.AddFilenames {
+			// --source only applies when a single file is being added; with multiple
+			// files each one is read from its own path.
+			sourceFile := ""
+			if len(opts.AddFilenames) == 1 {
+				sourceFile = opts.SourceFile
+			}
+
+			var input io.Reader
+			switch {
+			case sourceFile == "-":
+				input = opts.IO.In
+			case sourceFile != "":
+				f, err := os.Open(sourceFile)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = f.Close()
+				}()
+				input = f
+			default:
+				f, err := os.Open(addFilename)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = f.Close()
+				}()
+				input = f
+			}
+
+			content, err := io.ReadAll(input)
 			if err != nil {
-				return err
+				return fmt.Errorf("read content: %w", err)
 			}
-			defer func() {
-				_ = f.Close()
-			}()
-			input = f
-		default:
-			f, err := os.Open(opts.AddFilename)
+
+			files, err := getFilesToAdd(addFilename, content)
 			if err != nil {
 				return err
 			}
-			defer func() {
-				_ = f.Close()
-			}()
-			input = f
-		}
 
-		content, err := io.ReadAll(input)
-		if err != nil {
-			return fmt.Errorf("read content: %w", err)
+			for filename, file := range files {
+				gistToUpdate.Files[filename] = file
+			}
 		}
 
-		files, err := getFilesToAdd(opts.AddFilename, content)
-		if err != nil {
-			return err
+		for _, filename := range opts.RemoveFilenames {
+			if err := removeFile(gistToUpdate, filename); err != nil {
+				return err
+			}
 		}
 
-		gistToUpdate.Files = files
-		return updateGist(apiClient, host, gistToUpdate)
-	}
-
-	// Remove a file from the gist
-	if opts.RemoveFilename != "" {
-		err := removeFile(gistToUpdate, opts.RemoveFilename)
-		if err != nil {
-			return err
+		for oldFilename, newFilename := range renames {
+			file, found := gistToUpdate.Files[oldFilename]
+			if !found || file == nil {
+				return fmt.Errorf("gist has no file %q", oldFilename)
+			}
+			file.NewFilename = newFilename
 		}
 
 		return updateGist(apiClient, host, gistToUpdate)
@@ -378,6 +413,21 @@ func getFilesToAdd(file string, content []byte) (map[string]*gistFileToUpdate, e
 	}, nil
 }
 
+func parseRenames(specs []string) (map[string]string, error) {
+	renames := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		oldFilename, newFilename, ok := strings.Cut(spec, "=")
+		if !ok || oldFilename == "" || newFilename == "" {
+			return nil, fmt.Errorf("invalid value for --rename: %q; must be in the format old=new", spec)
+		}
+		if _, seen := renames[oldFilename]; seen {
+			return nil, fmt.Errorf("file %q specified more than once to --rename", oldFilename)
+		}
+		renames[oldFilename] = newFilename
+	}
+	return renames, nil
+}
+
 func removeFile(gist gistToUpdate, filename string) error {
 	if _, found := gist.Files[filename]; !found {
 		return fmt.Errorf("gist has no file %q", filename)
@@ -60,17 +60,25 @@ func TestNewCmdEdit(t *testing.T) {
 			name: "add",
 			cli:  "123 --add cool.md",
 			wants: EditOptions{
-				Selector:    "123",
-				AddFilename: "cool.md",
+				Selector:     "123",
+				AddFilenames: []string{"cool.md"},
+			},
+		},
+		{
+			name: "add multiple",
+			cli:  "123 --add cool.md --add great.md",
+			wants: EditOptions{
+				Selector:     "123",
+				AddFilenames: []string{"cool.md", "great.md"},
 			},
 		},
 		{
 			name: "add with source",
 			cli:  "123 --add cool.md -",
 			wants: EditOptions{
-				Selector:    "123",
-				AddFilename: "cool.md",
-				SourceFile:  "-",
+				Selector:     "123",
+				AddFilenames: []string{"cool.md"},
+				SourceFile:   "-",
 			},
 		},
 		{
@@ -85,20 +93,38 @@ func TestNewCmdEdit(t *testing.T) {
 			name: "remove",
 			cli:  "123 --remove cool.md",
 			wants: EditOptions{
-				Selector:       "123",
-				RemoveFilename: "cool.md",
+				Selector:        "123",
+				RemoveFilenames: []string{"cool.md"},
 			},
 		},
 		{
-			name:     "add and remove are mutually exclusive",
-			cli:      "123 --add cool.md --remove great.md",
-			wantsErr: true,
+			name: "rename",
+			cli:  "123 --rename old.md=new.md",
+			wants: EditOptions{
+				Selector:        "123",
+				RenameFilenames: []string{"old.md=new.md"},
+			},
+		},
+		{
+			name: "add, remove, and rename combined",
+			cli:  "123 --add cool.md --remove great.md --rename old.md=new.md",
+			wants: EditOptions{
+				Selector:        "123",
+				AddFilenames:    []string{"cool.md"},
+				RemoveFilenames: []string{"great.md"},
+				RenameFilenames: []string{"old.md=new.md"},
+			},
 		},
 		{
 			name:     "filename and remove are mutually exclusive",
 			cli:      "123 --filename cool.md --remove great.md",
 			wantsErr: true,
 		},
+		{
+			name:     "filename and rename are mutually exclusive",
+			cli:      "123 --filename cool.md --rename old.md=new.md",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -127,9 +153,10 @@ func TestNewCmdEdit(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Equal(t, tt.wants.EditFilename, gotOpts.EditFilename)
-			require.Equal(t, tt.wants.AddFilename, gotOpts.AddFilename)
+			require.Equal(t, tt.wants.AddFilenames, gotOpts.AddFilenames)
 			require.Equal(t, tt.wants.Selector, gotOpts.Selector)
-			require.Equal(t, tt.wants.RemoveFilename, gotOpts.RemoveFilename)
+			require.Equal(t, tt.wants.RemoveFilenames, gotOpts.RemoveFilenames)
+			require.Equal(t, tt.wants.RenameFilenames, gotOpts.RenameFilenames)
 		})
 	}
 }
@@ -287,7 +314,7 @@ func Test_editRun(t *testing.T) {
 				},
 				Owner: &shared.GistOwner{Login: "octocat2"},
 			},
-			wantErr: "you do not own this gist",
+			wantErr: "gist 1234 is owned by octocat2, not you",
 		},
 		{
 			name: "add file to existing gist",
@@ -307,7 +334,7 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				AddFilename: fileToAdd,
+				AddFilenames: []string{fileToAdd},
 			},
 		},
 		{
@@ -358,8 +385,8 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				AddFilename: "from_source.txt",
-				SourceFile:  fileToAdd,
+				AddFilenames: []string{"from_source.txt"},
+				SourceFile:   fileToAdd,
 			},
 			wantParams: map[string]interface{}{
 				"description": "",
@@ -389,8 +416,8 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				AddFilename: "from_source.txt",
-				SourceFile:  "-",
+				AddFilenames: []string{"from_source.txt"},
+				SourceFile:   "-",
 			},
 			stdin: "data from stdin",
 			wantParams: map[string]interface{}{
@@ -417,7 +444,7 @@ func Test_editRun(t *testing.T) {
 				Owner: &shared.GistOwner{Login: "octocat"},
 			},
 			opts: &EditOptions{
-				RemoveFilename: "sample2.txt",
+				RemoveFilenames: []string{"sample2.txt"},
 			},
 			wantErr: "gist has no file \"sample2.txt\"",
 		},
@@ -444,7 +471,7 @@ func Test_editRun(t *testing.T) {
 					httpmock.StatusStringResponse(201, "{}"))
 			},
 			opts: &EditOptions{
-				RemoveFilename: "sample2.txt",
+				RemoveFilenames: []string{"sample2.txt"},
 			},
 			wantParams: map[string]interface{}{
 				"description": "",
@@ -457,6 +484,115 @@ func Test_editRun(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "rename file in existing gist",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"sample.txt": {
+						Filename: "sample.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "gists/1234"),
+					httpmock.StatusStringResponse(201, "{}"))
+			},
+			opts: &EditOptions{
+				RenameFilenames: []string{"sample.txt=renamed.txt"},
+			},
+			wantParams: map[string]interface{}{
+				"description": "",
+				"files": map[string]interface{}{
+					"sample.txt": map[string]interface{}{
+						"filename": "renamed.txt",
+						"content":  "bwhiizzzbwhuiiizzzz",
+					},
+				},
+			},
+		},
+		{
+			name: "rename file that does not exist",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"sample.txt": {
+						Filename: "sample.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			opts: &EditOptions{
+				RenameFilenames: []string{"missing.txt=renamed.txt"},
+			},
+			wantErr: "gist has no file \"missing.txt\"",
+		},
+		{
+			name: "remove and rename the same file is rejected",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"sample.txt": {
+						Filename: "sample.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			opts: &EditOptions{
+				RemoveFilenames: []string{"sample.txt"},
+				RenameFilenames: []string{"sample.txt=renamed.txt"},
+			},
+			wantErr: `file "sample.txt" can't be both removed and renamed`,
+		},
+		{
+			name: "add, remove, and rename combined",
+			gist: &shared.Gist{
+				ID: "1234",
+				Files: map[string]*shared.GistFile{
+					"sample.txt": {
+						Filename: "sample.txt",
+						Content:  "bwhiizzzbwhuiiizzzz",
+						Type:     "text/plain",
+					},
+					"old.txt": {
+						Filename: "old.txt",
+						Content:  "so long",
+						Type:     "text/plain",
+					},
+				},
+				Owner: &shared.GistOwner{Login: "octocat"},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("POST", "gists/1234"),
+					httpmock.StatusStringResponse(201, "{}"))
+			},
+			opts: &EditOptions{
+				AddFilenames:    []string{fileToAdd},
+				RemoveFilenames: []string{"sample.txt"},
+				RenameFilenames: []string{"old.txt=new.txt"},
+			},
+			wantParams: map[string]interface{}{
+				"description": "",
+				"files": map[string]interface{}{
+					"sample.txt": nil,
+					"old.txt": map[string]interface{}{
+						"filename": "new.txt",
+						"content":  "so long",
+					},
+					filepath.Base(fileToAdd): map[string]interface{}{
+						"filename": filepath.Base(fileToAdd),
+						"content":  "hello",
+					},
+				},
+			},
+		},
 		{
 			name: "edit gist using file from source parameter",
 			gist: &shared.Gist{
@@ -19,9 +19,12 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	Config     func() (config.Config, error)
 	HttpClient func() (*http.Client, error)
+	Exporter   cmdutil.Exporter
 
 	Limit      int
 	Visibility string // all, secret, public
+	Filter     string
+	Language   string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -61,6 +64,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 10, "Maximum number of gists to fetch")
 	cmd.Flags().BoolVar(&flagPublic, "public", false, "Show only public gists")
 	cmd.Flags().BoolVar(&flagSecret, "secret", false, "Show only secret gists")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Filter gists by a substring of their description or filenames")
+	cmd.Flags().StringVar(&opts.Language, "language", "", "Filter gists by the language of their files")
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.GistFields)
 
 	return cmd
 }
@@ -78,7 +85,12 @@ func listRun(opts *ListOptions) error {
 
 	host, _ := cfg.Authentication().DefaultHost()
 
-	gists, err := shared.ListGists(client, host, opts.Limit, opts.Visibility)
+	gists, err := shared.ListGists(client, host, shared.ListGistsOptions{
+		Limit:      opts.Limit,
+		Visibility: opts.Visibility,
+		Filter:     opts.Filter,
+		Language:   opts.Language,
+	})
 	if err != nil {
 		return err
 	}
@@ -87,6 +99,10 @@ func listRun(opts *ListOptions) error {
 		return cmdutil.NewNoResultsError("no gists found")
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, gists)
+	}
+
 	if err := opts.IO.StartPager(); err == nil {
 		defer opts.IO.StopPager()
 	} else {
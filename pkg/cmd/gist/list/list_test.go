@@ -69,6 +69,24 @@ func TestNewCmdList(t *testing.T) {
 				Visibility: "all",
 			},
 		},
+		{
+			name: "filter",
+			cli:  `--filter "cool stuff"`,
+			wants: ListOptions{
+				Limit:      10,
+				Visibility: "all",
+				Filter:     "cool stuff",
+			},
+		},
+		{
+			name: "language",
+			cli:  "--language go",
+			wants: ListOptions{
+				Limit:      10,
+				Visibility: "all",
+				Language:   "go",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,6 +111,8 @@ func TestNewCmdList(t *testing.T) {
 
 			assert.Equal(t, tt.wants.Visibility, gotOpts.Visibility)
 			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Filter, gotOpts.Filter)
+			assert.Equal(t, tt.wants.Language, gotOpts.Language)
 		})
 	}
 }
@@ -293,6 +313,98 @@ func Test_listRun(t *testing.T) {
 				1234567890  cool.txt     1 file  public      about 6 hours ago
 			`),
 		},
+		{
+			name: "with filter",
+			opts: &ListOptions{Filter: "leaves"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(query),
+					httpmock.StringResponse(fmt.Sprintf(
+						`{ "data": { "viewer": { "gists": { "nodes": [
+							{
+								"name": "1234567890",
+								"files": [{ "name": "cool.txt" }],
+								"description": "",
+								"updatedAt": "%[1]v",
+								"isPublic": true
+							},
+							{
+								"name": "2345678901",
+								"files": [{ "name": "gistfile0.txt" }],
+								"description": "tea leaves thwart those who court catastrophe",
+								"updatedAt": "%[1]v",
+								"isPublic": false
+							}
+						] } } } }`,
+						sixHoursAgo.Format(time.RFC3339),
+					)),
+				)
+			},
+			wantOut: heredoc.Doc(`
+				ID          DESCRIPTION                    FILES   VISIBILITY  UPDATED
+				2345678901  tea leaves thwart those wh...  1 file  secret      about 6 hours ago
+			`),
+		},
+		{
+			name: "with language filter",
+			opts: &ListOptions{Language: "go"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(query),
+					httpmock.StringResponse(fmt.Sprintf(
+						`{ "data": { "viewer": { "gists": { "nodes": [
+							{
+								"name": "1234567890",
+								"files": [{ "name": "cool.txt", "language": { "name": "Text" } }],
+								"description": "",
+								"updatedAt": "%[1]v",
+								"isPublic": true
+							},
+							{
+								"name": "2345678901",
+								"files": [{ "name": "main.go", "language": { "name": "Go" } }],
+								"description": "",
+								"updatedAt": "%[1]v",
+								"isPublic": true
+							}
+						] } } } }`,
+						sixHoursAgo.Format(time.RFC3339),
+					)),
+				)
+			},
+			wantOut: heredoc.Doc(`
+				ID          DESCRIPTION  FILES   VISIBILITY  UPDATED
+				2345678901  main.go      1 file  public      about 6 hours ago
+			`),
+		},
+		{
+			name: "with json",
+			opts: &ListOptions{
+				Exporter: func() cmdutil.Exporter {
+					exporter := cmdutil.NewJSONExporter()
+					exporter.SetFields([]string{"id", "files"})
+					return exporter
+				}(),
+			},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(query),
+					httpmock.StringResponse(fmt.Sprintf(
+						`{ "data": { "viewer": { "gists": { "nodes": [
+							{
+								"name": "1234567890",
+								"files": [{ "name": "cool.txt", "language": { "name": "Text" } }],
+								"description": "",
+								"updatedAt": "%[1]v",
+								"isPublic": true
+							}
+						] } } } }`,
+						sixHoursAgo.Format(time.RFC3339),
+					)),
+				)
+			},
+			wantOut: "[{\"files\":{\"cool.txt\":{\"filename\":\"cool.txt\",\"language\":\"Text\",\"rawUrl\":\"\",\"type\":\"\"}},\"id\":\"1234567890\"}]\n",
+		},
 		{
 			name: "nontty output",
 			opts: &ListOptions{},
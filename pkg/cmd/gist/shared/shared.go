@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sort"
 	"strings"
 	"time"
@@ -22,6 +23,7 @@ type GistFile struct {
 	Type     string `json:"type,omitempty"`
 	Language string `json:"language,omitempty"`
 	Content  string `json:"content"`
+	RawURL   string `json:"raw_url,omitempty"`
 }
 
 type GistOwner struct {
@@ -35,9 +37,57 @@ type Gist struct {
 	UpdatedAt   time.Time            `json:"updated_at"`
 	Public      bool                 `json:"public"`
 	HTMLURL     string               `json:"html_url,omitempty"`
+	GitPullURL  string               `json:"git_pull_url,omitempty"`
+	GitPushURL  string               `json:"git_push_url,omitempty"`
 	Owner       *GistOwner           `json:"owner,omitempty"`
 }
 
+// GistFields lists the fields selectable via `--json` on gist commands that export a Gist.
+var GistFields = []string{
+	"id",
+	"description",
+	"files",
+	"public",
+	"url",
+	"gitPullUrl",
+	"gitPushUrl",
+	"updatedAt",
+	"owner",
+}
+
+func (gist *Gist) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(gist).Elem()
+	fieldByName := func(v reflect.Value, field string) reflect.Value {
+		return v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(field, s)
+		})
+	}
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		switch f {
+		case "url":
+			data[f] = gist.HTMLURL
+		case "files":
+			files := make(map[string]interface{}, len(gist.Files))
+			for name, file := range gist.Files {
+				files[name] = map[string]interface{}{
+					"filename": file.Filename,
+					"type":     file.Type,
+					"language": file.Language,
+					"rawUrl":   file.RawURL,
+				}
+			}
+			data[f] = files
+		default:
+			sf := fieldByName(v, f)
+			data[f] = sf.Interface()
+		}
+	}
+
+	return data
+}
+
 var NotFoundErr = errors.New("not found")
 
 func GetGist(client *http.Client, hostname, gistID string) (*Gist, error) {
@@ -74,14 +124,27 @@ func GistIDFromURL(gistURL string) (string, error) {
 	return "", fmt.Errorf("Invalid gist URL %s", u)
 }
 
-func ListGists(client *http.Client, hostname string, limit int, visibility string) ([]Gist, error) {
+// ListGistsOptions controls which gists ListGists returns. Filter and Language are
+// applied client-side, since the GraphQL gist list API has no equivalent server-side
+// filters.
+type ListGistsOptions struct {
+	Limit      int
+	Visibility string // all, secret, public
+	Filter     string // substring matched against description and filenames
+	Language   string // matched against a file's detected language
+}
+
+func ListGists(client *http.Client, hostname string, opts ListGistsOptions) ([]Gist, error) {
 	type response struct {
 		Viewer struct {
 			Gists struct {
 				Nodes []struct {
 					Description string
 					Files       []struct {
-						Name string
+						Name     string
+						Language struct {
+							Name string
+						}
 					}
 					IsPublic  bool
 					Name      string
@@ -95,6 +158,8 @@ func ListGists(client *http.Client, hostname string, limit int, visibility strin
 		}
 	}
 
+	limit := opts.Limit
+
 	perPage := limit
 	if perPage > 100 {
 		perPage = 100
@@ -103,7 +168,7 @@ func ListGists(client *http.Client, hostname string, limit int, visibility strin
 	variables := map[string]interface{}{
 		"per_page":   githubv4.Int(perPage),
 		"endCursor":  (*githubv4.String)(nil),
-		"visibility": githubv4.GistPrivacy(strings.ToUpper(visibility)),
+		"visibility": githubv4.GistPrivacy(strings.ToUpper(opts.Visibility)),
 	}
 
 	gql := api.NewClientFromHTTP(client)
@@ -122,19 +187,23 @@ pagination:
 			for _, file := range gist.Files {
 				files[file.Name] = &GistFile{
 					Filename: file.Name,
+					Language: file.Language.Name,
 				}
 			}
 
-			gists = append(
-				gists,
-				Gist{
-					ID:          gist.Name,
-					Description: gist.Description,
-					Files:       files,
-					UpdatedAt:   gist.UpdatedAt,
-					Public:      gist.IsPublic,
-				},
-			)
+			g := Gist{
+				ID:          gist.Name,
+				Description: gist.Description,
+				Files:       files,
+				UpdatedAt:   gist.UpdatedAt,
+				Public:      gist.IsPublic,
+			}
+
+			if !gistMatchesFilter(g, opts.Filter) || !gistMatchesLanguage(g, opts.Language) {
+				continue
+			}
+
+			gists = append(gists, g)
 			if len(gists) == limit {
 				break pagination
 			}
@@ -149,6 +218,38 @@ pagination:
 	return gists, nil
 }
 
+func gistMatchesFilter(gist Gist, filter string) bool {
+	if filter == "" {
+		return true
+	}
+
+	if strings.Contains(strings.ToLower(gist.Description), strings.ToLower(filter)) {
+		return true
+	}
+
+	for filename := range gist.Files {
+		if strings.Contains(strings.ToLower(filename), strings.ToLower(filter)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func gistMatchesLanguage(gist Gist, language string) bool {
+	if language == "" {
+		return true
+	}
+
+	for _, file := range gist.Files {
+		if strings.EqualFold(file.Language, language) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func IsBinaryFile(file string) (bool, error) {
 	detectedMime, err := mimetype.DetectFile(file)
 	if err != nil {
@@ -177,7 +278,7 @@ func IsBinaryContents(contents []byte) bool {
 }
 
 func PromptGists(prompter prompter.Prompter, client *http.Client, host string, cs *iostreams.ColorScheme) (gistID string, err error) {
-	gists, err := ListGists(client, host, 10, "all")
+	gists, err := ListGists(client, host, ListGistsOptions{Limit: 10, Visibility: "all"})
 	if err != nil {
 		return "", err
 	}
@@ -212,7 +313,7 @@ func PromptGists(prompter prompter.Prompter, client *http.Client, host string, c
 		opts = append(opts, opt)
 	}
 
-	result, err := prompter.Select("Select a gist", "", opts)
+	result, err := prompter.SelectWithFilter("Select a gist", "", opts)
 
 	if err != nil {
 		return "", err
@@ -104,7 +104,7 @@ func TestPromptGists(t *testing.T) {
 		{
 			name: "multiple files, select first gist",
 			prompterStubs: func(pm *prompter.MockPrompter) {
-				pm.RegisterSelect("Select a gist",
+				pm.RegisterSelectWithFilter("Select a gist",
 					[]string{"cool.txt  about 6 hours ago", "gistfile0.txt  about 6 hours ago"},
 					func(_, _ string, opts []string) (int, error) {
 						return prompter.IndexFor(opts, "cool.txt  about 6 hours ago")
@@ -131,7 +131,7 @@ func TestPromptGists(t *testing.T) {
 		{
 			name: "multiple files, select second gist",
 			prompterStubs: func(pm *prompter.MockPrompter) {
-				pm.RegisterSelect("Select a gist",
+				pm.RegisterSelectWithFilter("Select a gist",
 					[]string{"cool.txt  about 6 hours ago", "gistfile0.txt  about 6 hours ago"},
 					func(_, _ string, opts []string) (int, error) {
 						return prompter.IndexFor(opts, "gistfile0.txt  about 6 hours ago")
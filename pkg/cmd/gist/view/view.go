@@ -161,10 +161,19 @@ func viewRun(opts *ViewOptions) error {
 		return nil
 	}
 
+	filenames := make([]string, 0, len(gist.Files))
+	for fn := range gist.Files {
+		filenames = append(filenames, fn)
+	}
+
+	sort.Slice(filenames, func(i, j int) bool {
+		return strings.ToLower(filenames[i]) < strings.ToLower(filenames[j])
+	})
+
 	if opts.Filename != "" {
 		gistFile, ok := gist.Files[opts.Filename]
 		if !ok {
-			return fmt.Errorf("gist has no such file: %q", opts.Filename)
+			return fmt.Errorf("gist has no such file: %q; available files: %s", opts.Filename, strings.Join(filenames, ", "))
 		}
 		return render(gistFile)
 	}
@@ -174,14 +183,6 @@ func viewRun(opts *ViewOptions) error {
 	}
 
 	showFilenames := len(gist.Files) > 1
-	filenames := make([]string, 0, len(gist.Files))
-	for fn := range gist.Files {
-		filenames = append(filenames, fn)
-	}
-
-	sort.Slice(filenames, func(i, j int) bool {
-		return strings.ToLower(filenames[i]) < strings.ToLower(filenames[j])
-	})
 
 	if opts.ListFiles {
 		for _, fn := range filenames {
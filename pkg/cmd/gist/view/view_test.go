@@ -115,6 +115,7 @@ func Test_viewRun(t *testing.T) {
 		wantOut      string
 		gist         *shared.Gist
 		wantErr      bool
+		wantErrMsg   string
 		mockGistList bool
 	}{
 		{
@@ -179,6 +180,28 @@ func Test_viewRun(t *testing.T) {
 			},
 			wantOut: "bwhiizzzbwhuiiizzzz\n",
 		},
+		{
+			name: "filename not found",
+			opts: &ViewOptions{
+				Selector:  "1234",
+				Filename:  "nonexistent.txt",
+				ListFiles: false,
+			},
+			gist: &shared.Gist{
+				Files: map[string]*shared.GistFile{
+					"cicada.txt": {
+						Content: "bwhiizzzbwhuiiizzzz",
+						Type:    "text/plain",
+					},
+					"foo.md": {
+						Content: "# foo",
+						Type:    "application/markdown",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: `gist has no such file: "nonexistent.txt"; available files: cicada.txt, foo.md`,
+		},
 		{
 			name: "filename selected, raw",
 			opts: &ViewOptions{
@@ -201,6 +224,39 @@ func Test_viewRun(t *testing.T) {
 			},
 			wantOut: "bwhiizzzbwhuiiizzzz\n",
 		},
+		{
+			name: "single markdown file, rendered",
+			opts: &ViewOptions{
+				Selector:  "1234",
+				ListFiles: false,
+			},
+			gist: &shared.Gist{
+				Files: map[string]*shared.GistFile{
+					"foo.md": {
+						Content: "# foo",
+						Type:    "application/markdown",
+					},
+				},
+			},
+			wantOut: "\n  # foo                                                                       \n\n",
+		},
+		{
+			name: "single markdown file, raw",
+			opts: &ViewOptions{
+				Selector:  "1234",
+				Raw:       true,
+				ListFiles: false,
+			},
+			gist: &shared.Gist{
+				Files: map[string]*shared.GistFile{
+					"foo.md": {
+						Content: "# foo",
+						Type:    "application/markdown",
+					},
+				},
+			},
+			wantOut: "# foo\n",
+		},
 		{
 			name: "multiple files, no description",
 			opts: &ViewOptions{
@@ -360,7 +416,7 @@ func Test_viewRun(t *testing.T) {
 			)
 
 			pm := prompter.NewMockPrompter(t)
-			pm.RegisterSelect("Select a gist", []string{"cool.txt  about 6 hours ago"}, func(_, _ string, opts []string) (int, error) {
+			pm.RegisterSelectWithFilter("Select a gist", []string{"cool.txt  about 6 hours ago"}, func(_, _ string, opts []string) (int, error) {
 				return 0, nil
 			})
 			tt.opts.Prompter = pm
@@ -382,6 +438,9 @@ func Test_viewRun(t *testing.T) {
 			err := viewRun(tt.opts)
 			if tt.wantErr {
 				assert.Error(t, err)
+				if tt.wantErrMsg != "" {
+					assert.Equal(t, tt.wantErrMsg, err.Error())
+				}
 				return
 			}
 			assert.NoError(t, err)
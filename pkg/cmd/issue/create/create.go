@@ -96,10 +96,11 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return errors.New("`--template` is not supported when using `--body` or `--body-file`")
 			}
 
-			opts.Interactive = !(titleProvided && bodyProvided)
+			templateProvidesBody := opts.Template != "" && !opts.IO.CanPrompt()
+			opts.Interactive = !(titleProvided && (bodyProvided || templateProvidesBody))
 
 			if opts.Interactive && !opts.IO.CanPrompt() {
-				return cmdutil.FlagErrorf("must provide `--title` and `--body` when not running interactively")
+				return cmdutil.NewFlagRequiredInNonInteractiveError("--title", "--body")
 			}
 
 			if runF != nil {
@@ -289,6 +290,28 @@ func createRun(opts *CreateOptions) (err error) {
 			err = fmt.Errorf("title can't be blank")
 			return
 		}
+
+		if opts.Template != "" && tb.Body == "" {
+			var template prShared.Template
+			template, err = tpl.Select(opts.Template)
+			if err != nil {
+				return
+			}
+			tb.Body = string(template.Body())
+			templateNameForSubmit = template.NameForSubmit()
+
+			if len(tb.Labels) == 0 {
+				tb.Labels = template.Labels()
+			}
+			if len(tb.Assignees) == 0 {
+				var templateAssignees []string
+				templateAssignees, err = meReplacer.ReplaceSlice(template.Assignees())
+				if err != nil {
+					return
+				}
+				tb.Assignees = templateAssignees
+			}
+		}
 	}
 
 	if action == prShared.PreviewAction {
@@ -110,7 +110,15 @@ func TestNewCmdCreate(t *testing.T) {
 			name:     "template from name non-tty",
 			tty:      false,
 			cli:      `-t mytitle --template "bug report"`,
-			wantsErr: true,
+			wantsErr: false,
+			wantsOpts: CreateOptions{
+				Title:       "mytitle",
+				Body:        "",
+				RecoverFile: "",
+				WebMode:     false,
+				Template:    "bug report",
+				Interactive: false,
+			},
 		},
 		{
 			name:     "template and body",
@@ -576,6 +584,51 @@ func TestIssueCreate_nonLegacyTemplate(t *testing.T) {
 	assert.Equal(t, "", output.BrowsedURL)
 }
 
+func TestIssueCreate_nonInteractiveTemplate(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPOID",
+				"hasIssuesEnabled": true
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueTemplates\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "issueTemplates": [
+				{ "name": "Bug report",
+				  "body": "Does not work :((" },
+				{ "name": "Submit a request",
+				  "body": "I have a suggestion for an enhancement" }
+			] } } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+			{ "data": { "createIssue": { "issue": {
+				"URL": "https://github.com/OWNER/REPO/issues/12"
+			} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["repositoryId"], "REPOID")
+				assert.Equal(t, inputs["title"], "hello")
+				assert.Equal(t, inputs["body"], "I have a suggestion for an enhancement")
+				assert.Equal(t, inputs["issueTemplate"], "Submit a request")
+			}),
+	)
+
+	output, err := runCommandWithRootDirOverridden(http, false, `-t hello --template "Submit a request"`, "", nil)
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+	assert.Equal(t, "", output.BrowsedURL)
+}
+
 func TestIssueCreate_continueInBrowser(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/MakeNowJust/heredoc"
@@ -31,6 +32,11 @@ type EditOptions struct {
 	SelectorArgs []string
 	Interactive  bool
 
+	AddSubIssues    []int
+	RemoveSubIssues []int
+
+	AuditComment bool
+
 	prShared.Editable
 }
 
@@ -41,9 +47,17 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		DetermineEditor:    func() (string, error) { return cmdutil.DetermineEditor(f.Config) },
 		FieldsToEditSurvey: prShared.FieldsToEditSurvey,
 		EditFieldsSurvey:   prShared.EditFieldsSurvey,
-		FetchOptions:       prShared.FetchOptions,
 		Prompter:           f.Prompter,
 	}
+	// Non-interactive edits already know exactly which names they need
+	// resolved, so they can use the cheaper, targeted lookup; interactive
+	// edits still need the full option lists for prompting.
+	opts.FetchOptions = func(client *api.Client, repo ghrepo.Interface, editable *prShared.Editable) error {
+		if opts.Interactive {
+			return prShared.FetchOptions(client, repo, editable)
+		}
+		return prShared.ResolveEditableMetadataIDs(client, repo, editable)
+	}
 
 	var bodyFile string
 
@@ -64,6 +78,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 			$ gh issue edit 23 --milestone "Version 1"
 			$ gh issue edit 23 --body-file body.txt
 			$ gh issue edit 23 34 --add-label "help wanted"
+			$ gh issue edit 23 --add-label "bug" --audit-comment
 		`),
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -111,7 +126,12 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 				opts.Editable.Milestone.Edited = true
 			}
 
-			if !opts.Editable.Dirty() {
+			hasSubIssueEdits := len(opts.AddSubIssues) > 0 || len(opts.RemoveSubIssues) > 0
+			if hasSubIssueEdits && len(opts.SelectorArgs) > 1 {
+				return cmdutil.FlagErrorf("only one issue can be specified when using `--add-sub-issue` or `--remove-sub-issue`")
+			}
+
+			if !opts.Editable.Dirty() && !hasSubIssueEdits {
 				opts.Interactive = true
 			}
 
@@ -141,10 +161,54 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	cmd.Flags().StringSliceVar(&opts.Editable.Projects.Add, "add-project", nil, "Add the issue to projects by `name`")
 	cmd.Flags().StringSliceVar(&opts.Editable.Projects.Remove, "remove-project", nil, "Remove the issue from projects by `name`")
 	cmd.Flags().StringVarP(&opts.Editable.Milestone.Value, "milestone", "m", "", "Edit the milestone the issue belongs to by `name`")
+	cmd.Flags().IntSliceVar(&opts.AddSubIssues, "add-sub-issue", nil, "Add sub-issues by issue `number`")
+	cmd.Flags().IntSliceVar(&opts.RemoveSubIssues, "remove-sub-issue", nil, "Remove sub-issues by issue `number`")
+	cmd.Flags().BoolVar(&opts.AuditComment, "audit-comment", false, "Post a comment summarizing the changes made, for compliance auditing")
 
 	return cmd
 }
 
+// auditCommentBody summarizes the changes recorded in editable as a
+// semicolon-separated list of deltas, e.g. "Added label: bug; Removed
+// assignee: foo", for posting as a compliance audit comment.
+func auditCommentBody(editable prShared.Editable) string {
+	var changes []string
+
+	if editable.Title.Edited {
+		changes = append(changes, fmt.Sprintf("Changed title to %q", editable.Title.Value))
+	}
+	if editable.Body.Edited {
+		changes = append(changes, "Updated body")
+	}
+	for _, label := range editable.Labels.Add {
+		changes = append(changes, fmt.Sprintf("Added label: %s", label))
+	}
+	for _, label := range editable.Labels.Remove {
+		changes = append(changes, fmt.Sprintf("Removed label: %s", label))
+	}
+	for _, assignee := range editable.Assignees.Add {
+		changes = append(changes, fmt.Sprintf("Added assignee: %s", assignee))
+	}
+	for _, assignee := range editable.Assignees.Remove {
+		changes = append(changes, fmt.Sprintf("Removed assignee: %s", assignee))
+	}
+	for _, project := range editable.Projects.Add {
+		changes = append(changes, fmt.Sprintf("Added to project: %s", project))
+	}
+	for _, project := range editable.Projects.Remove {
+		changes = append(changes, fmt.Sprintf("Removed from project: %s", project))
+	}
+	if editable.Milestone.Edited {
+		if editable.Milestone.Value == "" {
+			changes = append(changes, "Cleared milestone")
+		} else {
+			changes = append(changes, fmt.Sprintf("Set milestone: %s", editable.Milestone.Value))
+		}
+	}
+
+	return strings.Join(changes, "; ")
+}
+
 func editRun(opts *EditOptions) error {
 	httpClient, err := opts.HttpClient()
 	if err != nil {
@@ -190,6 +254,23 @@ func editRun(opts *EditOptions) error {
 		return err
 	}
 
+	if len(opts.AddSubIssues) > 0 || len(opts.RemoveSubIssues) > 0 {
+		issue := issues[0]
+		for _, subIssueNumber := range opts.AddSubIssues {
+			if subIssueNumber == issue.Number {
+				return fmt.Errorf("issue #%d cannot be a sub-issue of itself", subIssueNumber)
+			}
+			if err := api.AddSubIssue(apiClient, repo, issue.Number, subIssueNumber); err != nil {
+				return fmt.Errorf("failed to add #%d as a sub-issue of #%d: %w", subIssueNumber, issue.Number, err)
+			}
+		}
+		for _, subIssueNumber := range opts.RemoveSubIssues {
+			if err := api.RemoveSubIssue(apiClient, repo, issue.Number, subIssueNumber); err != nil {
+				return fmt.Errorf("failed to remove #%d as a sub-issue of #%d: %w", subIssueNumber, issue.Number, err)
+			}
+		}
+	}
+
 	// Update all issues in parallel.
 	editedIssueChan := make(chan string, len(issues))
 	failedIssueChan := make(chan string, len(issues))
@@ -240,6 +321,15 @@ func editRun(opts *EditOptions) error {
 				return
 			}
 
+			if opts.AuditComment {
+				if body := auditCommentBody(editable); body != "" {
+					if _, err := api.CommentCreate(apiClient, repo.RepoHost(), api.CommentCreateInput{Body: body, SubjectId: issue.ID}); err != nil {
+						failedIssueChan <- fmt.Sprintf("failed to post audit comment on %s: %s", issue.URL, err)
+						return
+					}
+				}
+			}
+
 			editedIssueChan <- issue.URL
 		}(issue)
 	}
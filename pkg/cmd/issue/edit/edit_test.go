@@ -234,6 +234,30 @@ func TestNewCmdEdit(t *testing.T) {
 			},
 			wantsErr: true,
 		},
+		{
+			name:  "add-sub-issue flag",
+			input: "23 --add-sub-issue 24,25",
+			output: EditOptions{
+				SelectorArgs: []string{"23"},
+				AddSubIssues: []int{24, 25},
+			},
+			wantsErr: false,
+		},
+		{
+			name:  "remove-sub-issue flag",
+			input: "23 --remove-sub-issue 24",
+			output: EditOptions{
+				SelectorArgs:    []string{"23"},
+				RemoveSubIssues: []int{24},
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "add-sub-issue flag with multiple issues",
+			input:    "23 34 --add-sub-issue 24",
+			output:   EditOptions{},
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -275,6 +299,8 @@ func TestNewCmdEdit(t *testing.T) {
 			assert.Equal(t, tt.output.SelectorArgs, gotOpts.SelectorArgs)
 			assert.Equal(t, tt.output.Interactive, gotOpts.Interactive)
 			assert.Equal(t, tt.output.Editable, gotOpts.Editable)
+			assert.Equal(t, tt.output.AddSubIssues, gotOpts.AddSubIssues)
+			assert.Equal(t, tt.output.RemoveSubIssues, gotOpts.RemoveSubIssues)
 		})
 	}
 }
@@ -506,6 +532,112 @@ func Test_editRun(t *testing.T) {
 			stderr:  `failed to update https://github.com/OWNER/REPO/issue/456:.*test error`,
 			wantErr: true,
 		},
+		{
+			name: "add and remove sub-issues",
+			input: &EditOptions{
+				SelectorArgs:    []string{"123"},
+				Interactive:     false,
+				AddSubIssues:    []int{456},
+				RemoveSubIssues: []int{789},
+				FetchOptions:    prShared.FetchOptions,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockIssueGet(t, reg)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/issues/456"),
+					httpmock.StringResponse(`{"id": 9456}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/issues/123/sub_issues"),
+					httpmock.RESTPayload(201, "{}", func(payload map[string]interface{}) {
+						assert.Equal(t, float64(9456), payload["sub_issue_id"])
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/issues/789"),
+					httpmock.StringResponse(`{"id": 9789}`))
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/issues/123/sub_issue"),
+					httpmock.RESTPayload(204, "", func(payload map[string]interface{}) {
+						assert.Equal(t, float64(9789), payload["sub_issue_id"])
+					}))
+			},
+			stdout: "https://github.com/OWNER/REPO/issue/123\n",
+		},
+		{
+			name: "add sub-issue that is itself",
+			input: &EditOptions{
+				SelectorArgs: []string{"123"},
+				Interactive:  false,
+				AddSubIssues: []int{123},
+				FetchOptions: prShared.FetchOptions,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockIssueGet(t, reg)
+			},
+			wantErr: true,
+		},
+		{
+			name: "audit comment",
+			input: &EditOptions{
+				SelectorArgs: []string{"123"},
+				Interactive:  false,
+				AuditComment: true,
+				Editable: prShared.Editable{
+					Labels: prShared.EditableSlice{
+						Add:    []string{"bug"},
+						Remove: []string{"docs"},
+						Edited: true,
+					},
+					Assignees: prShared.EditableSlice{
+						Remove: []string{"octocat"},
+						Edited: true,
+					},
+					Metadata: api.RepoMetadataResult{
+						Labels: []api.RepoLabel{
+							{Name: "docs", ID: "DOCSID"},
+						},
+					},
+				},
+				FetchOptions: prShared.FetchOptions,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockIssueGet(t, reg)
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryAssignableUsers\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "assignableUsers": {
+						"nodes": [
+							{ "login": "hubot", "id": "HUBOTID" },
+							{ "login": "MonaLisa", "id": "MONAID" }
+						],
+						"pageInfo": { "hasNextPage": false }
+					} } } }
+					`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryLabelList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "labels": {
+						"nodes": [
+							{ "name": "bug", "id": "BUGID" },
+							{ "name": "docs", "id": "DOCSID" }
+						],
+						"pageInfo": { "hasNextPage": false }
+					} } } }
+					`))
+				mockIssueUpdate(t, reg)
+				mockIssueUpdateLabels(t, reg)
+				reg.Register(
+					httpmock.GraphQL(`mutation CommentCreate\b`),
+					httpmock.GraphQLMutation(`
+						{ "data": { "addComment": { "commentEdge": { "node": {
+							"url": "https://github.com/OWNER/REPO/issue/123#issuecomment-1"
+						} } } } }`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "Added label: bug; Removed label: docs; Removed assignee: octocat", inputs["body"])
+						}),
+				)
+			},
+			stdout: "https://github.com/OWNER/REPO/issue/123\n",
+		},
 		{
 			name: "interactive",
 			input: &EditOptions{
@@ -39,6 +39,7 @@ type ListOptions struct {
 	Search       string
 	WebMode      bool
 	Exporter     cmdutil.Exporter
+	ExitCode     bool
 
 	Detector fd.Detector
 	Now      func() time.Time
@@ -107,6 +108,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone number or title")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
 
 	return cmd
 }
@@ -118,6 +120,7 @@ var defaultFields = []string{
 	"state",
 	"updatedAt",
 	"labels",
+	"isPinned",
 }
 
 func listRun(opts *ListOptions) error {
@@ -184,8 +187,18 @@ func listRun(opts *ListOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(listResult.Issues) == 0 && opts.Exporter == nil {
-		return prShared.ListNoResults(ghrepo.FullName(baseRepo), "issue", !filterOptions.IsDefault())
+	if len(listResult.Issues) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			return prShared.ListNoResults(ghrepo.FullName(baseRepo), "issue", !filterOptions.IsDefault())
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(opts.IO, listResult.Issues); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
+		}
 	}
 
 	if err := opts.IO.StartPager(); err == nil {
@@ -114,6 +114,47 @@ func TestIssueList_tty(t *testing.T) {
 	assert.Equal(t, ``, output.Stderr())
 }
 
+func TestIssueList_tty_pinned(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`{
+			"data": {
+				"repository": {
+					"hasIssuesEnabled": true,
+					"issues": {
+						"totalCount": 1,
+						"nodes": [
+							{
+								"number": 1,
+								"title": "number won",
+								"url": "https://wow.com",
+								"updatedAt": "2022-08-24T22:01:12Z",
+								"isPinned": true
+							}
+						]
+					}
+				}
+			}
+		}`))
+
+	output, err := runCommand(http, true, "")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+
+		Showing 1 of 1 open issue in OWNER/REPO
+
+		ID  TITLE                LABELS  UPDATED
+		#1  number won (Pinned)          about 1 day ago
+	`), output.String())
+	assert.Equal(t, ``, output.Stderr())
+}
+
 func TestIssueList_tty_withFlags(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -159,6 +200,39 @@ func TestIssueList_tty_withAppFlag(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func TestIssueList_exitCode(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issues": {
+				"totalCount": 0, "nodes": [], "pageInfo": { "hasNextPage": false }
+			} } } }`),
+	)
+
+	_, err := runCommand(http, true, "--exit-code")
+	assert.Equal(t, cmdutil.SilentError, err)
+}
+
+func TestIssueList_exitCode_withJSON(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issues": {
+				"totalCount": 0, "nodes": [], "pageInfo": { "hasNextPage": false }
+			} } } }`),
+	)
+
+	output, err := runCommand(http, true, "--exit-code --json number")
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "[]\n", output.String())
+}
+
 func TestIssueList_withInvalidLimitFlag(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -532,6 +606,50 @@ func TestIssueList_withProjectItems(t *testing.T) {
 	require.Equal(t, issuesAndTotalCount.Issues[0].ProjectItems.Nodes[0].Status, expectedStatus)
 }
 
+func TestIssueList_withIsPinned(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.GraphQLQuery(`{
+			"data": {
+			  "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+				  "totalCount": 2,
+				  "nodes": [
+					{ "number": 1, "title": "pinned issue", "isPinned": true },
+					{ "number": 2, "title": "regular issue", "isPinned": false }
+				  ]
+				}
+			  }
+			}
+		  }`, func(_ string, params map[string]interface{}) {
+			require.Equal(t, map[string]interface{}{
+				"owner":  "OWNER",
+				"repo":   "REPO",
+				"limit":  float64(30),
+				"states": []interface{}{"OPEN"},
+			}, params)
+		}))
+
+	client := &http.Client{Transport: reg}
+	issuesAndTotalCount, err := issueList(
+		client,
+		ghrepo.New("OWNER", "REPO"),
+		prShared.FilterOptions{
+			Entity: "issue",
+		},
+		30,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, issuesAndTotalCount.Issues, 2)
+	require.True(t, issuesAndTotalCount.Issues[0].IsPinned)
+	require.False(t, issuesAndTotalCount.Issues[1].IsPinned)
+}
+
 func TestIssueList_Search_withProjectItems(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
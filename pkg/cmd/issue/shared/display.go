@@ -36,7 +36,11 @@ func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCou
 		if !isTTY {
 			table.AddField(issue.State)
 		}
-		table.AddField(text.RemoveExcessiveWhitespace(issue.Title))
+		title := text.RemoveExcessiveWhitespace(issue.Title)
+		if issue.IsPinned {
+			title = fmt.Sprintf("%s %s", title, cs.Gray("(Pinned)"))
+		}
+		table.AddField(title)
 		table.AddField(issueLabelList(&issue, cs, isTTY))
 		table.AddTimeField(now, issue.UpdatedAt, cs.Gray)
 		table.EndRow()
@@ -12,6 +12,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/text"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
@@ -28,11 +29,13 @@ type ViewOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser.Browser
+	Config     func() (config.Config, error)
 
 	SelectorArg string
 	WebMode     bool
 	Comments    bool
 	Exporter    cmdutil.Exporter
+	Width       int
 
 	Now func() time.Time
 }
@@ -42,6 +45,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		Browser:    f.Browser,
+		Config:     f.Config,
 		Now:        time.Now,
 	}
 
@@ -71,6 +75,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open an issue in the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View issue comments")
+	cmd.Flags().IntVar(&opts.Width, "width", 0, "Set the width for markdown rendering, defaulting to terminal width")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -226,13 +231,20 @@ func printHumanIssuePreview(opts *ViewOptions, baseRepo ghrepo.Interface, issue
 
 	// Body
 	var md string
-	var err error
 	if issue.Body == "" {
 		md = fmt.Sprintf("\n  %s\n\n", cs.Gray("No description provided"))
 	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		width := opts.Width
+		if width == 0 {
+			width = opts.IO.TerminalWidth()
+		}
 		md, err = markdown.Render(issue.Body,
-			markdown.WithTheme(opts.IO.TerminalTheme()),
-			markdown.WithWrap(opts.IO.TerminalWidth()))
+			markdown.StyleFromConfig(cfg.GlamourStyle(""), opts.IO.TerminalTheme(), opts.IO.ErrOut),
+			markdown.WithWrap(width))
 		if err != nil {
 			return err
 		}
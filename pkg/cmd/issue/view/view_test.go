@@ -246,6 +246,9 @@ func TestIssueView_tty_Preview(t *testing.T) {
 				BaseRepo: func() (ghrepo.Interface, error) {
 					return ghrepo.New("OWNER", "REPO"), nil
 				},
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
 				SelectorArg: "123",
 			}
 
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -37,6 +39,45 @@ var randomColors = []string{
 	"D4C5F9",
 }
 
+// namedPalettes are built-in color sets that --palette can assign colors
+// from. Colors are picked deterministically by hashing the label name, so
+// labels with related names (e.g. "type: bug", "type: feature") don't need
+// their colors chosen by hand to look coherent.
+var namedPalettes = map[string][]string{
+	"severity": {
+		"B60205", // critical
+		"D93F0B", // high
+		"FBCA04", // medium
+		"0E8A16", // low
+	},
+	"type": {
+		"1D76DB",
+		"5319E7",
+		"0052CC",
+		"006B75",
+		"C2E0C6",
+	},
+}
+
+func paletteNames() []string {
+	names := make([]string, 0, len(namedPalettes))
+	for name := range namedPalettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func colorFromPalette(palette, name string) (string, error) {
+	colors, ok := namedPalettes[palette]
+	if !ok {
+		return "", fmt.Errorf("unknown palette %q; available palettes: %s", palette, strings.Join(paletteNames(), ", "))
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return colors[h.Sum32()%uint32(len(colors))], nil
+}
+
 type createOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	HttpClient func() (*http.Client, error)
@@ -45,6 +86,7 @@ type createOptions struct {
 	Color       string
 	Description string
 	Name        string
+	Palette     string
 	Force       bool
 }
 
@@ -68,12 +110,28 @@ func newCmdCreate(f *cmdutil.Factory, runF func(*createOptions) error) *cobra.Co
 		Example: heredoc.Doc(`
 			# create new bug label
 			$ gh label create bug --description "Something isn't working" --color E99695
+
+			# create new label with a color from the "type" palette, picked deterministically by name
+			$ gh label create "type: bug" --palette type
 		`),
 		Args: cmdutil.ExactArgs(1, "cannot create label: name argument required"),
 		RunE: func(c *cobra.Command, args []string) error {
 			opts.BaseRepo = f.BaseRepo
 			opts.Name = args[0]
 			opts.Color = strings.TrimPrefix(opts.Color, "#")
+
+			if err := cmdutil.MutuallyExclusive("specify only one of `--color` or `--palette`", opts.Color != "", opts.Palette != ""); err != nil {
+				return err
+			}
+
+			if opts.Palette != "" {
+				color, err := colorFromPalette(opts.Palette, opts.Name)
+				if err != nil {
+					return err
+				}
+				opts.Color = color
+			}
+
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -83,6 +141,7 @@ func newCmdCreate(f *cmdutil.Factory, runF func(*createOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the label")
 	cmd.Flags().StringVarP(&opts.Color, "color", "c", "", "Color of the label")
+	cmd.Flags().StringVar(&opts.Palette, "palette", "", fmt.Sprintf("Assign a color from a built-in named palette (%s)", strings.Join(paletteNames(), ", ")))
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Update the label color and description if label already exists")
 
 	return cmd
@@ -49,6 +49,23 @@ func TestNewCmdCreate(t *testing.T) {
 			input:  "test --color '#AAAAAA'",
 			output: createOptions{Name: "test", Color: "AAAAAA"},
 		},
+		{
+			name:   "palette flag",
+			input:  "test --palette type",
+			output: createOptions{Name: "test", Color: "1D76DB"},
+		},
+		{
+			name:    "palette and color flags are mutually exclusive",
+			input:   "test --color FFFFFF --palette type",
+			wantErr: true,
+			errMsg:  "specify only one of `--color` or `--palette`",
+		},
+		{
+			name:    "unknown palette",
+			input:   "test --palette nonexistent",
+			wantErr: true,
+			errMsg:  `unknown palette "nonexistent"; available palettes: severity, type`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -83,6 +100,25 @@ func TestNewCmdCreate(t *testing.T) {
 	}
 }
 
+func TestColorFromPalette(t *testing.T) {
+	color, err := colorFromPalette("type", "bug")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, color)
+
+	// same name and palette always resolve to the same color
+	again, err := colorFromPalette("type", "bug")
+	assert.NoError(t, err)
+	assert.Equal(t, color, again)
+
+	// different names within the same palette can resolve to different colors
+	other, err := colorFromPalette("type", "feature")
+	assert.NoError(t, err)
+	assert.NotEqual(t, color, other)
+
+	_, err = colorFromPalette("nonexistent", "bug")
+	assert.EqualError(t, err, `unknown palette "nonexistent"; available palettes: severity, type`)
+}
+
 func TestCreateRun(t *testing.T) {
 	tests := []struct {
 		name       string
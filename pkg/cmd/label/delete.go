@@ -22,6 +22,7 @@ type deleteOptions struct {
 	Prompter   iprompter
 
 	Name      string
+	DeleteAll bool
 	Confirmed bool
 }
 
@@ -33,13 +34,26 @@ func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Co
 	}
 
 	cmd := &cobra.Command{
-		Use:   "delete <name>",
+		Use:   "delete {<name> | --all}",
 		Short: "Delete a label from a repository",
-		Args:  cmdutil.ExactArgs(1, "cannot delete label: name argument required"),
+		Args: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 && !opts.DeleteAll {
+				return cmdutil.FlagErrorf("cannot delete label: name argument required")
+			}
+			if len(args) > 0 && opts.DeleteAll {
+				return cmdutil.FlagErrorf("cannot use `--all` with label name")
+			}
+			if len(args) > 1 {
+				return cmdutil.FlagErrorf("too many arguments")
+			}
+			return nil
+		},
 		RunE: func(c *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
-			opts.Name = args[0]
+			if len(args) > 0 {
+				opts.Name = args[0]
+			}
 
 			if !opts.IO.CanPrompt() && !opts.Confirmed {
 				return cmdutil.FlagErrorf("--yes required when not running interactively")
@@ -55,6 +69,7 @@ func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Co
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "Confirm deletion without prompting")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
 	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Confirm deletion without prompting")
+	cmd.Flags().BoolVar(&opts.DeleteAll, "all", false, "Delete all labels in the repository")
 
 	return cmd
 }
@@ -70,6 +85,10 @@ func deleteRun(opts *deleteOptions) error {
 		return err
 	}
 
+	if opts.DeleteAll {
+		return deleteAllRun(opts, httpClient, baseRepo)
+	}
+
 	if !opts.Confirmed {
 		if err := opts.Prompter.ConfirmDeletion(opts.Name); err != nil {
 			return err
@@ -92,6 +111,43 @@ func deleteRun(opts *deleteOptions) error {
 	return nil
 }
 
+func deleteAllRun(opts *deleteOptions, httpClient *http.Client, baseRepo ghrepo.Interface) error {
+	if !opts.Confirmed {
+		if err := opts.Prompter.ConfirmDeletion(ghrepo.FullName(baseRepo)); err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	labels, _, err := listLabels(httpClient, baseRepo, listQueryOptions{Limit: -1})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	var deleteErr error
+	for _, l := range labels {
+		opts.IO.StartProgressIndicator()
+		err := deleteLabel(httpClient, baseRepo, l.Name)
+		opts.IO.StopProgressIndicator()
+
+		if err != nil {
+			deleteErr = err
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.Out, "%s Failed to delete label %q from %s: %s\n", cs.FailureIcon(), l.Name, ghrepo.FullName(baseRepo), err)
+			}
+			continue
+		}
+
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Label %q deleted from %s\n", cs.SuccessIcon(), l.Name, ghrepo.FullName(baseRepo))
+		}
+	}
+
+	return deleteErr
+}
+
 func deleteLabel(client *http.Client, repo ghrepo.Interface, name string) error {
 	apiClient := api.NewClientFromHTTP(client)
 	path := fmt.Sprintf("repos/%s/%s/labels/%s", repo.RepoOwner(), repo.RepoName(), name)
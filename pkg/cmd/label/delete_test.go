@@ -46,6 +46,18 @@ func TestNewCmdDelete(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "--yes required when not running interactively",
 		},
+		{
+			name:   "all flag",
+			tty:    true,
+			input:  "--all",
+			output: deleteOptions{DeleteAll: true},
+		},
+		{
+			name:       "name argument and all flag",
+			input:      "test --all",
+			wantErr:    true,
+			wantErrMsg: "cannot use `--all` with label name",
+		},
 	}
 
 	for _, tt := range tests {
@@ -141,6 +153,62 @@ func TestDeleteRun(t *testing.T) {
 			wantErr: true,
 			errMsg:  "HTTP 422: Not Found (https://api.github.com/repos/OWNER/REPO/labels/missing)",
 		},
+		{
+			name: "deletes all labels",
+			tty:  true,
+			opts: &deleteOptions{DeleteAll: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query LabelList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "labels": {
+						"totalCount": 2,
+						"nodes": [
+							{ "name": "bug", "color": "ff0000", "description": "" },
+							{ "name": "docs", "color": "00ff00", "description": "" }
+						],
+						"pageInfo": { "hasNextPage": false, "endCursor": "" }
+					} } } }`),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/labels/bug"),
+					httpmock.StatusStringResponse(204, "{}"),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/labels/docs"),
+					httpmock.StatusStringResponse(204, "{}"),
+				)
+			},
+			prompterStubs: func(pm *prompter.PrompterMock) {
+				pm.ConfirmDeletionFunc = func(_ string) error {
+					return nil
+				}
+			},
+			wantStdout: "✓ Label \"bug\" deleted from OWNER/REPO\n✓ Label \"docs\" deleted from OWNER/REPO\n",
+		},
+		{
+			name: "deletes all labels notty",
+			tty:  false,
+			opts: &deleteOptions{DeleteAll: true, Confirmed: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query LabelList\b`),
+					httpmock.StringResponse(`
+					{ "data": { "repository": { "labels": {
+						"totalCount": 1,
+						"nodes": [
+							{ "name": "bug", "color": "ff0000", "description": "" }
+						],
+						"pageInfo": { "hasNextPage": false, "endCursor": "" }
+					} } } }`),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/labels/bug"),
+					httpmock.StatusStringResponse(204, "{}"),
+				)
+			},
+			wantStdout: "",
+		},
 	}
 
 	for _, tt := range tests {
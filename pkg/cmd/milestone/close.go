@@ -0,0 +1,80 @@
+package milestone
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type closeOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Selector string
+}
+
+func newCmdClose(f *cmdutil.Factory, runF func(*closeOptions) error) *cobra.Command {
+	opts := closeOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "close {<number> | <title>}",
+		Short: "Close a milestone",
+		Args:  cmdutil.ExactArgs(1, "cannot close milestone: number or title argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return closeRun(&opts)
+		},
+	}
+
+	return cmd
+}
+
+func closeRun(opts *closeOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	milestone, err := milestoneFromArg(httpClient, baseRepo, opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	if milestone.State == "closed" {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Milestone %q is already closed\n", cs.Yellow("!"), milestone.Title)
+		}
+		return nil
+	}
+
+	opts.IO.StartProgressIndicator()
+	_, err = updateMilestone(httpClient, baseRepo, milestone.Number, map[string]string{"state": "closed"})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Milestone %q closed in %s\n", cs.SuccessIcon(), milestone.Title, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package milestone
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type createOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Title       string
+	Description string
+	DueDate     string
+}
+
+func newCmdCreate(f *cmdutil.Factory, runF func(*createOptions) error) *cobra.Command {
+	opts := createOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <title>",
+		Short: "Create a new milestone",
+		Args:  cmdutil.ExactArgs(1, "cannot create milestone: title argument required"),
+		Example: heredoc.Doc(`
+			# create a milestone due at the end of the year
+			$ gh milestone create "v2.0" --due-date 2024-12-31
+		`),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Title = args[0]
+			if runF != nil {
+				return runF(&opts)
+			}
+			return createRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the milestone")
+	cmd.Flags().StringVar(&opts.DueDate, "due-date", "", "Due date for the milestone in `YYYY-MM-DD` format")
+
+	return cmd
+}
+
+func createRun(opts *createOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var dueOn string
+	if opts.DueDate != "" {
+		d, err := time.Parse("2006-01-02", opts.DueDate)
+		if err != nil {
+			return cmdutil.FlagErrorf("invalid --due-date: %q is not in YYYY-MM-DD format", opts.DueDate)
+		}
+		dueOn = d.UTC().Format(time.RFC3339)
+	}
+
+	opts.IO.StartProgressIndicator()
+	milestone, err := createMilestone(httpClient, baseRepo, opts.Title, opts.Description, dueOn)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Milestone %q created in %s\n", cs.SuccessIcon(), milestone.Title, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
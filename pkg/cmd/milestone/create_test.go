@@ -0,0 +1,116 @@
+package milestone
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  createOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "title only",
+			input:  "v2.0",
+			output: createOptions{Title: "v2.0"},
+		},
+		{
+			name:   "with description and due date",
+			input:  `v2.0 -d "next release" --due-date 2024-12-31`,
+			output: createOptions{Title: "v2.0", Description: "next release", DueDate: "2024-12-31"},
+		},
+		{
+			name:    "no arguments",
+			input:   "",
+			wantErr: true,
+			errMsg:  "cannot create milestone: title argument required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *createOptions
+			cmd := newCmdCreate(f, func(opts *createOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Title, gotOpts.Title)
+			assert.Equal(t, tt.output.Description, gotOpts.Description)
+			assert.Equal(t, tt.output.DueDate, gotOpts.DueDate)
+		})
+	}
+}
+
+func TestCreateRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`{"number": 3, "title": "v2.0", "state": "open"}`),
+	)
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &createOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Title: "v2.0",
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Milestone \"v2.0\" created in OWNER/REPO\n", stdout.String())
+}
+
+func TestCreateRun_invalidDueDate(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	opts := &createOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return nil, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Title:   "v2.0",
+		DueDate: "not-a-date",
+	}
+
+	err := createRun(opts)
+	assert.EqualError(t, err, `invalid --due-date: "not-a-date" is not in YYYY-MM-DD format`)
+}
@@ -0,0 +1,93 @@
+package milestone
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type iprompter interface {
+	ConfirmDeletion(string) error
+}
+
+type deleteOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Prompter   iprompter
+
+	Selector  string
+	Confirmed bool
+}
+
+func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Command {
+	opts := deleteOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete {<number> | <title>}",
+		Short: "Delete a milestone from a repository",
+		Args:  cmdutil.ExactArgs(1, "cannot delete milestone: number or title argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return cmdutil.FlagErrorf("--yes required when not running interactively")
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return deleteRun(&opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Confirm deletion without prompting")
+
+	return cmd
+}
+
+func deleteRun(opts *deleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	milestone, err := milestoneFromArg(httpClient, baseRepo, opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Confirmed {
+		if err := opts.Prompter.ConfirmDeletion(milestone.Title); err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = deleteMilestone(httpClient, baseRepo, milestone.Number)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Milestone %q deleted from %s\n", cs.SuccessIcon(), milestone.Title, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package milestone
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+type milestonePrompterStub struct {
+	confirmed bool
+}
+
+func (p *milestonePrompterStub) ConfirmDeletion(requiredValue string) error {
+	p.confirmed = true
+	return nil
+}
+
+func TestDeleteRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`[{"number": 1, "title": "v1.0", "state": "open"}]`),
+	)
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/milestones/1"),
+		httpmock.StringResponse(`{}`),
+	)
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	prompter := &milestonePrompterStub{}
+
+	opts := &deleteOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Prompter: prompter,
+		Selector: "v1.0",
+	}
+
+	err := deleteRun(opts)
+	assert.NoError(t, err)
+	assert.True(t, prompter.confirmed)
+	assert.Equal(t, "✓ Milestone \"v1.0\" deleted from OWNER/REPO\n", stdout.String())
+}
+
+func TestDeleteRun_confirmed(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`[{"number": 1, "title": "v1.0", "state": "open"}]`),
+	)
+	reg.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/milestones/1"),
+		httpmock.StringResponse(`{}`),
+	)
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &deleteOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Selector:  "v1.0",
+		Confirmed: true,
+	}
+
+	err := deleteRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Milestone \"v1.0\" deleted from OWNER/REPO\n", stdout.String())
+}
@@ -0,0 +1,104 @@
+package milestone
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type editOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Selector    string
+	Title       string
+	Description string
+	DueDate     string
+}
+
+func newCmdEdit(f *cmdutil.Factory, runF func(*editOptions) error) *cobra.Command {
+	opts := editOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit {<number> | <title>}",
+		Short: "Edit a milestone",
+		Args:  cmdutil.ExactArgs(1, "cannot edit milestone: number or title argument required"),
+		Example: heredoc.Doc(`
+			# rename and push back the due date of a milestone
+			$ gh milestone edit "v1.0" --title "v1.0.1" --due-date 2024-06-30
+		`),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Selector = args[0]
+			if opts.Title == "" && opts.Description == "" && opts.DueDate == "" {
+				return cmdutil.FlagErrorf("specify at least one of `--title`, `--description`, or `--due-date`")
+			}
+			if runF != nil {
+				return runF(&opts)
+			}
+			return editRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Rename the milestone")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the milestone")
+	cmd.Flags().StringVar(&opts.DueDate, "due-date", "", "Due date for the milestone in `YYYY-MM-DD` format")
+
+	return cmd
+}
+
+func editRun(opts *editOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	milestone, err := milestoneFromArg(httpClient, baseRepo, opts.Selector)
+	if err != nil {
+		return err
+	}
+
+	properties := map[string]string{}
+	if opts.Title != "" {
+		properties["title"] = opts.Title
+	}
+	if opts.Description != "" {
+		properties["description"] = opts.Description
+	}
+	if opts.DueDate != "" {
+		d, err := time.Parse("2006-01-02", opts.DueDate)
+		if err != nil {
+			return cmdutil.FlagErrorf("invalid --due-date: %q is not in YYYY-MM-DD format", opts.DueDate)
+		}
+		properties["due_on"] = d.UTC().Format(time.RFC3339)
+	}
+
+	opts.IO.StartProgressIndicator()
+	updated, err := updateMilestone(httpClient, baseRepo, milestone.Number, properties)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Milestone %q updated in %s\n", cs.SuccessIcon(), updated.Title, ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package milestone
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`[{"number": 1, "title": "v1.0", "state": "open"}]`),
+	)
+	reg.Register(
+		httpmock.REST("PATCH", "repos/OWNER/REPO/milestones/1"),
+		httpmock.StringResponse(`{"number": 1, "title": "v1.0.1", "state": "open"}`),
+	)
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &editOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Selector: "v1.0",
+		Title:    "v1.0.1",
+	}
+
+	err := editRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Milestone \"v1.0.1\" updated in OWNER/REPO\n", stdout.String())
+}
+
+func TestNewCmdEdit_missingFlags(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{IOStreams: ios}
+	cmd := newCmdEdit(f, func(opts *editOptions) error { return nil })
+	cmd.SetArgs([]string{"v1.0"})
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "specify at least one of `--title`, `--description`, or `--due-date`")
+}
@@ -0,0 +1,166 @@
+package milestone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+const maxPageSize = 100
+
+// listMilestones lists the milestones in the given repo. Pass -1 for limit to list all
+// milestones matching state; otherwise, only that number of milestones is returned.
+func listMilestones(httpClient *http.Client, repo ghrepo.Interface, state string, limit int) ([]Milestone, error) {
+	perPage := maxPageSize
+	if limit > 0 && limit < perPage {
+		perPage = limit
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/milestones?state=%s&per_page=%d", repo.RepoOwner(), repo.RepoName(), state, perPage)
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+
+	var milestones []Milestone
+	for {
+		var page []Milestone
+		nextURL, err := apiGet(httpClient, url, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range page {
+			milestones = append(milestones, m)
+			if limit > 0 && len(milestones) == limit {
+				return milestones, nil
+			}
+		}
+
+		if nextURL == "" {
+			break
+		}
+		url = nextURL
+	}
+
+	return milestones, nil
+}
+
+// milestoneByTitle looks up a single milestone by its title, matching the way `--milestone`
+// resolves titles on `gh issue` and `gh pr`. It returns an error if no milestone has that title.
+func milestoneByTitle(httpClient *http.Client, repo ghrepo.Interface, state, title string) (*Milestone, error) {
+	milestones, err := listMilestones(httpClient, repo, state, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range milestones {
+		if m.Title == title {
+			result := m
+			return &result, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no milestone found with title %q", title)
+}
+
+// milestoneByNumber looks up a single milestone by its number.
+func milestoneByNumber(httpClient *http.Client, repo ghrepo.Interface, number int) (*Milestone, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones/%d", repo.RepoOwner(), repo.RepoName(), number)
+
+	var m Milestone
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// milestoneFromArg resolves a milestone by number or, failing that, by title, so that `gh
+// milestone` subcommands accept either the same way `--milestone` does on `gh issue`/`gh pr`.
+func milestoneFromArg(httpClient *http.Client, repo ghrepo.Interface, arg string) (*Milestone, error) {
+	if number, err := strconv.Atoi(arg); err == nil {
+		return milestoneByNumber(httpClient, repo, number)
+	}
+	return milestoneByTitle(httpClient, repo, "all", arg)
+}
+
+type createMilestoneRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	DueOn       string `json:"due_on,omitempty"`
+}
+
+func createMilestone(httpClient *http.Client, repo ghrepo.Interface, title, description, dueOn string) (*Milestone, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones", repo.RepoOwner(), repo.RepoName())
+
+	requestByte, err := json.Marshal(createMilestoneRequest{Title: title, Description: description, DueOn: dueOn})
+	if err != nil {
+		return nil, err
+	}
+
+	var m Milestone
+	err = apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(requestByte), &m)
+	return &m, err
+}
+
+func updateMilestone(httpClient *http.Client, repo ghrepo.Interface, number int, properties map[string]string) (*Milestone, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones/%d", repo.RepoOwner(), repo.RepoName(), number)
+
+	requestByte, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Milestone
+	err = apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(requestByte), &m)
+	return &m, err
+}
+
+func deleteMilestone(httpClient *http.Client, repo ghrepo.Interface, number int) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/%s/milestones/%d", repo.RepoOwner(), repo.RepoName(), number)
+
+	return apiClient.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
+
+func apiGet(httpClient *http.Client, url string, data interface{}) (string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	if err := dec.Decode(data); err != nil {
+		return "", err
+	}
+
+	return findNextPage(resp), nil
+}
+
+var linkRE = regexp.MustCompile(`<([^>]+)>;\s*rel="([^"]+)"`)
+
+func findNextPage(resp *http.Response) string {
+	for _, m := range linkRE.FindAllStringSubmatch(resp.Header.Get("Link"), -1) {
+		if len(m) > 2 && m[2] == "next" {
+			return m[1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,119 @@
+package milestone
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type listOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Exporter cmdutil.Exporter
+	State    string
+	Limit    int
+}
+
+func newCmdList(f *cmdutil.Factory, runF func(*listOptions) error) *cobra.Command {
+	opts := listOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List milestones in a repository",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: heredoc.Doc(`
+			# list open milestones
+			$ gh milestone list
+
+			# list closed milestones
+			$ gh milestone list --state closed
+		`),
+		RunE: func(c *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return listRun(&opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "", "open", []string{"open", "closed", "all"}, "Filter by milestone state")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of milestones to fetch")
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, milestoneFields)
+
+	return cmd
+}
+
+func listRun(opts *listOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	milestones, err := listMilestones(httpClient, baseRepo, opts.State, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(milestones) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no milestones found in %s", ghrepo.FullName(baseRepo)))
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, milestones)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		title := fmt.Sprintf("Showing %s in %s", text.Pluralize(len(milestones), "milestone"), ghrepo.FullName(baseRepo))
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
+	}
+
+	return printMilestones(opts.IO, milestones)
+}
+
+func printMilestones(io *iostreams.IOStreams, milestones []Milestone) error {
+	cs := io.ColorScheme()
+	table := tableprinter.New(io, tableprinter.WithHeader("TITLE", "STATE", "DUE DATE", "ISSUES"))
+
+	for _, m := range milestones {
+		table.AddField(m.Title, tableprinter.WithColor(cs.Bold))
+		table.AddField(m.State)
+		if m.DueOn != nil {
+			table.AddField(m.DueOn.Format("2006-01-02"))
+		} else {
+			table.AddField("")
+		}
+		table.AddField(fmt.Sprintf("%d open, %d closed", m.OpenIssues, m.ClosedIssues))
+
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,105 @@
+package milestone
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  listOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no arguments",
+			input:  "",
+			output: listOptions{State: "open", Limit: 30},
+		},
+		{
+			name:   "state flag",
+			input:  "--state closed",
+			output: listOptions{State: "closed", Limit: 30},
+		},
+		{
+			name:    "invalid state flag",
+			input:   "--state invalid",
+			wantErr: true,
+			errMsg:  `invalid argument "invalid" for "--state" flag: valid values are {open|closed|all}`,
+		},
+		{
+			name:    "invalid limit flag",
+			input:   "--limit 0",
+			wantErr: true,
+			errMsg:  "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *listOptions
+			cmd := newCmdList(f, func(opts *listOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.State, gotOpts.State)
+			assert.Equal(t, tt.output.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/milestones"),
+		httpmock.StringResponse(`[
+			{"number": 1, "title": "v1.0", "state": "open", "open_issues": 2, "closed_issues": 1}
+		]`),
+	)
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &listOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		State: "open",
+		Limit: 30,
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "\nShowing 1 milestone in OWNER/REPO\n\nTITLE  STATE  DUE DATE  ISSUES\nv1.0   open             2 open, 1 closed\n", stdout.String())
+}
@@ -0,0 +1,23 @@
+package milestone
+
+import (
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMilestone(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone <command>",
+		Short: "Manage milestones",
+		Long:  `Work with GitHub milestones.`,
+	}
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(newCmdList(f, nil))
+	cmd.AddCommand(newCmdCreate(f, nil))
+	cmd.AddCommand(newCmdEdit(f, nil))
+	cmd.AddCommand(newCmdClose(f, nil))
+	cmd.AddCommand(newCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package milestone
+
+import (
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+var milestoneFields = []string{
+	"closedIssues",
+	"createdAt",
+	"description",
+	"dueOn",
+	"number",
+	"openIssues",
+	"state",
+	"title",
+	"updatedAt",
+	"url",
+}
+
+type Milestone struct {
+	Number       int        `json:"number"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	DueOn        *time.Time `json:"due_on"`
+	OpenIssues   int        `json:"open_issues"`
+	ClosedIssues int        `json:"closed_issues"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	URL          string     `json:"html_url"`
+}
+
+func (m *Milestone) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(m, fields)
+}
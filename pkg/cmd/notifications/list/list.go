@@ -0,0 +1,155 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/notifications/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type hostConfig interface {
+	DefaultHost() (string, string)
+}
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	HostConfig hostConfig
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	Repo          string
+	Reason        string
+	Since         string
+	Participating bool
+	Limit         int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your notifications",
+		Example: heredoc.Doc(`
+			# List unread notifications across all repositories
+			$ gh notifications list
+
+			# List notifications you are participating in for a single repository
+			$ gh notifications list --repo cli/cli --participating
+
+			# List notifications you've been asked to review
+			$ gh notifications list --reason review_requested
+
+			# List notifications updated since a given time
+			$ gh notifications list --since 2023-01-01T00:00:00Z
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			opts.HostConfig = cfg.Authentication()
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "List notifications for a single repository in OWNER/REPO format")
+	cmd.Flags().StringVar(&opts.Reason, "reason", "", "Filter by notification reason, e.g. review_requested, mention, author")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Show notifications updated after this time, in ISO 8601 format")
+	cmd.Flags().BoolVar(&opts.Participating, "participating", false, "Only show notifications you're participating in or mentioned in")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of notifications to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.Fields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	hostname, _ := opts.HostConfig.DefaultHost()
+
+	repo := opts.Repo
+	if repo != "" {
+		r, err := ghrepo.FromFullNameWithHost(repo, hostname)
+		if err != nil {
+			return err
+		}
+		repo = ghrepo.FullName(r)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	notifications, err := shared.ListNotifications(client, hostname, repo, shared.ListOptions{
+		Participating: opts.Participating,
+		Since:         opts.Since,
+		Limit:         opts.Limit,
+	})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("%s Failed to get notifications: %w", opts.IO.ColorScheme().FailureIcon(), err)
+	}
+
+	if opts.Reason != "" {
+		filtered := notifications[:0]
+		for _, n := range notifications {
+			if n.Reason == opts.Reason {
+				filtered = append(filtered, n)
+			}
+		}
+		notifications = filtered
+	}
+
+	if len(notifications) == 0 {
+		return cmdutil.NewNoResultsError("no notifications found")
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, notifications)
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("", "REPOSITORY", "REASON", "TYPE", "TITLE"))
+	for _, n := range notifications {
+		unread := " "
+		if n.Unread {
+			unread = cs.Bold("*")
+		}
+		tp.AddField(unread)
+		tp.AddField(n.Repository.FullName)
+		tp.AddField(n.Reason)
+		tp.AddField(n.Subject.Type)
+		tp.AddField(n.Subject.Title)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
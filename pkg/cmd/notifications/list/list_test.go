@@ -0,0 +1,211 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHostConfig string
+
+func (c testHostConfig) DefaultHost() (string, string) {
+	return string(c), ""
+}
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name:  "no arguments",
+			input: "",
+			wants: ListOptions{
+				Limit: 30,
+			},
+		},
+		{
+			name:     "invalid limit",
+			input:    "-L 0",
+			wantsErr: "invalid limit: 0",
+		},
+		{
+			name:  "with repo and reason",
+			input: "--repo cli/cli --reason review_requested",
+			wants: ListOptions{
+				Limit:  30,
+				Repo:   "cli/cli",
+				Reason: "review_requested",
+			},
+		},
+		{
+			name:  "with participating and since",
+			input: "--participating --since 2023-01-01T00:00:00Z",
+			wants: ListOptions{
+				Limit:         30,
+				Participating: true,
+				Since:         "2023-01-01T00:00:00Z",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				Config: func() (config.Config, error) {
+					return config.NewFromString(""), nil
+				},
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Repo, gotOpts.Repo)
+			assert.Equal(t, tt.wants.Reason, gotOpts.Reason)
+			assert.Equal(t, tt.wants.Participating, gotOpts.Participating)
+			assert.Equal(t, tt.wants.Since, gotOpts.Since)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ListOptions
+		stubs      func(*httpmock.Registry)
+		tty        bool
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "displays results tty",
+			tty:  true,
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "notifications"),
+					httpmock.JSONResponse([]map[string]interface{}{
+						{
+							"id":     "1",
+							"unread": true,
+							"reason": "mention",
+							"subject": map[string]interface{}{
+								"title": "Fix the bug",
+								"type":  "Issue",
+							},
+							"repository": map[string]interface{}{
+								"full_name": "cli/cli",
+							},
+						},
+					}),
+				)
+			},
+			wantStdout: "   REPOSITORY  REASON   TYPE   TITLE\n*  cli/cli     mention  Issue  Fix the bug\n",
+		},
+		{
+			name: "filters by reason client-side",
+			opts: ListOptions{Reason: "review_requested"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "notifications"),
+					httpmock.JSONResponse([]map[string]interface{}{
+						{
+							"id":     "1",
+							"reason": "mention",
+							"subject": map[string]interface{}{
+								"title": "Fix the bug",
+								"type":  "Issue",
+							},
+							"repository": map[string]interface{}{
+								"full_name": "cli/cli",
+							},
+						},
+					}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "no notifications found",
+		},
+		{
+			name: "requests a single repository",
+			opts: ListOptions{Repo: "cli/cli"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/cli/cli/notifications"),
+					httpmock.JSONResponse([]map[string]interface{}{}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "no notifications found",
+		},
+		{
+			name: "displays list error",
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "notifications"),
+					httpmock.StatusStringResponse(404, "Not Found"),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "X Failed to get notifications: HTTP 404 (https://api.github.com/notifications?per_page=30)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+			ios.SetStdinTTY(tt.tty)
+			ios.SetStderrTTY(tt.tty)
+			tt.opts.IO = ios
+			tt.opts.HostConfig = testHostConfig("github.com")
+			if tt.opts.Limit == 0 {
+				tt.opts.Limit = 30
+			}
+			defer reg.Verify(t)
+
+			err := listRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package notifications
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	notificationsListCmd "github.com/cli/cli/v2/pkg/cmd/notifications/list"
+	notificationsReadCmd "github.com/cli/cli/v2/pkg/cmd/notifications/read"
+	notificationsSubscribeCmd "github.com/cli/cli/v2/pkg/cmd/notifications/subscribe"
+	notificationsUnsubscribeCmd "github.com/cli/cli/v2/pkg/cmd/notifications/unsubscribe"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdNotifications(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notifications <command>",
+		Short: "Manage your GitHub notifications",
+		Long:  `Triage notifications for the authenticated user.`,
+		Example: heredoc.Doc(`
+			$ gh notifications list
+			$ gh notifications list --repo cli/cli --reason review_requested
+			$ gh notifications read --all
+			$ gh notifications unsubscribe --thread 1
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(notificationsListCmd.NewCmdList(f, nil))
+	cmd.AddCommand(notificationsReadCmd.NewCmdRead(f, nil))
+	cmd.AddCommand(notificationsSubscribeCmd.NewCmdSubscribe(f, nil))
+	cmd.AddCommand(notificationsUnsubscribeCmd.NewCmdUnsubscribe(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,158 @@
+package read
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/notifications/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type hostConfig interface {
+	DefaultHost() (string, string)
+}
+
+type ReadOptions struct {
+	HttpClient func() (*http.Client, error)
+	HostConfig hostConfig
+	IO         *iostreams.IOStreams
+	Browser    browser.Browser
+
+	ThreadID string
+	All      bool
+	Repo     string
+	Web      bool
+}
+
+func NewCmdRead(f *cmdutil.Factory, runF func(*ReadOptions) error) *cobra.Command {
+	opts := &ReadOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "read [<id>]",
+		Short: "Mark notifications as read",
+		Long: heredoc.Doc(`
+			Mark a single notification, or every notification, as read.
+
+			Pass a thread ID to mark that notification as read, or use --all to mark
+			every notification as read. Combine --all with --repo to limit it to a
+			single repository. Pass --web with a thread ID to open the notification's
+			subject in the browser instead of marking it as read.
+		`),
+		Example: heredoc.Doc(`
+			$ gh notifications read 1234567
+			$ gh notifications read --all
+			$ gh notifications read --all --repo cli/cli
+			$ gh notifications read 1234567 --web
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			opts.HostConfig = cfg.Authentication()
+
+			if len(args) > 0 {
+				opts.ThreadID = args[0]
+			}
+
+			if opts.ThreadID == "" && !opts.All {
+				return cmdutil.FlagErrorf("specify a thread ID or `--all`")
+			}
+			if opts.ThreadID != "" && opts.All {
+				return cmdutil.FlagErrorf("specify a thread ID or `--all`, not both")
+			}
+			if opts.Repo != "" && opts.ThreadID != "" {
+				return cmdutil.FlagErrorf("`--repo` can only be used with `--all`")
+			}
+			if opts.Web && opts.ThreadID == "" {
+				return cmdutil.FlagErrorf("`--web` requires a thread ID")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return readRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Mark all notifications for a single repository in OWNER/REPO format as read")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Mark every notification as read")
+	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the notification's subject in the browser instead of marking it as read")
+
+	return cmd
+}
+
+func readRun(opts *ReadOptions) error {
+	hostname, _ := opts.HostConfig.DefaultHost()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	if opts.Web {
+		notifications, err := shared.ListNotifications(client, hostname, "", shared.ListOptions{All: true})
+		if err != nil {
+			return err
+		}
+		for _, n := range notifications {
+			if n.ID == opts.ThreadID {
+				webURL := n.WebURL(hostname)
+				if webURL == "" {
+					return fmt.Errorf("notification %s has no web page to open", opts.ThreadID)
+				}
+				if opts.IO.IsStdoutTTY() {
+					fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", webURL)
+				}
+				return opts.Browser.Browse(webURL)
+			}
+		}
+		return fmt.Errorf("notification %s not found", opts.ThreadID)
+	}
+
+	if opts.ThreadID != "" {
+		if err := shared.MarkThreadRead(client, hostname, opts.ThreadID); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Marked notification %s as read\n", opts.IO.ColorScheme().SuccessIcon(), opts.ThreadID)
+		}
+		return nil
+	}
+
+	repo := opts.Repo
+	if repo != "" {
+		r, err := ghrepo.FromFullNameWithHost(repo, hostname)
+		if err != nil {
+			return err
+		}
+		repo = ghrepo.FullName(r)
+	}
+
+	if err := shared.MarkAllRead(client, hostname, repo); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		if repo != "" {
+			fmt.Fprintf(opts.IO.Out, "%s Marked all notifications for %s as read\n", opts.IO.ColorScheme().SuccessIcon(), repo)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s Marked all notifications as read\n", opts.IO.ColorScheme().SuccessIcon())
+		}
+	}
+
+	return nil
+}
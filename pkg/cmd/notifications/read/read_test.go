@@ -0,0 +1,172 @@
+package read
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHostConfig string
+
+func (c testHostConfig) DefaultHost() (string, string) {
+	return string(c), ""
+}
+
+func TestNewCmdRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wants    ReadOptions
+		wantsErr string
+	}{
+		{
+			name:  "with thread id",
+			input: "1234567",
+			wants: ReadOptions{ThreadID: "1234567"},
+		},
+		{
+			name:  "with --all",
+			input: "--all",
+			wants: ReadOptions{All: true},
+		},
+		{
+			name:     "no id and no --all",
+			input:    "",
+			wantsErr: "specify a thread ID or `--all`",
+		},
+		{
+			name:     "id and --all",
+			input:    "1234567 --all",
+			wantsErr: "specify a thread ID or `--all`, not both",
+		},
+		{
+			name:     "--repo without --all",
+			input:    "1234567 --repo cli/cli",
+			wantsErr: "`--repo` can only be used with `--all`",
+		},
+		{
+			name:     "--web without an id",
+			input:    "--all --web",
+			wantsErr: "`--web` requires a thread ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				Config: func() (config.Config, error) {
+					return config.NewFromString(""), nil
+				},
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *ReadOptions
+			cmd := NewCmdRead(f, func(opts *ReadOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.ThreadID, gotOpts.ThreadID)
+			assert.Equal(t, tt.wants.All, gotOpts.All)
+		})
+	}
+}
+
+func TestReadRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ReadOptions
+		stubs      func(*httpmock.Registry)
+		tty        bool
+		wantErr    bool
+		wantErrMsg string
+		wantStdout string
+	}{
+		{
+			name: "marks a single thread as read",
+			tty:  true,
+			opts: ReadOptions{ThreadID: "1234567"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PATCH", "notifications/threads/1234567"),
+					httpmock.StatusStringResponse(205, ""),
+				)
+			},
+			wantStdout: "✓ Marked notification 1234567 as read\n",
+		},
+		{
+			name: "marks every notification as read",
+			tty:  true,
+			opts: ReadOptions{All: true},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "notifications"),
+					httpmock.StatusStringResponse(205, ""),
+				)
+			},
+			wantStdout: "✓ Marked all notifications as read\n",
+		},
+		{
+			name: "marks a repository's notifications as read",
+			tty:  true,
+			opts: ReadOptions{All: true, Repo: "cli/cli"},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PUT", "repos/cli/cli/notifications"),
+					httpmock.StatusStringResponse(205, ""),
+				)
+			},
+			wantStdout: "✓ Marked all notifications for cli/cli as read\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.stubs != nil {
+				tt.stubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+			ios.SetStdinTTY(tt.tty)
+			ios.SetStderrTTY(tt.tty)
+			tt.opts.IO = ios
+			tt.opts.HostConfig = testHostConfig("github.com")
+			tt.opts.Browser = &browser.Stub{}
+			defer reg.Verify(t)
+
+			err := readRun(&tt.opts)
+			if tt.wantErr {
+				if tt.wantErrMsg != "" {
+					assert.EqualError(t, err, tt.wantErrMsg)
+				} else {
+					assert.Error(t, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
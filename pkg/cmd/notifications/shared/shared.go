@@ -0,0 +1,205 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+var Fields = []string{
+	"id",
+	"reason",
+	"repository",
+	"title",
+	"type",
+	"unread",
+	"updatedAt",
+	"url",
+}
+
+type Notification struct {
+	ID         string     `json:"id"`
+	Unread     bool       `json:"unread"`
+	Reason     string     `json:"reason"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	LastReadAt *time.Time `json:"last_read_at"`
+	Subject    struct {
+		Title            string `json:"title"`
+		URL              string `json:"url"`
+		LatestCommentURL string `json:"latest_comment_url"`
+		Type             string `json:"type"`
+	} `json:"subject"`
+	Repository struct {
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+	} `json:"repository"`
+}
+
+// WebURL translates the API URL of the notification's subject into the URL
+// of the corresponding web page, e.g. an issue, pull request, or release.
+// It returns an empty string if the subject has no API URL or it cannot be
+// translated.
+func (n Notification) WebURL(hostname string) string {
+	if n.Subject.URL == "" {
+		return ""
+	}
+
+	u, err := url.Parse(n.Subject.URL)
+	if err != nil {
+		return ""
+	}
+
+	path := strings.TrimPrefix(u.Path, "/repos")
+	path = strings.Replace(path, "/pulls/", "/pull/", 1)
+
+	return ghinstance.HostPrefix(hostname) + strings.TrimPrefix(path, "/")
+}
+
+func (n *Notification) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = n.ID
+		case "unread":
+			data[f] = n.Unread
+		case "reason":
+			data[f] = n.Reason
+		case "updatedAt":
+			data[f] = n.UpdatedAt
+		case "title":
+			data[f] = n.Subject.Title
+		case "type":
+			data[f] = n.Subject.Type
+		case "url":
+			data[f] = n.Subject.URL
+		case "repository":
+			data[f] = n.Repository.FullName
+		}
+	}
+	return data
+}
+
+type ListOptions struct {
+	All           bool
+	Participating bool
+	Since         string
+	Limit         int
+}
+
+// ListNotifications returns the authenticated user's notifications, or a
+// single repository's notifications when repo is non-empty. Pass a
+// non-positive limit to fetch every page the API has to offer.
+func ListNotifications(client *api.Client, hostname, repo string, opts ListOptions) ([]Notification, error) {
+	query := url.Values{}
+	if opts.All {
+		query.Add("all", "true")
+	}
+	if opts.Participating {
+		query.Add("participating", "true")
+	}
+	if opts.Since != "" {
+		query.Add("since", opts.Since)
+	}
+
+	perPage := 100
+	if opts.Limit > 0 && opts.Limit < perPage {
+		perPage = opts.Limit
+	}
+	query.Add("per_page", fmt.Sprintf("%d", perPage))
+
+	path := "notifications"
+	if repo != "" {
+		path = fmt.Sprintf("repos/%s/notifications", repo)
+	}
+	path = fmt.Sprintf("%s?%s", path, query.Encode())
+
+	var notifications []Notification
+	for path != "" {
+		var page []Notification
+		var err error
+		path, err = client.RESTWithNext(hostname, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, page...)
+
+		if opts.Limit > 0 && len(notifications) >= opts.Limit {
+			notifications = notifications[:opts.Limit]
+			break
+		}
+	}
+
+	return notifications, nil
+}
+
+// MarkThreadRead marks a single notification thread as read.
+func MarkThreadRead(client *api.Client, hostname, threadID string) error {
+	return restNoContent(client, hostname, "PATCH", fmt.Sprintf("notifications/threads/%s", threadID), nil)
+}
+
+// MarkAllRead marks every notification as read, or every notification for a
+// single repository when repo is non-empty.
+func MarkAllRead(client *api.Client, hostname, repo string) error {
+	path := "notifications"
+	if repo != "" {
+		path = fmt.Sprintf("repos/%s/notifications", repo)
+	}
+	return restNoContent(client, hostname, "PUT", path, strings.NewReader("{}"))
+}
+
+// restNoContent issues a REST request for endpoints that respond with no
+// body on success (e.g. the notifications "mark as read" endpoints, which
+// reply 205 Reset Content). client.REST always attempts to decode a JSON
+// response, which fails against an empty body.
+func restNoContent(client *api.Client, hostname, method, path string, body *strings.Reader) error {
+	var reader io.Reader
+	if body != nil {
+		reader = body
+	}
+
+	req, err := http.NewRequest(method, ghinstance.RESTPrefix(hostname)+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := client.HTTP().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+// SetThreadSubscription subscribes to or unsubscribes from future
+// notifications for a thread without marking it as read.
+func SetThreadSubscription(client *api.Client, hostname, threadID string, ignored bool) error {
+	body, err := json.Marshal(map[string]bool{"ignored": ignored})
+	if err != nil {
+		return err
+	}
+	return client.REST(hostname, "PUT", fmt.Sprintf("notifications/threads/%s/subscription", threadID), bytes.NewReader(body), nil)
+}
+
+// DeleteThreadSubscription removes a thread subscription, returning it to
+// its default, automatic state.
+func DeleteThreadSubscription(client *api.Client, hostname, threadID string) error {
+	return client.REST(hostname, "DELETE", fmt.Sprintf("notifications/threads/%s/subscription", threadID), nil, nil)
+}
@@ -0,0 +1,37 @@
+package shared
+
+import "testing"
+
+func TestNotification_WebURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiURL string
+		want   string
+	}{
+		{
+			name:   "issue",
+			apiURL: "https://api.github.com/repos/cli/cli/issues/1234",
+			want:   "https://github.com/cli/cli/issues/1234",
+		},
+		{
+			name:   "pull request",
+			apiURL: "https://api.github.com/repos/cli/cli/pulls/1234",
+			want:   "https://github.com/cli/cli/pull/1234",
+		},
+		{
+			name:   "no subject url",
+			apiURL: "",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n Notification
+			n.Subject.URL = tt.apiURL
+			if got := n.WebURL("github.com"); got != tt.want {
+				t.Errorf("WebURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package subscribe
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmd/notifications/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type hostConfig interface {
+	DefaultHost() (string, string)
+}
+
+type SubscribeOptions struct {
+	HttpClient func() (*http.Client, error)
+	HostConfig hostConfig
+	IO         *iostreams.IOStreams
+
+	ThreadID string
+}
+
+func NewCmdSubscribe(f *cmdutil.Factory, runF func(*SubscribeOptions) error) *cobra.Command {
+	opts := &SubscribeOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "subscribe",
+		Short: "Subscribe to future notifications for a thread",
+		Example: heredoc.Doc(`
+			$ gh notifications subscribe --thread 1234567
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			opts.HostConfig = cfg.Authentication()
+
+			if opts.ThreadID == "" {
+				return cmdutil.FlagErrorf("`--thread` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return subscribeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ThreadID, "thread", "", "ID of the notification thread to subscribe to")
+
+	return cmd
+}
+
+func subscribeRun(opts *SubscribeOptions) error {
+	hostname, _ := opts.HostConfig.DefaultHost()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	if err := shared.SetThreadSubscription(client, hostname, opts.ThreadID, false); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Subscribed to notification thread %s\n", opts.IO.ColorScheme().SuccessIcon(), opts.ThreadID)
+	}
+
+	return nil
+}
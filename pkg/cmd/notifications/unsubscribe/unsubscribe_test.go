@@ -0,0 +1,95 @@
+package unsubscribe
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHostConfig string
+
+func (c testHostConfig) DefaultHost() (string, string) {
+	return string(c), ""
+}
+
+func TestNewCmdUnsubscribe(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wants    UnsubscribeOptions
+		wantsErr string
+	}{
+		{
+			name:  "with thread",
+			input: "--thread 1234567",
+			wants: UnsubscribeOptions{ThreadID: "1234567"},
+		},
+		{
+			name:     "without thread",
+			input:    "",
+			wantsErr: "`--thread` is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				Config: func() (config.Config, error) {
+					return config.NewFromString(""), nil
+				},
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *UnsubscribeOptions
+			cmd := NewCmdUnsubscribe(f, func(opts *UnsubscribeOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.ThreadID, gotOpts.ThreadID)
+		})
+	}
+}
+
+func TestUnsubscribeRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("DELETE", "notifications/threads/1234567/subscription"),
+		httpmock.StatusStringResponse(204, ""),
+	)
+	defer reg.Verify(t)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	opts := &UnsubscribeOptions{
+		IO:         ios,
+		HostConfig: testHostConfig("github.com"),
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		ThreadID: "1234567",
+	}
+
+	assert.NoError(t, unsubscribeRun(opts))
+	assert.Equal(t, "✓ Unsubscribed from notification thread 1234567\n", stdout.String())
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
 )
 
 type OrganizationList struct {
@@ -12,8 +13,41 @@ type OrganizationList struct {
 	User          string
 }
 
+var orgFields = []string{
+	"id",
+	"login",
+	"name",
+	"role",
+}
+
 type Organization struct {
-	Login string
+	ID                  string
+	Login               string
+	Name                string
+	ViewerCanAdminister bool
+}
+
+// Role reports the viewer's role in the organization. GitHub's GraphQL API
+// does not expose a role enum on a user's organization membership, so this
+// is derived from whether the viewer can administer the organization.
+func (o Organization) Role() string {
+	if o.ViewerCanAdminister {
+		return "Admin"
+	}
+	return "Member"
+}
+
+func (o *Organization) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "role":
+			data[f] = o.Role()
+		default:
+			data[f] = cmdutil.StructExportData(o, []string{f})[f]
+		}
+	}
+	return data
 }
 
 func listOrgs(httpClient *http.Client, hostname string, limit int) (*OrganizationList, error) {
@@ -37,7 +71,10 @@ func listOrgs(httpClient *http.Client, hostname string, limit int) (*Organizatio
 			organizations(first: $limit, after: $endCursor) {
 				totalCount
 				nodes {
+					id
 					login
+					name
+					viewerCanAdminister
 				}
 				pageInfo {
 					hasNextPage
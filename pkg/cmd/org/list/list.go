@@ -6,6 +6,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -17,7 +18,8 @@ type ListOptions struct {
 	Config     func() (config.Config, error)
 	HttpClient func() (*http.Client, error)
 
-	Limit int
+	Exporter cmdutil.Exporter
+	Limit    int
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -52,6 +54,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of organizations to list")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, orgFields)
 
 	return cmd
 }
@@ -74,6 +77,10 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, listResult.Organizations)
+	}
+
 	if err := opts.IO.StartPager(); err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
 	}
@@ -84,11 +91,24 @@ func listRun(opts *ListOptions) error {
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", header)
 	}
 
-	for _, org := range listResult.Organizations {
-		fmt.Fprintln(opts.IO.Out, org.Login)
+	return printOrgs(opts.IO, listResult.Organizations)
+}
+
+func printOrgs(io *iostreams.IOStreams, orgs []Organization) error {
+	if len(orgs) == 0 {
+		return nil
+	}
+
+	table := tableprinter.New(io, tableprinter.WithHeader("LOGIN", "NAME", "ROLE"))
+
+	for _, org := range orgs {
+		table.AddField(org.Login)
+		table.AddField(org.Name)
+		table.AddField(org.Role())
+		table.EndRow()
 	}
 
-	return nil
+	return table.Render()
 }
 
 func listHeader(user string, resultCount, totalCount int) string {
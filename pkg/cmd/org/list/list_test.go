@@ -45,6 +45,11 @@ func TestNewCmdList(t *testing.T) {
 			cli:      "-L 0",
 			wantsErr: "invalid limit: 0",
 		},
+		{
+			name:     "invalid json flag",
+			cli:      "--json invalid",
+			wantsErr: "Unknown JSON field: \"invalid\"\nAvailable fields:\n  id\n  login\n  name\n  role",
+		},
 	}
 
 	for _, tt := range tests {
@@ -126,10 +131,14 @@ There are no organizations associated with @octocat
 							"totalCount": 2,
 							"nodes": [
 							{
-								"login": "github"
+								"login": "github",
+								"name": "GitHub",
+								"viewerCanAdminister": true
 							},
 							{
-								"login": "cli"
+								"login": "cli",
+								"name": "",
+								"viewerCanAdminister": false
 							}
 						]	}	} } }`,
 					),
@@ -142,8 +151,9 @@ There are no organizations associated with @octocat
 
 Showing 2 of 2 organizations
 
-github
-cli
+LOGIN   NAME    ROLE
+github  GitHub  Admin
+cli             Member
 `),
 		},
 		{
@@ -162,10 +172,14 @@ cli
 							"totalCount": 2,
 							"nodes": [
 							{
-								"login": "github"
+								"login": "github",
+								"name": "GitHub",
+								"viewerCanAdminister": true
 							},
 							{
-								"login": "cli"
+								"login": "cli",
+								"name": "",
+								"viewerCanAdminister": false
 							}
 						]	}	} } }`,
 					),
@@ -178,7 +192,8 @@ cli
 
 Showing 1 of 2 organizations
 
-github
+LOGIN   NAME    ROLE
+github  GitHub  Admin
 `),
 		},
 		{
@@ -197,10 +212,14 @@ github
 							"totalCount": 2,
 							"nodes": [
 							{
-								"login": "github"
+								"login": "github",
+								"name": "GitHub",
+								"viewerCanAdminister": true
 							},
 							{
-								"login": "cli"
+								"login": "cli",
+								"name": "",
+								"viewerCanAdminister": false
 							}
 						]	}	} } }`,
 					),
@@ -208,10 +227,7 @@ github
 				return &http.Client{Transport: r}, nil
 			}},
 			isTTY: false,
-			wantOut: heredoc.Doc(`
-github
-cli
-`),
+			wantOut: "github\tGitHub\tAdmin\ncli\t\tMember\n",
 		},
 	}
 
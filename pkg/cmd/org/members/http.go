@@ -0,0 +1,276 @@
+package members
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	ghAPI "github.com/cli/go-gh/v2/pkg/api"
+)
+
+var memberFields = []string{
+	"id",
+	"login",
+	"name",
+	"role",
+	"twoFactorDisabled",
+}
+
+type Member struct {
+	ID    string `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+	// TwoFactorDisabled is nil when the viewer lacks permission to see 2FA status.
+	TwoFactorDisabled *bool `json:"twoFactorDisabled"`
+}
+
+func (m *Member) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(m, fields)
+}
+
+type MemberList struct {
+	Members    []Member
+	TotalCount int
+	// TwoFactorUnavailable is true when the viewer's token lacks the scope
+	// required to see members' two-factor authentication status.
+	TwoFactorUnavailable bool
+}
+
+func listMembers(httpClient *http.Client, hostname, org, role, team string, limit int) (*MemberList, error) {
+	if team != "" {
+		return listTeamMembers(httpClient, hostname, org, team, role, limit)
+	}
+	return listOrgMembers(httpClient, hostname, org, role, limit)
+}
+
+func listOrgMembers(httpClient *http.Client, hostname, org, role string, limit int) (*MemberList, error) {
+	type edge struct {
+		Role                string
+		HasTwoFactorEnabled bool
+		Node                struct {
+			ID    string
+			Login string
+			Name  string
+		}
+	}
+	type response struct {
+		Organization struct {
+			MembersWithRole struct {
+				TotalCount int
+				Edges      []edge
+				PageInfo   struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"membersWithRole(first: $limit, after: $endCursor)"`
+		}
+	}
+
+	query := `query OrgMembersWithRole($org: String!, $limit: Int!, $endCursor: String) {
+		organization(login: $org) {
+			membersWithRole(first: $limit, after: $endCursor) {
+				totalCount
+				edges {
+					role
+					hasTwoFactorEnabled
+					node {
+						id
+						login
+						name
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	listResult := MemberList{}
+	pageLimit := min(limit, 100)
+	variables := map[string]interface{}{
+		"org": org,
+	}
+
+loop:
+	for {
+		variables["limit"] = pageLimit
+		var data response
+		err := client.GraphQL(hostname, query, variables, &data)
+		twoFactorUnavailable := false
+		if err != nil {
+			var gqlErrResponse api.GraphQLError
+			if errors.As(err, &gqlErrResponse) {
+				gqlErrors := make([]ghAPI.GraphQLErrorItem, 0, len(gqlErrResponse.Errors))
+				for _, gqlErr := range gqlErrResponse.Errors {
+					if gqlErr.Type == "FORBIDDEN" && pathContains(gqlErr.Path, "hasTwoFactorEnabled") {
+						twoFactorUnavailable = true
+					} else {
+						gqlErrors = append(gqlErrors, gqlErr)
+					}
+				}
+				if len(gqlErrors) == 0 {
+					err = nil
+				} else {
+					err = api.GraphQLError{
+						GraphQLError: &ghAPI.GraphQLError{
+							Errors: gqlErrors,
+						},
+					}
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		listResult.TotalCount = data.Organization.MembersWithRole.TotalCount
+		if twoFactorUnavailable {
+			listResult.TwoFactorUnavailable = true
+		}
+
+		for _, e := range data.Organization.MembersWithRole.Edges {
+			if role != "" && !strings.EqualFold(role, e.Role) {
+				continue
+			}
+			m := Member{
+				ID:    e.Node.ID,
+				Login: e.Node.Login,
+				Name:  e.Node.Name,
+				Role:  e.Role,
+			}
+			if !listResult.TwoFactorUnavailable {
+				hasTwoFactor := e.HasTwoFactorEnabled
+				disabled := !hasTwoFactor
+				m.TwoFactorDisabled = &disabled
+			}
+			listResult.Members = append(listResult.Members, m)
+			if len(listResult.Members) == limit {
+				break loop
+			}
+		}
+
+		if data.Organization.MembersWithRole.PageInfo.HasNextPage {
+			variables["endCursor"] = data.Organization.MembersWithRole.PageInfo.EndCursor
+			pageLimit = min(pageLimit, limit-len(listResult.Members))
+		} else {
+			break
+		}
+	}
+
+	return &listResult, nil
+}
+
+func listTeamMembers(httpClient *http.Client, hostname, org, team, role string, limit int) (*MemberList, error) {
+	type edge struct {
+		Role string
+		Node struct {
+			ID    string
+			Login string
+			Name  string
+		}
+	}
+	type response struct {
+		Organization struct {
+			Team struct {
+				Members struct {
+					TotalCount int
+					Edges      []edge
+					PageInfo   struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				} `graphql:"members(first: $limit, after: $endCursor, membership: IMMEDIATE)"`
+			} `graphql:"team(slug: $team)"`
+		}
+	}
+
+	query := `query OrgTeamMembers($org: String!, $team: String!, $limit: Int!, $endCursor: String) {
+		organization(login: $org) {
+			team(slug: $team) {
+				members(first: $limit, after: $endCursor, membership: IMMEDIATE) {
+					totalCount
+					edges {
+						role
+						node {
+							id
+							login
+							name
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	listResult := MemberList{}
+	pageLimit := min(limit, 100)
+	variables := map[string]interface{}{
+		"org":  org,
+		"team": team,
+	}
+
+loop:
+	for {
+		variables["limit"] = pageLimit
+		var data response
+		err := client.GraphQL(hostname, query, variables, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		listResult.TotalCount = data.Organization.Team.Members.TotalCount
+
+		for _, e := range data.Organization.Team.Members.Edges {
+			if role != "" && !strings.EqualFold(role, e.Role) {
+				continue
+			}
+			listResult.Members = append(listResult.Members, Member{
+				ID:    e.Node.ID,
+				Login: e.Node.Login,
+				Name:  e.Node.Name,
+				Role:  e.Role,
+			})
+			if len(listResult.Members) == limit {
+				break loop
+			}
+		}
+
+		if data.Organization.Team.Members.PageInfo.HasNextPage {
+			variables["endCursor"] = data.Organization.Team.Members.PageInfo.EndCursor
+			pageLimit = min(pageLimit, limit-len(listResult.Members))
+		} else {
+			break
+		}
+	}
+
+	return &listResult, nil
+}
+
+func pathContains(path []interface{}, want string) bool {
+	for _, p := range path {
+		if s, ok := p.(string); ok && s == want {
+			return true
+		}
+	}
+	return false
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
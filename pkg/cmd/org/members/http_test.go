@@ -0,0 +1,138 @@
+package members
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listMembers(t *testing.T) {
+	tests := []struct {
+		name          string
+		role          string
+		team          string
+		limit         int
+		httpStub      func(*httpmock.Registry)
+		wantMembers   []Member
+		want2FAUnsure bool
+		wantErr       bool
+	}{
+		{
+			name:  "default",
+			limit: 30,
+			httpStub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrgMembersWithRole\b`),
+					httpmock.StringResponse(`
+						{ "data": { "organization": { "membersWithRole": {
+							"totalCount": 2,
+							"edges": [
+								{ "role": "ADMIN", "hasTwoFactorEnabled": true, "node": { "id": "1", "login": "monalisa", "name": "Mona Lisa" } },
+								{ "role": "MEMBER", "hasTwoFactorEnabled": false, "node": { "id": "2", "login": "hubot", "name": "" } }
+							],
+							"pageInfo": { "hasNextPage": false, "endCursor": null }
+						} } } }`,
+					),
+				)
+			},
+			wantMembers: []Member{
+				{ID: "1", Login: "monalisa", Name: "Mona Lisa", Role: "ADMIN", TwoFactorDisabled: boolPtr(false)},
+				{ID: "2", Login: "hubot", Name: "", Role: "MEMBER", TwoFactorDisabled: boolPtr(true)},
+			},
+		},
+		{
+			name:  "filtered by role",
+			role:  "admin",
+			limit: 30,
+			httpStub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrgMembersWithRole\b`),
+					httpmock.StringResponse(`
+						{ "data": { "organization": { "membersWithRole": {
+							"totalCount": 2,
+							"edges": [
+								{ "role": "ADMIN", "hasTwoFactorEnabled": true, "node": { "id": "1", "login": "monalisa", "name": "Mona Lisa" } },
+								{ "role": "MEMBER", "hasTwoFactorEnabled": false, "node": { "id": "2", "login": "hubot", "name": "" } }
+							],
+							"pageInfo": { "hasNextPage": false, "endCursor": null }
+						} } } }`,
+					),
+				)
+			},
+			wantMembers: []Member{
+				{ID: "1", Login: "monalisa", Name: "Mona Lisa", Role: "ADMIN", TwoFactorDisabled: boolPtr(false)},
+			},
+		},
+		{
+			name:  "two-factor status forbidden",
+			limit: 30,
+			httpStub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrgMembersWithRole\b`),
+					httpmock.StringResponse(`
+						{ "data": { "organization": { "membersWithRole": {
+							"totalCount": 1,
+							"edges": [
+								{ "role": "MEMBER", "hasTwoFactorEnabled": false, "node": { "id": "2", "login": "hubot", "name": "" } }
+							],
+							"pageInfo": { "hasNextPage": false, "endCursor": null }
+						} } },
+						"errors": [
+							{ "type": "FORBIDDEN", "message": "Must have admin rights", "path": ["organization", "membersWithRole", "edges", 0, "hasTwoFactorEnabled"] }
+						] }`,
+					),
+				)
+			},
+			wantMembers: []Member{
+				{ID: "2", Login: "hubot", Name: "", Role: "MEMBER"},
+			},
+			want2FAUnsure: true,
+		},
+		{
+			name:  "filtered by team",
+			team:  "core",
+			limit: 30,
+			httpStub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query OrgTeamMembers\b`),
+					httpmock.StringResponse(`
+						{ "data": { "organization": { "team": { "members": {
+							"totalCount": 1,
+							"edges": [
+								{ "role": "MAINTAINER", "node": { "id": "1", "login": "monalisa", "name": "Mona Lisa" } }
+							],
+							"pageInfo": { "hasNextPage": false, "endCursor": null }
+						} } } } }`,
+					),
+				)
+			},
+			wantMembers: []Member{
+				{ID: "1", Login: "monalisa", Name: "Mona Lisa", Role: "MAINTAINER"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.httpStub(reg)
+			httpClient := &http.Client{Transport: reg}
+
+			result, err := listMembers(httpClient, "github.com", "my-org", tt.role, tt.team, tt.limit)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMembers, result.Members)
+			assert.Equal(t, tt.want2FAUnsure, result.TwoFactorUnavailable)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,154 @@
+package members
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type MembersOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org      string
+	Role     string
+	Team     string
+	Limit    int
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdMembers(f *cmdutil.Factory, runF func(*MembersOptions) error) *cobra.Command {
+	opts := MembersOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "members <organization>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List members of an organization",
+		Long: heredoc.Doc(`
+			List members of an organization.
+
+			Showing whether a member has two-factor authentication disabled requires
+			the authenticated account to be an administrator of the organization,
+			and for the token in use to have the read:org scope. When that
+			information is not available, a warning is printed and the column is
+			omitted.
+		`),
+		Example: heredoc.Doc(`
+			# List members of an organization
+			$ gh org members my-org
+
+			# List only organization admins
+			$ gh org members my-org --role admin
+
+			# List members of a specific team
+			$ gh org members my-org --team my-team
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return membersRun(&opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "", []string{"admin", "member"}, "Filter members returned by their role in the organization")
+	cmd.Flags().StringVar(&opts.Team, "team", "", "Filter members returned by the team they belong to")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of members to list")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, memberFields)
+
+	return cmd
+}
+
+func membersRun(opts *MembersOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	listResult, err := listMembers(httpClient, host, opts.Org, opts.Role, opts.Team, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if listResult.TwoFactorUnavailable {
+		fmt.Fprintln(opts.IO.ErrOut, "warning: could not determine two-factor authentication status; this requires organization admin access")
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, listResult.Members)
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.IO.IsStdoutTTY() {
+		header := membersHeader(opts.Org, len(listResult.Members), listResult.TotalCount)
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", header)
+	}
+
+	return printMembers(opts.IO, listResult.Members)
+}
+
+func printMembers(io *iostreams.IOStreams, members []Member) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	cs := io.ColorScheme()
+	table := tableprinter.New(io, tableprinter.WithHeader("LOGIN", "NAME", "ROLE", "2FA"))
+
+	for _, m := range members {
+		table.AddField(m.Login)
+		table.AddField(m.Name)
+		table.AddField(m.Role)
+		table.AddField(twoFactorStatus(cs, m.TwoFactorDisabled))
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func twoFactorStatus(cs *iostreams.ColorScheme, disabled *bool) string {
+	if disabled == nil {
+		return ""
+	}
+	if *disabled {
+		return cs.Red("Disabled")
+	}
+	return "Enabled"
+}
+
+func membersHeader(org string, resultCount, totalCount int) string {
+	if totalCount == 0 {
+		return fmt.Sprintf("There are no members associated with @%s", org)
+	}
+
+	return fmt.Sprintf("Showing %d of %s in @%s", resultCount, text.Pluralize(totalCount, "member"), org)
+}
@@ -0,0 +1,179 @@
+package members
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdMembers(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    MembersOptions
+		wantsErr string
+	}{
+		{
+			name: "no flags",
+			cli:  "my-org",
+			wants: MembersOptions{
+				Org:   "my-org",
+				Limit: 30,
+			},
+		},
+		{
+			name: "with role",
+			cli:  "my-org --role admin",
+			wants: MembersOptions{
+				Org:   "my-org",
+				Role:  "admin",
+				Limit: 30,
+			},
+		},
+		{
+			name: "with team",
+			cli:  "my-org --team core",
+			wants: MembersOptions{
+				Org:   "my-org",
+				Team:  "core",
+				Limit: 30,
+			},
+		},
+		{
+			name:     "missing organization",
+			cli:      "",
+			wantsErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:     "invalid limit",
+			cli:      "my-org -L 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *MembersOptions
+			cmd := NewCmdMembers(f, func(opts *MembersOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.Role, gotOpts.Role)
+			assert.Equal(t, tt.wants.Team, gotOpts.Team)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestMembersRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    MembersOptions
+		isTTY   bool
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "default behavior",
+			opts: MembersOptions{
+				Org: "my-org",
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrgMembersWithRole\b`),
+						httpmock.StringResponse(`
+							{ "data": { "organization": { "membersWithRole": {
+								"totalCount": 2,
+								"edges": [
+									{ "role": "ADMIN", "hasTwoFactorEnabled": true, "node": { "id": "1", "login": "monalisa", "name": "Mona Lisa" } },
+									{ "role": "MEMBER", "hasTwoFactorEnabled": false, "node": { "id": "2", "login": "hubot", "name": "" } }
+								],
+								"pageInfo": { "hasNextPage": false, "endCursor": null }
+							} } } }`,
+						),
+					)
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: true,
+			wantOut: "\nShowing 2 of 2 members in @my-org\n\n" +
+				"LOGIN     NAME       ROLE    2FA\n" +
+				"monalisa  Mona Lisa  ADMIN   Enabled\n" +
+				"hubot                MEMBER  Disabled\n",
+		},
+		{
+			name: "no members found",
+			opts: MembersOptions{
+				Org: "my-org",
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrgMembersWithRole\b`),
+						httpmock.StringResponse(`
+							{ "data": { "organization": { "membersWithRole": {
+								"totalCount": 0,
+								"edges": [],
+								"pageInfo": { "hasNextPage": false, "endCursor": null }
+							} } } }`,
+						),
+					)
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY:   true,
+			wantOut: "\nThere are no members associated with @my-org\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			tt.opts.IO = ios
+			tt.opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			if tt.opts.Limit == 0 {
+				tt.opts.Limit = 30
+			}
+
+			err := membersRun(&tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
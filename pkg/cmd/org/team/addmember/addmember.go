@@ -0,0 +1,107 @@
+package addmember
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/org/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddMemberOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org      string
+	Slug     string
+	Username string
+	Role     string
+}
+
+func NewCmdAddMember(f *cmdutil.Factory, runF func(*AddMemberOptions) error) *cobra.Command {
+	opts := &AddMemberOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add-member <organization>/<team-slug> <username>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add a member to a team",
+		Long: heredoc.Doc(`
+			Add a user to a team, or update their role on the team if they are
+			already a member.
+
+			Adding a user who is already on the team is not an error; it succeeds
+			and prints a notice instead.
+		`),
+		Example: heredoc.Doc(`
+			# Add a member to a team
+			$ gh org team add-member my-org/my-team monalisa
+
+			# Add a member as a team maintainer
+			$ gh org team add-member my-org/my-team monalisa --role maintainer
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			org, slug, err := shared.ParseSlug(args[0])
+			if err != nil {
+				return err
+			}
+			opts.Org = org
+			opts.Slug = slug
+			opts.Username = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addMemberRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "member", []string{"maintainer", "member"}, "The role to grant the member on the team")
+
+	return cmd
+}
+
+func addMemberRun(opts *AddMemberOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	alreadyMember, existingRole, err := shared.MembershipState(httpClient, host, opts.Org, opts.Slug, opts.Username)
+	if err != nil {
+		shared.PrintError(opts.IO.ErrOut, err)
+		return cmdutil.SilentError
+	}
+
+	if err := shared.AddTeamMember(httpClient, host, opts.Org, opts.Slug, opts.Username, opts.Role); err != nil {
+		shared.PrintError(opts.IO.ErrOut, err)
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	switch {
+	case alreadyMember && existingRole == opts.Role:
+		fmt.Fprintf(opts.IO.Out, "%s %s is already a %s of %s/%s\n", cs.WarningIcon(), opts.Username, opts.Role, opts.Org, opts.Slug)
+	case alreadyMember:
+		fmt.Fprintf(opts.IO.Out, "%s Changed %s's role on %s/%s from %s to %s\n", cs.SuccessIcon(), opts.Username, opts.Org, opts.Slug, existingRole, opts.Role)
+	default:
+		fmt.Fprintf(opts.IO.Out, "%s Added %s to %s/%s as a %s\n", cs.SuccessIcon(), opts.Username, opts.Org, opts.Slug, opts.Role)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package addmember
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdAddMember(t *testing.T) {
+	f := &cmdutil.Factory{}
+
+	argv, err := shlex.Split("my-org/my-team monalisa --role maintainer")
+	require.NoError(t, err)
+
+	var gotOpts *AddMemberOptions
+	cmd := NewCmdAddMember(f, func(opts *AddMemberOptions) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "my-org", gotOpts.Org)
+	assert.Equal(t, "my-team", gotOpts.Slug)
+	assert.Equal(t, "monalisa", gotOpts.Username)
+	assert.Equal(t, "maintainer", gotOpts.Role)
+}
+
+func TestAddMemberRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		membershipResp httpmock.Responder
+		wantOut        string
+	}{
+		{
+			name:           "new member",
+			membershipResp: httpmock.StatusStringResponse(404, `{"message": "Not Found"}`),
+			wantOut:        "✓ Added monalisa to my-org/my-team as a member\n",
+		},
+		{
+			name:           "already a member with same role",
+			membershipResp: httpmock.StringResponse(`{"role": "member"}`),
+			wantOut:        "! monalisa is already a member of my-org/my-team\n",
+		},
+		{
+			name:           "already a member with different role",
+			membershipResp: httpmock.StringResponse(`{"role": "member"}`),
+			wantOut:        "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(
+				httpmock.REST("GET", "orgs/my-org/teams/my-team/memberships/monalisa"),
+				tt.membershipResp,
+			)
+			reg.Register(
+				httpmock.REST("PUT", "orgs/my-org/teams/my-team/memberships/monalisa"),
+				httpmock.StringResponse(`{"state": "active"}`),
+			)
+
+			ios, _, stdout, _ := iostreams.Test()
+
+			role := "member"
+			if tt.name == "already a member with different role" {
+				role = "maintainer"
+			}
+
+			opts := &AddMemberOptions{
+				IO: ios,
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				Org:      "my-org",
+				Slug:     "my-team",
+				Username: "monalisa",
+				Role:     role,
+			}
+
+			err := addMemberRun(opts)
+			require.NoError(t, err)
+			if tt.wantOut != "" {
+				assert.Equal(t, tt.wantOut, stdout.String())
+			} else {
+				assert.Equal(t, "✓ Changed monalisa's role on my-org/my-team from member to maintainer\n", stdout.String())
+			}
+		})
+	}
+}
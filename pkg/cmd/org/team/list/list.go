@@ -0,0 +1,110 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/org/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org      string
+	Exporter cmdutil.Exporter
+}
+
+var teamFields = []string{
+	"id",
+	"slug",
+	"name",
+	"description",
+	"privacy",
+	"permission",
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <organization>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List teams in an organization",
+		Example: heredoc.Doc(`
+			$ gh org team list my-org
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Org = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, teamFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	teams, err := shared.ListTeams(httpClient, host, opts.Org)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, teams)
+	}
+
+	if len(teams) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no teams found in %s", opts.Org))
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nShowing %s in @%s\n\n", text.Pluralize(len(teams), "team"), opts.Org)
+	}
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("SLUG", "NAME", "PRIVACY", "PERMISSION", "DESCRIPTION"))
+	for _, team := range teams {
+		table.AddField(team.Slug)
+		table.AddField(team.Name)
+		table.AddField(team.Privacy)
+		table.AddField(team.Permission)
+		table.AddField(team.Description)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
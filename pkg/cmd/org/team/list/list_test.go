@@ -0,0 +1,68 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdList(t *testing.T) {
+	f := &cmdutil.Factory{}
+
+	argv, err := shlex.Split("my-org")
+	require.NoError(t, err)
+
+	var gotOpts *ListOptions
+	cmd := NewCmdList(f, func(opts *ListOptions) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "my-org", gotOpts.Org)
+}
+
+func TestListRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams"),
+		httpmock.StringResponse(`[
+			{ "id": 1, "slug": "core", "name": "Core", "privacy": "closed", "permission": "admin" },
+			{ "id": 2, "slug": "docs", "name": "Docs", "privacy": "secret", "permission": "pull" }
+		]`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		IO: ios,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Org: "my-org",
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "\nShowing 2 teams in @my-org\n\n"+
+		"SLUG  NAME  PRIVACY  PERMISSION  DESCRIPTION\n"+
+		"core  Core  closed   admin       \n"+
+		"docs  Docs  secret   pull        \n", stdout.String())
+}
@@ -0,0 +1,126 @@
+package members
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/org/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type MembersOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org       string
+	Slug      string
+	Role      string
+	Inherited bool
+	Exporter  cmdutil.Exporter
+}
+
+var memberFields = []string{
+	"id",
+	"login",
+}
+
+func NewCmdMembers(f *cmdutil.Factory, runF func(*MembersOptions) error) *cobra.Command {
+	opts := &MembersOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "members <organization>/<team-slug>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List members of a team",
+		Long: heredoc.Doc(`
+			List the members of a team.
+
+			By default, only members added directly to the team are shown. Pass
+			--inherited to also include members of the team's child teams.
+		`),
+		Example: heredoc.Doc(`
+			# List members of a team
+			$ gh org team members my-org/my-team
+
+			# Include members of child teams
+			$ gh org team members my-org/my-team --inherited
+
+			# List only the team's maintainers
+			$ gh org team members my-org/my-team --role maintainer
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			org, slug, err := shared.ParseSlug(args[0])
+			if err != nil {
+				return err
+			}
+			opts.Org = org
+			opts.Slug = slug
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return membersRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "", []string{"maintainer", "member"}, "Filter members returned by their role on the team")
+	cmd.Flags().BoolVar(&opts.Inherited, "inherited", false, "Include members inherited from child teams")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, memberFields)
+
+	return cmd
+}
+
+func membersRun(opts *MembersOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	members, err := shared.ListTeamMembers(httpClient, host, opts.Org, opts.Slug, opts.Role, opts.Inherited)
+	if err != nil {
+		shared.PrintError(opts.IO.ErrOut, err)
+		return cmdutil.SilentError
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, members)
+	}
+
+	if len(members) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no members found for team %s/%s", opts.Org, opts.Slug))
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nShowing %s for %s/%s\n\n", text.Pluralize(len(members), "member"), opts.Org, opts.Slug)
+	}
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("LOGIN"))
+	for _, m := range members {
+		table.AddField(m.Login)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
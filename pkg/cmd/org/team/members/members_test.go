@@ -0,0 +1,111 @@
+package members
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdMembers(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		wantOrg   string
+		wantSlug  string
+		wantErr   string
+		wantRole  string
+		wantInher bool
+	}{
+		{
+			name:     "no flags",
+			cli:      "my-org/my-team",
+			wantOrg:  "my-org",
+			wantSlug: "my-team",
+		},
+		{
+			name:     "with role",
+			cli:      "my-org/my-team --role maintainer",
+			wantOrg:  "my-org",
+			wantSlug: "my-team",
+			wantRole: "maintainer",
+		},
+		{
+			name:      "with inherited",
+			cli:       "my-org/my-team --inherited",
+			wantOrg:   "my-org",
+			wantSlug:  "my-team",
+			wantInher: true,
+		},
+		{
+			name:    "invalid slug",
+			cli:     "my-team",
+			wantErr: `expected the value for team to be formatted as "ORG/TEAM-SLUG"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+
+			var gotOpts *MembersOptions
+			cmd := NewCmdMembers(f, func(opts *MembersOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOrg, gotOpts.Org)
+			assert.Equal(t, tt.wantSlug, gotOpts.Slug)
+			assert.Equal(t, tt.wantRole, gotOpts.Role)
+			assert.Equal(t, tt.wantInher, gotOpts.Inherited)
+		})
+	}
+}
+
+func TestMembersRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/my-team/members"),
+		httpmock.StringResponse(`[{ "id": 1, "login": "monalisa" }]`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &MembersOptions{
+		IO: ios,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Org:  "my-org",
+		Slug: "my-team",
+	}
+
+	err := membersRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "\nShowing 1 member for my-org/my-team\n\nLOGIN\nmonalisa\n", stdout.String())
+}
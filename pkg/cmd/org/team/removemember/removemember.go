@@ -0,0 +1,80 @@
+package removemember
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmd/org/team/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemoveMemberOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org      string
+	Slug     string
+	Username string
+}
+
+func NewCmdRemoveMember(f *cmdutil.Factory, runF func(*RemoveMemberOptions) error) *cobra.Command {
+	opts := &RemoveMemberOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove-member <organization>/<team-slug> <username>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove a member from a team",
+		Example: heredoc.Doc(`
+			$ gh org team remove-member my-org/my-team monalisa
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			org, slug, err := shared.ParseSlug(args[0])
+			if err != nil {
+				return err
+			}
+			opts.Org = org
+			opts.Slug = slug
+			opts.Username = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return removeMemberRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func removeMemberRun(opts *RemoveMemberOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	if err := shared.RemoveTeamMember(httpClient, host, opts.Org, opts.Slug, opts.Username); err != nil {
+		shared.PrintError(opts.IO.ErrOut, err)
+		return cmdutil.SilentError
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Removed %s from %s/%s\n", cs.SuccessIcon(), opts.Username, opts.Org, opts.Slug)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package removemember
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdRemoveMember(t *testing.T) {
+	f := &cmdutil.Factory{}
+
+	argv, err := shlex.Split("my-org/my-team monalisa")
+	require.NoError(t, err)
+
+	var gotOpts *RemoveMemberOptions
+	cmd := NewCmdRemoveMember(f, func(opts *RemoveMemberOptions) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetArgs(argv)
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "my-org", gotOpts.Org)
+	assert.Equal(t, "my-team", gotOpts.Slug)
+	assert.Equal(t, "monalisa", gotOpts.Username)
+}
+
+func TestRemoveMemberRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("DELETE", "orgs/my-org/teams/my-team/memberships/monalisa"),
+		httpmock.StatusStringResponse(204, ""),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &RemoveMemberOptions{
+		IO: ios,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Org:      "my-org",
+		Slug:     "my-team",
+		Username: "monalisa",
+	}
+
+	err := removeMemberRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Removed monalisa from my-org/my-team\n", stdout.String())
+}
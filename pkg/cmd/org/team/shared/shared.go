@@ -0,0 +1,236 @@
+// Package shared holds the REST API plumbing and types common to the
+// `gh org team` subcommands.
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	ghAPI "github.com/cli/go-gh/v2/pkg/api"
+)
+
+type Team struct {
+	ID          int    `json:"id"`
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Privacy     string `json:"privacy"`
+	Permission  string `json:"permission"`
+}
+
+func (t *Team) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = t.ID
+		case "slug":
+			data[f] = t.Slug
+		case "name":
+			data[f] = t.Name
+		case "description":
+			data[f] = t.Description
+		case "privacy":
+			data[f] = t.Privacy
+		case "permission":
+			data[f] = t.Permission
+		}
+	}
+	return data
+}
+
+type Member struct {
+	Login string `json:"login"`
+	ID    int    `json:"id"`
+}
+
+func (m *Member) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "login":
+			data[f] = m.Login
+		case "id":
+			data[f] = m.ID
+		}
+	}
+	return data
+}
+
+// ParseSlug splits an "org/team-slug" argument into its parts.
+func ParseSlug(arg string) (org, slug string, err error) {
+	parts := strings.SplitN(arg, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected the value for team to be formatted as \"ORG/TEAM-SLUG\"")
+	}
+	return parts[0], parts[1], nil
+}
+
+// ListTeams returns every team in an organization.
+func ListTeams(httpClient *http.Client, host, org string) ([]Team, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("orgs/%s/teams?per_page=100", org)
+
+	var teams []Team
+	for path != "" {
+		var page []Team
+		var err error
+		path, err = apiClient.RESTWithNext(host, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, page...)
+	}
+	return teams, nil
+}
+
+// ListTeamMembers returns the members of a team directly, optionally
+// filtered by role. When inherited is true, members of every descendant
+// team are included as well, deduplicated by login.
+func ListTeamMembers(httpClient *http.Client, host, org, slug, role string, inherited bool) ([]Member, error) {
+	members, err := listTeamMembers(httpClient, host, org, slug, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inherited {
+		return members, nil
+	}
+
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		seen[m.Login] = true
+	}
+
+	childTeams, err := listChildTeams(httpClient, host, org, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range childTeams {
+		childMembers, err := ListTeamMembers(httpClient, host, org, child.Slug, role, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range childMembers {
+			if !seen[m.Login] {
+				seen[m.Login] = true
+				members = append(members, m)
+			}
+		}
+	}
+
+	return members, nil
+}
+
+func listTeamMembers(httpClient *http.Client, host, org, slug, role string) ([]Member, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("orgs/%s/teams/%s/members?per_page=100", org, slug)
+	if role != "" {
+		path += "&role=" + role
+	}
+
+	var members []Member
+	for path != "" {
+		var page []Member
+		var err error
+		path, err = apiClient.RESTWithNext(host, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+	}
+	return members, nil
+}
+
+func listChildTeams(httpClient *http.Client, host, org, slug string) ([]Team, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("orgs/%s/teams/%s/teams?per_page=100", org, slug)
+
+	var children []Team
+	for path != "" {
+		var page []Team
+		var err error
+		path, err = apiClient.RESTWithNext(host, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, page...)
+	}
+	return children, nil
+}
+
+// MembershipState reports whether a user is already a member of a team and,
+// if so, with which role. A not-found response is not treated as an error.
+func MembershipState(httpClient *http.Client, host, org, slug, username string) (found bool, role string, err error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var membership struct {
+		Role string `json:"role"`
+	}
+	err = apiClient.REST(host, "GET", fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, username), nil, &membership)
+	if err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+	return true, membership.Role, nil
+}
+
+// AddTeamMember adds a user to a team with the given role, or updates their
+// role if they are already a member. The membership API is idempotent:
+// calling it for an existing member simply updates (or confirms) their role.
+func AddTeamMember(httpClient *http.Client, host, org, slug, username, role string) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	body := strings.NewReader(fmt.Sprintf(`{"role":%q}`, role))
+	var membership struct {
+		State string `json:"state"`
+	}
+	return apiClient.REST(host, "PUT", fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, username), body, &membership)
+}
+
+// RemoveTeamMember removes a user from a team. GitHub returns a successful
+// response even when the user was not a member, so this is idempotent too.
+func RemoveTeamMember(httpClient *http.Client, host, org, slug, username string) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	return apiClient.REST(host, "DELETE", fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", org, slug, username), nil, nil)
+}
+
+// PrintError writes err to w, along with a hint about missing OAuth scopes
+// when the underlying error is an HTTP error caused by insufficient token
+// permissions.
+func PrintError(w io.Writer, err error) {
+	fmt.Fprintln(w, "error:", err)
+	if msg := scopesSuggestion(err); msg != "" {
+		fmt.Fprintln(w, msg)
+	}
+}
+
+// scopesSuggestion extracts a missing-OAuth-scope hint out of err, if any.
+// The teams REST endpoints are reached via Client.RESTWithNext, whose
+// underlying library returns the go-gh HTTP error directly rather than the
+// api.HTTPError wrapper that carries this hint, so it's recomputed here from
+// the same response headers api.HTTPError would have used.
+func scopesSuggestion(err error) string {
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.ScopesSuggestion()
+	}
+
+	var ghErr *ghAPI.HTTPError
+	if errors.As(err, &ghErr) {
+		resp := &http.Response{
+			StatusCode: ghErr.StatusCode,
+			Header:     ghErr.Headers,
+			Request:    &http.Request{URL: ghErr.RequestURL},
+		}
+		return api.ScopesSuggestion(resp)
+	}
+
+	return ""
+}
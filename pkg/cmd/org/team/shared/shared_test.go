@@ -0,0 +1,144 @@
+package shared
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSlug(t *testing.T) {
+	tests := []struct {
+		arg      string
+		wantOrg  string
+		wantSlug string
+		wantErr  string
+	}{
+		{arg: "my-org/my-team", wantOrg: "my-org", wantSlug: "my-team"},
+		{arg: "my-team", wantErr: `expected the value for team to be formatted as "ORG/TEAM-SLUG"`},
+		{arg: "my-org/", wantErr: `expected the value for team to be formatted as "ORG/TEAM-SLUG"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			org, slug, err := ParseSlug(tt.arg)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOrg, org)
+			assert.Equal(t, tt.wantSlug, slug)
+		})
+	}
+}
+
+func TestListTeamMembers_inherited(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/parent/members"),
+		httpmock.JSONResponse([]Member{{Login: "monalisa", ID: 1}}),
+	)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/parent/teams"),
+		httpmock.JSONResponse([]Team{{Slug: "child"}}),
+	)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/child/members"),
+		httpmock.JSONResponse([]Member{{Login: "monalisa", ID: 1}, {Login: "hubot", ID: 2}}),
+	)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/child/teams"),
+		httpmock.JSONResponse([]Team{}),
+	)
+	httpClient := &http.Client{Transport: reg}
+
+	members, err := ListTeamMembers(httpClient, "github.com", "my-org", "parent", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, []Member{
+		{Login: "monalisa", ID: 1},
+		{Login: "hubot", ID: 2},
+	}, members)
+}
+
+func TestListTeamMembers_notInherited(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/teams/parent/members"),
+		httpmock.JSONResponse([]Member{{Login: "monalisa", ID: 1}}),
+	)
+	httpClient := &http.Client{Transport: reg}
+
+	members, err := ListTeamMembers(httpClient, "github.com", "my-org", "parent", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, []Member{{Login: "monalisa", ID: 1}}, members)
+}
+
+func TestPrintError_scopesSuggestion(t *testing.T) {
+	responder := httpmock.WithHeader(
+		httpmock.WithHeader(
+			httpmock.StatusJSONResponse(403, map[string]string{"message": "Must have admin rights to Organization."}),
+			"X-Accepted-Oauth-Scopes", "admin:org",
+		),
+		"X-Oauth-Scopes", "repo",
+	)
+
+	reg := &httpmock.Registry{}
+	reg.Register(httpmock.REST("GET", "orgs/my-org/teams/my-team/members"), responder)
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := listTeamMembers(httpClient, "github.com", "my-org", "my-team", "")
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	PrintError(&buf, err)
+	assert.Contains(t, buf.String(), `This API operation needs the "admin:org" scope.`)
+}
+
+func TestMembershipState(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantFound bool
+		wantRole  string
+		wantErr   bool
+	}{
+		{
+			name:      "existing member",
+			status:    200,
+			body:      `{"role": "maintainer"}`,
+			wantFound: true,
+			wantRole:  "maintainer",
+		},
+		{
+			name:      "not a member",
+			status:    404,
+			body:      `{"message": "Not Found"}`,
+			wantFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			reg.Register(
+				httpmock.REST("GET", "orgs/my-org/teams/my-team/memberships/monalisa"),
+				httpmock.StatusStringResponse(tt.status, tt.body),
+			)
+			httpClient := &http.Client{Transport: reg}
+
+			found, role, err := MembershipState(httpClient, "github.com", "my-org", "my-team", "monalisa")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantRole, role)
+		})
+	}
+}
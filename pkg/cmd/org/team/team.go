@@ -0,0 +1,32 @@
+package team
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	orgAddMemberCmd "github.com/cli/cli/v2/pkg/cmd/org/team/addmember"
+	orgTeamListCmd "github.com/cli/cli/v2/pkg/cmd/org/team/list"
+	orgTeamMembersCmd "github.com/cli/cli/v2/pkg/cmd/org/team/members"
+	orgRemoveMemberCmd "github.com/cli/cli/v2/pkg/cmd/org/team/removemember"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTeam(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team <command>",
+		Short: "Manage teams within an organization",
+		Long:  "Work with GitHub teams and their membership.",
+		Example: heredoc.Doc(`
+			$ gh org team list my-org
+			$ gh org team members my-org/my-team
+		`),
+	}
+
+	cmdutil.AddGroup(cmd, "General commands",
+		orgTeamListCmd.NewCmdList(f, nil),
+		orgTeamMembersCmd.NewCmdMembers(f, nil),
+		orgAddMemberCmd.NewCmdAddMember(f, nil),
+		orgRemoveMemberCmd.NewCmdRemoveMember(f, nil),
+	)
+
+	return cmd
+}
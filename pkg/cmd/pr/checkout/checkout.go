@@ -24,6 +24,7 @@ type CheckoutOptions struct {
 	IO         *iostreams.IOStreams
 	Remotes    func() (cliContext.Remotes, error)
 	Branch     func() (string, error)
+	Prompter   shared.Prompt
 
 	Finder shared.PRFinder
 
@@ -42,12 +43,38 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 		Config:     f.Config,
 		Remotes:    f.Remotes,
 		Branch:     f.Branch,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
 		Use:   "checkout {<number> | <url> | <branch>}",
 		Short: "Check out a pull request in git",
 		Args:  cmdutil.ExactArgs(1, "argument required"),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			results := f.GitClient.TrackingBranchNames(context.Background(), toComplete)
+
+			cfg, err := f.Config()
+			if err != nil || cfg.CompletionNetwork("") == "disabled" {
+				return results, cobra.ShellCompDirectiveNoFileComp
+			}
+			baseRepo, err := f.BaseRepo()
+			if err != nil {
+				return results, cobra.ShellCompDirectiveNoFileComp
+			}
+			httpClient, err := f.HttpClient()
+			if err != nil {
+				return results, cobra.ShellCompDirectiveNoFileComp
+			}
+			prs, err := shared.PullRequestsForCompletion(httpClient, baseRepo)
+			if err != nil {
+				return results, cobra.ShellCompDirectiveNoFileComp
+			}
+			return append(results, prs...), cobra.ShellCompDirectiveNoFileComp
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
 
@@ -107,10 +134,20 @@ func checkoutRun(opts *CheckoutOptions) error {
 		return fmt.Errorf("invalid branch name: %q", pr.HeadRefName)
 	}
 
+	branchTemplate := cfg.PRCheckoutBranchTemplate(baseRepo.RepoHost())
+
 	var cmdQueue [][]string
 
 	if headRemote != nil {
-		cmdQueue = append(cmdQueue, cmdsForExistingRemote(headRemote, pr, opts)...)
+		localBranch, err := opts.resolveLocalBranchName(pr, branchTemplate, pr.HeadRefName)
+		if err != nil {
+			return err
+		}
+		existingRemoteCmds, err := cmdsForExistingRemote(headRemote, pr, localBranch, opts)
+		if err != nil {
+			return err
+		}
+		cmdQueue = append(cmdQueue, existingRemoteCmds...)
 	} else {
 		httpClient, err := opts.HttpClient()
 		if err != nil {
@@ -122,7 +159,21 @@ func checkoutRun(opts *CheckoutOptions) error {
 		if err != nil {
 			return err
 		}
-		cmdQueue = append(cmdQueue, cmdsForMissingRemote(pr, baseURLOrName, baseRepo.RepoHost(), defaultBranch, protocol, opts)...)
+
+		fallback := pr.HeadRefName
+		if fallback == defaultBranch {
+			// avoid naming the new branch the same as the default branch
+			fallback = fmt.Sprintf("%s/%s", pr.HeadRepositoryOwner.Login, fallback)
+		}
+		localBranch, err := opts.resolveLocalBranchName(pr, branchTemplate, fallback)
+		if err != nil {
+			return err
+		}
+		missingRemoteCmds, err := cmdsForMissingRemote(pr, baseURLOrName, baseRepo.RepoHost(), protocol, localBranch, opts)
+		if err != nil {
+			return err
+		}
+		cmdQueue = append(cmdQueue, missingRemoteCmds...)
 	}
 
 	if opts.RecurseSubmodules {
@@ -138,7 +189,7 @@ func checkoutRun(opts *CheckoutOptions) error {
 	return nil
 }
 
-func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, opts *CheckoutOptions) [][]string {
+func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, localBranch string, opts *CheckoutOptions) ([][]string, error) {
 	var cmds [][]string
 	remoteBranch := fmt.Sprintf("%s/%s", remote.Name, pr.HeadRefName)
 
@@ -149,17 +200,15 @@ func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, opts
 
 	cmds = append(cmds, []string{"fetch", remote.Name, refSpec})
 
-	localBranch := pr.HeadRefName
-	if opts.BranchName != "" {
-		localBranch = opts.BranchName
-	}
-
 	switch {
 	case opts.Detach:
 		cmds = append(cmds, []string{"checkout", "--detach", "FETCH_HEAD"})
 	case localBranchExists(opts.GitClient, localBranch):
 		cmds = append(cmds, []string{"checkout", localBranch})
 		if opts.Force {
+			if err := opts.confirmForceReset(localBranch); err != nil {
+				return nil, err
+			}
 			cmds = append(cmds, []string{"reset", "--hard", fmt.Sprintf("refs/remotes/%s", remoteBranch)})
 		} else {
 			// TODO: check if non-fast-forward and suggest to use `--force`
@@ -169,25 +218,17 @@ func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, opts
 		cmds = append(cmds, []string{"checkout", "-b", localBranch, "--track", remoteBranch})
 	}
 
-	return cmds
+	return cmds, nil
 }
 
-func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultBranch, protocol string, opts *CheckoutOptions) [][]string {
+func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, protocol, localBranch string, opts *CheckoutOptions) ([][]string, error) {
 	var cmds [][]string
 	ref := fmt.Sprintf("refs/pull/%d/head", pr.Number)
 
 	if opts.Detach {
 		cmds = append(cmds, []string{"fetch", baseURLOrName, ref})
 		cmds = append(cmds, []string{"checkout", "--detach", "FETCH_HEAD"})
-		return cmds
-	}
-
-	localBranch := pr.HeadRefName
-	if opts.BranchName != "" {
-		localBranch = opts.BranchName
-	} else if pr.HeadRefName == defaultBranch {
-		// avoid naming the new branch the same as the default branch
-		localBranch = fmt.Sprintf("%s/%s", pr.HeadRepositoryOwner.Login, localBranch)
+		return cmds, nil
 	}
 
 	currentBranch, _ := opts.Branch()
@@ -195,6 +236,9 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 		// PR head matches currently checked out branch
 		cmds = append(cmds, []string{"fetch", baseURLOrName, ref})
 		if opts.Force {
+			if err := opts.confirmForceReset(localBranch); err != nil {
+				return nil, err
+			}
 			cmds = append(cmds, []string{"reset", "--hard", "FETCH_HEAD"})
 		} else {
 			// TODO: check if non-fast-forward and suggest to use `--force`
@@ -202,6 +246,11 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 		}
 	} else {
 		if opts.Force {
+			if opts.IO.CanPrompt() && localBranchExists(opts.GitClient, localBranch) {
+				if err := opts.confirmForceReset(localBranch); err != nil {
+					return nil, err
+				}
+			}
 			cmds = append(cmds, []string{"fetch", baseURLOrName, fmt.Sprintf("%s:%s", ref, localBranch), "--force"})
 		} else {
 			// TODO: check if non-fast-forward and suggest to use `--force`
@@ -227,7 +276,80 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 		cmds = append(cmds, []string{"config", fmt.Sprintf("branch.%s.merge", localBranch), mergeRef})
 	}
 
-	return cmds
+	return cmds, nil
+}
+
+// confirmForceReset asks the user to confirm before a --force checkout discards the
+// current state of localBranch. It is a no-op when the session can't prompt, since
+// checkoutRun still has to behave predictably in scripts and CI.
+func (opts *CheckoutOptions) confirmForceReset(localBranch string) error {
+	if !opts.IO.CanPrompt() {
+		return nil
+	}
+
+	cont, err := opts.Prompter.Confirm(fmt.Sprintf("Local branch %q will be reset to match the pull request, discarding any local changes. Continue?", localBranch), false)
+	if err != nil {
+		return err
+	}
+	if !cont {
+		return cmdutil.CancelError
+	}
+
+	return nil
+}
+
+// resolveLocalBranchName determines the name of the local branch that the pull request
+// should be checked out to. An explicit --branch flag always wins. Otherwise, for pull
+// requests from forks, a configured pr_checkout_branch_template is rendered in place of
+// fallback. If the resulting name collides with an unrelated local branch, the user is
+// prompted (or, with --force, the existing branch is reused as-is) to pick a free name.
+func (opts *CheckoutOptions) resolveLocalBranchName(pr *api.PullRequest, template, fallback string) (string, error) {
+	if opts.BranchName != "" {
+		return opts.BranchName, nil
+	}
+
+	localBranch := fallback
+	templated := false
+	if template != "" && pr.IsCrossRepository {
+		localBranch = renderBranchTemplate(template, pr)
+		templated = true
+	}
+
+	if !templated || opts.Detach || opts.Force || !localBranchExists(opts.GitClient, localBranch) {
+		return localBranch, nil
+	}
+
+	if !opts.IO.CanPrompt() {
+		return nextFreeBranchName(opts.GitClient, localBranch), nil
+	}
+
+	cont, err := opts.Prompter.Confirm(fmt.Sprintf("Local branch %q already exists; check out the pull request under a different name?", localBranch), true)
+	if err != nil {
+		return "", err
+	}
+	if !cont {
+		return "", fmt.Errorf("local branch %q already exists; use `--force` to overwrite it or `--branch` to choose a different name", localBranch)
+	}
+
+	return nextFreeBranchName(opts.GitClient, localBranch), nil
+}
+
+func renderBranchTemplate(template string, pr *api.PullRequest) string {
+	replacer := strings.NewReplacer(
+		"{number}", fmt.Sprintf("%d", pr.Number),
+		"{head}", pr.HeadRefName,
+		"{owner}", pr.HeadRepositoryOwner.Login,
+	)
+	return replacer.Replace(template)
+}
+
+func nextFreeBranchName(client *git.Client, base string) string {
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", base, i)
+		if !localBranchExists(client, candidate) {
+			return candidate
+		}
+	}
 }
 
 func missingMergeConfigForBranch(client *git.Client, b string) bool {
@@ -13,6 +13,7 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -64,6 +65,7 @@ func Test_checkoutRun(t *testing.T) {
 	tests := []struct {
 		name       string
 		opts       *CheckoutOptions
+		canPrompt  bool
 		httpStubs  func(*httpmock.Registry)
 		runStubs   func(*run.CommandStubber)
 		remotes    map[string]string
@@ -157,12 +159,177 @@ func Test_checkoutRun(t *testing.T) {
 				cs.Register(`git config branch\.foobar\.merge refs/heads/feature`, 0, "")
 			},
 		},
+		{
+			name: "fork repo with branch template",
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "hubot/REPO:feature")
+					pr.MaintainerCanModify = true
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewFromString("pr_checkout_branch_template: pr/{number}-{head}"), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git show-ref --verify -- refs/heads/pr/123-feature`, 1, "")
+				cs.Register(`git fetch origin refs/pull/123/head:pr/123-feature`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.merge`, 1, "")
+				cs.Register(`git checkout pr/123-feature`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.remote https://github\.com/hubot/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.pushRemote https://github\.com/hubot/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.merge refs/heads/feature`, 0, "")
+			},
+		},
+		{
+			name: "fork repo with branch template collision and --force",
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				Force:       true,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "hubot/REPO:feature")
+					pr.MaintainerCanModify = true
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewFromString("pr_checkout_branch_template: pr/{number}-{head}"), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git fetch origin refs/pull/123/head:pr/123-feature --force`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.merge`, 1, "")
+				cs.Register(`git checkout pr/123-feature`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.remote https://github\.com/hubot/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.pushRemote https://github\.com/hubot/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature\.merge refs/heads/feature`, 0, "")
+			},
+		},
+		{
+			name:      "--force prompts for confirmation before resetting an existing local branch",
+			canPrompt: true,
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				Force:       true,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+				Prompter: &prompter.PrompterMock{
+					ConfirmFunc: func(string, bool) (bool, error) {
+						return true, nil
+					},
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
+				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature`, 0, "")
+				cs.Register(`git checkout feature`, 0, "")
+				cs.Register(`git reset --hard refs/remotes/origin/feature`, 0, "")
+			},
+		},
+		{
+			name:      "--force checkout is cancelled when confirmation is declined",
+			canPrompt: true,
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				Force:       true,
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+				Prompter: &prompter.PrompterMock{
+					ConfirmFunc: func(string, bool) (bool, error) {
+						return false, nil
+					},
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
+			},
+			wantErr: true,
+		},
+		{
+			name:      "fork repo with branch template collision prompts for a new name",
+			canPrompt: true,
+			opts: &CheckoutOptions{
+				SelectorArg: "123",
+				Finder: func() shared.PRFinder {
+					baseRepo, pr := stubPR("OWNER/REPO:master", "hubot/REPO:feature")
+					pr.MaintainerCanModify = true
+					finder := shared.NewMockFinder("123", pr, baseRepo)
+					return finder
+				}(),
+				Config: func() (config.Config, error) {
+					return config.NewFromString("pr_checkout_branch_template: pr/{number}-{head}"), nil
+				},
+				Branch: func() (string, error) {
+					return "main", nil
+				},
+				Prompter: &prompter.PrompterMock{
+					ConfirmFunc: func(string, bool) (bool, error) {
+						return true, nil
+					},
+				},
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git show-ref --verify -- refs/heads/pr/123-feature`, 0, "")
+				cs.Register(`git show-ref --verify -- refs/heads/pr/123-feature-2`, 1, "")
+				cs.Register(`git fetch origin refs/pull/123/head:pr/123-feature-2`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature-2\.merge`, 1, "")
+				cs.Register(`git checkout pr/123-feature-2`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature-2\.remote https://github\.com/hubot/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature-2\.pushRemote https://github\.com/hubot/REPO\.git`, 0, "")
+				cs.Register(`git config branch\.pr/123-feature-2\.merge refs/heads/feature`, 0, "")
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := tt.opts
 
 			ios, _, stdout, stderr := iostreams.Test()
+			if tt.canPrompt {
+				ios.SetStdinTTY(true)
+				ios.SetStdoutTTY(true)
+			}
 			opts.IO = ios
 			httpReg := &httpmock.Registry{}
 			defer httpReg.Verify(t)
@@ -42,7 +42,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 				selector = args[0]
 			}
 			fields := []string{"id", "url"}
-			if opts.EditLast {
+			if opts.EditLast || opts.CreateIfNone {
 				fields = append(fields, "comments")
 			}
 			finder := shared.NewFinder(f)
@@ -75,6 +75,8 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 	cmd.Flags().BoolP("editor", "e", false, "Skip prompts and open the text editor to write the body in")
 	cmd.Flags().BoolP("web", "w", false, "Open the web browser to write the comment")
 	cmd.Flags().BoolVar(&opts.EditLast, "edit-last", false, "Edit the last comment of the same author")
+	cmd.Flags().BoolVar(&opts.CreateIfNone, "create-if-none", false, "Edit the last matching comment, or create a new one if none exists")
+	cmd.Flags().StringVar(&opts.Marker, "marker", "", "Identify the comment to edit or create using a hidden `string` marker")
 
 	return cmd
 }
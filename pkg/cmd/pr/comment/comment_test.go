@@ -159,6 +159,18 @@ func TestNewCmdComment(t *testing.T) {
 			output:   shared.CommentableOptions{},
 			wantsErr: true,
 		},
+		{
+			name:  "create-if-none with marker",
+			input: "1 --body test --create-if-none --marker gh-ci-status",
+			output: shared.CommentableOptions{
+				Interactive:  false,
+				InputType:    shared.InputTypeInline,
+				Body:         "test",
+				CreateIfNone: true,
+				Marker:       "gh-ci-status",
+			},
+			wantsErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -202,6 +214,8 @@ func TestNewCmdComment(t *testing.T) {
 			assert.Equal(t, tt.output.Interactive, gotOpts.Interactive)
 			assert.Equal(t, tt.output.InputType, gotOpts.InputType)
 			assert.Equal(t, tt.output.Body, gotOpts.Body)
+			assert.Equal(t, tt.output.CreateIfNone, gotOpts.CreateIfNone)
+			assert.Equal(t, tt.output.Marker, gotOpts.Marker)
 		})
 	}
 }
@@ -359,6 +373,90 @@ func Test_commentRun(t *testing.T) {
 	}
 }
 
+func Test_commentRun_createIfNone(t *testing.T) {
+	tests := []struct {
+		name      string
+		comments  []api.Comment
+		httpStubs func(*testing.T, *httpmock.Registry)
+		stdout    string
+	}{
+		{
+			name: "updates the comment matching the marker",
+			comments: []api.Comment{
+				{ID: "id1", Body: "<!-- gh-ci-status -->\nold status", URL: "https://github.com/OWNER/REPO/pull/123#issuecomment-111", ViewerDidAuthor: true},
+				{ID: "id2", Body: "unrelated comment", URL: "https://github.com/OWNER/REPO/pull/123#issuecomment-222", ViewerDidAuthor: true},
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation CommentUpdate\b`),
+					httpmock.GraphQLMutation(`
+					{ "data": { "updateIssueComment": { "issueComment": {
+						"url": "https://github.com/OWNER/REPO/pull/123#issuecomment-111"
+					} } } }`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "id1", inputs["id"])
+							assert.Equal(t, "<!-- gh-ci-status -->\ncomment body", inputs["body"])
+						}),
+				)
+			},
+			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-111\n",
+		},
+		{
+			name: "creates a comment when none match the marker",
+			comments: []api.Comment{
+				{ID: "id2", Body: "unrelated comment", URL: "https://github.com/OWNER/REPO/pull/123#issuecomment-222", ViewerDidAuthor: true},
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation CommentCreate\b`),
+					httpmock.GraphQLMutation(`
+					{ "data": { "addComment": { "commentEdge": { "node": {
+						"url": "https://github.com/OWNER/REPO/pull/123#issuecomment-456"
+					} } } } }`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "<!-- gh-ci-status -->\ncomment body", inputs["body"])
+						}),
+				)
+			},
+			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-456\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			ios.SetStdinTTY(true)
+			ios.SetStderrTTY(true)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(t, reg)
+
+			opts := &shared.CommentableOptions{
+				Interactive:  false,
+				InputType:    shared.InputTypeInline,
+				Body:         "comment body",
+				CreateIfNone: true,
+				Marker:       "gh-ci-status",
+				IO:           ios,
+				HttpClient:   func() (*http.Client, error) { return &http.Client{Transport: reg}, nil },
+				RetrieveCommentable: func() (shared.Commentable, ghrepo.Interface, error) {
+					return &api.PullRequest{
+						Number:   123,
+						URL:      "https://github.com/OWNER/REPO/pull/123",
+						Comments: api.Comments{Nodes: tt.comments},
+					}, ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := shared.CommentableRun(opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.stdout, stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
+
 func mockCommentCreate(t *testing.T, reg *httpmock.Registry) {
 	reg.Register(
 		httpmock.GraphQL(`mutation CommentCreate\b`),
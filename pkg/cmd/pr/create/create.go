@@ -191,7 +191,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			}
 
 			if !opts.IO.CanPrompt() && !opts.WebMode && !(opts.FillVerbose || opts.Autofill || opts.FillFirst) && (!opts.TitleProvided || !opts.BodyProvided) {
-				return cmdutil.FlagErrorf("must provide `--title` and `--body` (or `--fill` or `fill-first` or `--fillverbose`) when not running interactively")
+				return cmdutil.NewFlagRequiredOneOfInNonInteractiveError("--title and --body", "--fill", "--fill-first", "--fillverbose")
 			}
 
 			if opts.DryRun && opts.WebMode {
@@ -240,6 +240,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 }
 
 func createRun(opts *CreateOptions) (err error) {
+	if opts.RecoverFile != "" {
+		if err = applyRecoveredBranches(opts); err != nil {
+			return fmt.Errorf("failed to recover input: %w", err)
+		}
+	}
+
 	ctx, err := NewCreateContext(opts)
 	if err != nil {
 		return
@@ -372,6 +378,10 @@ func createRun(opts *CreateOptions) (err error) {
 		return
 	}
 
+	if rule, ruleErr := api.RepoBranchProtectionRule(client, ctx.BaseRepo, ctx.BaseBranch); ruleErr == nil && rule != nil && (rule.RequiresApprovingReviews || rule.RequiresStatusChecks) {
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s is a protected branch; you may not be able to merge directly into it\n", cs.WarningIcon(), cs.Cyan(ctx.BaseBranch))
+	}
+
 	allowPreview := !state.HasMetadata() && shared.ValidURL(openURL) && !opts.DryRun
 	allowMetadata := ctx.BaseRepo.ViewerCanTriage()
 	action, err := shared.ConfirmPRSubmission(opts.Prompter, allowPreview, allowMetadata, state.Draft)
@@ -502,6 +512,23 @@ func determineTrackingBranch(gitClient *git.Client, remotes ghContext.Remotes, h
 	return nil
 }
 
+// applyRecoveredBranches reads the base and head branches saved in a failed create's
+// recovery file, falling back to them when the corresponding flag wasn't given, so
+// that `--recover` resubmits against the same branches without repeating `--base`/`--head`.
+func applyRecoveredBranches(opts *CreateOptions) error {
+	var recovered shared.IssueMetadataState
+	if err := shared.FillFromJSON(opts.IO, opts.RecoverFile, &recovered); err != nil {
+		return err
+	}
+	if opts.BaseBranch == "" {
+		opts.BaseBranch = recovered.BaseBranch
+	}
+	if opts.HeadBranch == "" {
+		opts.HeadBranch = recovered.HeadBranch
+	}
+	return nil
+}
+
 func NewIssueState(ctx CreateContext, opts CreateOptions) (*shared.IssueMetadataState, error) {
 	var milestoneTitles []string
 	if opts.Milestone != "" {
@@ -522,6 +549,8 @@ func NewIssueState(ctx CreateContext, opts CreateOptions) (*shared.IssueMetadata
 		Projects:   opts.Projects,
 		Milestones: milestoneTitles,
 		Draft:      opts.IsDraft,
+		BaseBranch: ctx.BaseBranch,
+		HeadBranch: ctx.HeadBranchLabel,
 	}
 
 	if opts.FillVerbose || opts.Autofill || opts.FillFirst || !opts.TitleProvided || !opts.BodyProvided {
@@ -572,6 +601,9 @@ func NewCreateContext(opts *CreateOptions) (*CreateContext, error) {
 	if headBranch == "" {
 		headBranch, err = opts.Branch()
 		if err != nil {
+			if errors.Is(err, git.ErrNotOnAnyBranch) {
+				return nil, fmt.Errorf("could not determine the current branch, which is required when `--head` is not provided: %w\nuse `--head` to specify the branch that contains commits for your pull request", err)
+			}
 			return nil, fmt.Errorf("could not determine the current branch: %w", err)
 		}
 		headBranchLabel = headBranch
@@ -1106,6 +1106,61 @@ func Test_createRun(t *testing.T) {
 			expectedOut:    "https://github.com/OWNER/REPO/pull/12\n",
 			expectedErrOut: "\nCreating pull request for feature into master in OWNER/REPO\n\n",
 		},
+		{
+			name: "warns about protected base branch",
+			tty:  true,
+			setup: func(opts *CreateOptions, t *testing.T) func() {
+				opts.TitleProvided = true
+				opts.Title = "my title"
+				opts.HeadBranch = "feature"
+				return func() {}
+			},
+			httpStubs: func(reg *httpmock.Registry, t *testing.T) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestTemplates\b`),
+					httpmock.StringResponse(`{ "data": { "repository": { "pullRequestTemplates": [] } } }`),
+				)
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestBaseBranchProtection\b`),
+					httpmock.StringResponse(`
+				{ "data": { "repository": { "ref": { "branchProtectionRule": {
+					"requiresApprovingReviews": true,
+					"requiresStatusChecks": false
+				} } } } }`),
+				)
+				reg.Register(
+					httpmock.GraphQL(`mutation PullRequestCreate\b`),
+					httpmock.GraphQLMutation(`
+			{ "data": { "createPullRequest": { "pullRequest": {
+				"URL": "https://github.com/OWNER/REPO/pull/12"
+			} } } }
+			`, func(input map[string]interface{}) {
+						assert.Equal(t, "master", input["baseRefName"].(string))
+					}))
+			},
+			cmdStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature`, 0, "")
+				cs.Register(`git rev-parse --show-toplevel`, 0, "")
+			},
+			promptStubs: func(pm *prompter.PrompterMock) {
+				pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
+					if p == "Body" {
+						return d, nil
+					} else {
+						return "", prompter.NoSuchPromptErr(p)
+					}
+				}
+				pm.SelectFunc = func(p, _ string, opts []string) (int, error) {
+					if p == "What's next?" {
+						return prompter.IndexFor(opts, "Submit")
+					} else {
+						return -1, prompter.NoSuchPromptErr(p)
+					}
+				}
+			},
+			expectedOut:    "https://github.com/OWNER/REPO/pull/12\n",
+			expectedErrOut: "\nCreating pull request for feature into master in OWNER/REPO\n\n! master is a protected branch; you may not be able to merge directly into it\n",
+		},
 		{
 			name: "recover",
 			tty:  true,
@@ -1185,6 +1240,67 @@ func Test_createRun(t *testing.T) {
 			expectedOut:    "https://github.com/OWNER/REPO/pull/12\n",
 			expectedErrOut: "\nCreating pull request for feature into master in OWNER/REPO\n\n",
 		},
+		{
+			name: "recover base branch",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry, t *testing.T) {
+				reg.Register(
+					httpmock.GraphQL(`mutation PullRequestCreate\b`),
+					httpmock.GraphQLMutation(`
+			{ "data": { "createPullRequest": { "pullRequest": {
+				"URL": "https://github.com/OWNER/REPO/pull/12"
+			} } } }
+			`, func(input map[string]interface{}) {
+						assert.Equal(t, "recovered-base", input["baseRefName"].(string))
+						assert.Equal(t, "recovered title", input["title"].(string))
+					}))
+			},
+			cmdStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git( .+)? log( .+)? origin/recovered-base\.\.\.feature`, 0, "")
+			},
+			promptStubs: func(pm *prompter.PrompterMock) {
+				pm.InputFunc = func(p, d string) (string, error) {
+					if p == "Title" {
+						return d, nil
+					} else {
+						return "", prompter.NoSuchPromptErr(p)
+					}
+				}
+				pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
+					if p == "Body" {
+						return d, nil
+					} else {
+						return "", prompter.NoSuchPromptErr(p)
+					}
+				}
+				pm.SelectFunc = func(p, _ string, opts []string) (int, error) {
+					if p == "What's next?" {
+						return 0, nil
+					} else {
+						return -1, prompter.NoSuchPromptErr(p)
+					}
+				}
+			},
+			setup: func(opts *CreateOptions, t *testing.T) func() {
+				tmpfile, err := os.CreateTemp(t.TempDir(), "testrecover*")
+				assert.NoError(t, err)
+				state := shared.IssueMetadataState{
+					Title:      "recovered title",
+					Body:       "recovered body",
+					BaseBranch: "recovered-base",
+				}
+				data, err := json.Marshal(state)
+				assert.NoError(t, err)
+				_, err = tmpfile.Write(data)
+				assert.NoError(t, err)
+
+				opts.RecoverFile = tmpfile.Name()
+				opts.HeadBranch = "feature"
+				return func() { tmpfile.Close() }
+			},
+			expectedOut:    "https://github.com/OWNER/REPO/pull/12\n",
+			expectedErrOut: "\nCreating pull request for feature into recovered-base in OWNER/REPO\n\n",
+		},
 		{
 			name: "web long URL",
 			cmdStubs: func(cs *run.CommandStubber) {
@@ -1462,6 +1578,44 @@ func Test_createRun(t *testing.T) {
 	}
 }
 
+func Test_createRun_detachedHead(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.StubRepoInfoResponse("OWNER", "REPO", "master")
+	defer reg.Verify(t)
+
+	ios, _, _, _ := iostreams.Test()
+
+	opts := CreateOptions{}
+	opts.Prompter = &prompter.PrompterMock{}
+	opts.IO = ios
+	opts.Browser = &browser.Stub{}
+	opts.TitleProvided = true
+	opts.BodyProvided = true
+	opts.Title = "my title"
+	opts.Body = "my body"
+	opts.HttpClient = func() (*http.Client, error) {
+		return &http.Client{Transport: reg}, nil
+	}
+	opts.Config = func() (config.Config, error) {
+		return config.NewBlankConfig(), nil
+	}
+	opts.Remotes = func() (context.Remotes, error) {
+		return context.Remotes{
+			{
+				Remote: &git.Remote{Name: "origin", Resolved: "base"},
+				Repo:   ghrepo.New("OWNER", "REPO"),
+			},
+		}, nil
+	}
+	opts.Branch = func() (string, error) {
+		return "", git.ErrNotOnAnyBranch
+	}
+	opts.GitClient = &git.Client{GhPath: "some/path/gh", GitPath: "some/path/git"}
+
+	err := createRun(&opts)
+	assert.EqualError(t, err, "could not determine the current branch, which is required when `--head` is not provided: git: not on any branch\nuse `--head` to specify the branch that contains commits for your pull request")
+}
+
 func Test_determineTrackingBranch(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -23,11 +23,12 @@ import (
 
 func Test_NewCmdDiff(t *testing.T) {
 	tests := []struct {
-		name    string
-		args    string
-		isTTY   bool
-		want    DiffOptions
-		wantErr string
+		name       string
+		args       string
+		isTTY      bool
+		noColorEnv bool
+		want       DiffOptions
+		wantErr    string
 	}{
 		{
 			name: "name only",
@@ -63,6 +64,16 @@ func Test_NewCmdDiff(t *testing.T) {
 				UseColor:    false,
 			},
 		},
+		{
+			name:       "auto disables color when NO_COLOR is set, even on a TTY",
+			args:       "",
+			isTTY:      true,
+			noColorEnv: true,
+			want: DiffOptions{
+				SelectorArg: "",
+				UseColor:    false,
+			},
+		},
 		{
 			name:  "force color",
 			args:  "--color always",
@@ -110,7 +121,11 @@ func Test_NewCmdDiff(t *testing.T) {
 			ios.SetStdoutTTY(tt.isTTY)
 			ios.SetStdinTTY(tt.isTTY)
 			ios.SetStderrTTY(tt.isTTY)
-			ios.SetColorEnabled(tt.isTTY)
+			if tt.noColorEnv {
+				t.Setenv("NO_COLOR", "1")
+			} else {
+				ios.SetColorEnabled(tt.isTTY)
+			}
 
 			f := &cmdutil.Factory{
 				IOStreams: ios,
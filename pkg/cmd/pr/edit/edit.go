@@ -37,10 +37,10 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		IO:              f.IOStreams,
 		HttpClient:      f.HttpClient,
 		Surveyor:        surveyor{P: f.Prompter},
-		Fetcher:         fetcher{},
 		EditorRetriever: editorRetriever{config: f.Config},
 		Prompter:        f.Prompter,
 	}
+	opts.Fetcher = fetcher{interactive: func() bool { return opts.Interactive }}
 
 	var bodyFile string
 
@@ -170,9 +170,57 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		})
 	}
 
+	for _, flagName := range []string{"add-label", "remove-label"} {
+		_ = cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			baseRepo, httpClient, ok := completionDeps(f)
+			if !ok {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			results, err := shared.LabelsForCompletion(httpClient, baseRepo)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			return results, cobra.ShellCompDirectiveNoFileComp
+		})
+	}
+
+	_ = cmd.RegisterFlagCompletionFunc("milestone", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		baseRepo, httpClient, ok := completionDeps(f)
+		if !ok {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		results, err := shared.MilestonesForCompletion(httpClient, baseRepo)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return results, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	return cmd
 }
 
+// completionDeps resolves the base repository and HTTP client needed for
+// network-backed flag completion, honoring the completion_network config
+// setting. The second return value is false when completion should be skipped.
+func completionDeps(f *cmdutil.Factory) (ghrepo.Interface, *http.Client, bool) {
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, nil, false
+	}
+	if cfg.CompletionNetwork("") == "disabled" {
+		return nil, nil, false
+	}
+	baseRepo, err := f.BaseRepo()
+	if err != nil {
+		return nil, nil, false
+	}
+	httpClient, err := f.HttpClient()
+	if err != nil {
+		return nil, nil, false
+	}
+	return baseRepo, httpClient, true
+}
+
 func editRun(opts *EditOptions) error {
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
@@ -298,10 +346,19 @@ type EditableOptionsFetcher interface {
 	EditableOptionsFetch(*api.Client, ghrepo.Interface, *shared.Editable) error
 }
 
-type fetcher struct{}
+// fetcher is the default EditableOptionsFetcher. Non-interactive edits
+// already know exactly which names they need resolved, so they use the
+// cheaper, targeted lookup; interactive edits still need the full option
+// lists for prompting.
+type fetcher struct {
+	interactive func() bool
+}
 
-func (f fetcher) EditableOptionsFetch(client *api.Client, repo ghrepo.Interface, opts *shared.Editable) error {
-	return shared.FetchOptions(client, repo, opts)
+func (f fetcher) EditableOptionsFetch(client *api.Client, repo ghrepo.Interface, editable *shared.Editable) error {
+	if f.interactive != nil && !f.interactive() {
+		return shared.ResolveEditableMetadataIDs(client, repo, editable)
+	}
+	return shared.FetchOptions(client, repo, editable)
 }
 
 type EditorRetriever interface {
@@ -28,6 +28,8 @@ type ListOptions struct {
 	WebMode      bool
 	LimitResults int
 	Exporter     cmdutil.Exporter
+	ExitCode     bool
+	CacheTTL     time.Duration
 
 	State      string
 	BaseBranch string
@@ -110,6 +112,8 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with `query`")
 	cmdutil.NilBoolFlag(cmd, &opts.Draft, "draft", "d", "Filter by draft state")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
+	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the list for `duration`, e.g. \"5m\", \"1h\"")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "base", "head")
 
@@ -133,6 +137,12 @@ func listRun(opts *ListOptions) error {
 	if err != nil {
 		return err
 	}
+	var offlineStatus *api.OfflineStatus
+	if api.IsOfflineMode() {
+		httpClient, offlineStatus = api.NewOfflineHTTPClient(httpClient)
+	} else if opts.CacheTTL > 0 {
+		httpClient = api.NewCachedHTTPClient(httpClient, opts.CacheTTL)
+	}
 
 	baseRepo, err := opts.BaseRepo()
 	if err != nil {
@@ -176,8 +186,23 @@ func listRun(opts *ListOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(listResult.PullRequests) == 0 && opts.Exporter == nil {
-		return shared.ListNoResults(ghrepo.FullName(baseRepo), "pull request", !filters.IsDefault())
+	if offlineStatus != nil {
+		if servedAt, ok := offlineStatus.Served(); ok {
+			fmt.Fprintf(opts.IO.ErrOut, "! Showing results cached %s (offline mode)\n", text.FuzzyAgo(opts.Now(), servedAt))
+		}
+	}
+	if len(listResult.PullRequests) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			return shared.ListNoResults(ghrepo.FullName(baseRepo), "pull request", !filters.IsDefault())
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(opts.IO, listResult.PullRequests); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
+		}
 	}
 
 	err = opts.IO.StartPager()
@@ -217,8 +242,12 @@ func listRun(opts *ListOptions) error {
 		if isTTY {
 			prNum = "#" + prNum
 		}
+		prColor := cs.ColorFromString(shared.ColorForPRState(pr))
+		prURL := pr.URL
 
-		table.AddField(prNum, tableprinter.WithColor(cs.ColorFromString(shared.ColorForPRState(pr))))
+		table.AddField(prNum, tableprinter.WithColor(func(s string) string {
+			return cs.Hyperlink(prColor(s), prURL)
+		}))
 		table.AddField(text.RemoveExcessiveWhitespace(pr.Title))
 		table.AddField(pr.HeadLabel(), tableprinter.WithColor(cs.Cyan))
 		if !isTTY {
@@ -18,11 +18,18 @@ import (
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/test"
+	ghAPI "github.com/cli/go-gh/v2/pkg/api"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
 	ios, _, stdout, stderr := iostreams.Test()
 	ios.SetStdoutTTY(isTTY)
@@ -274,6 +281,33 @@ func TestPRList_filteringAuthor(t *testing.T) {
 	}
 }
 
+func TestPRList_exitCode(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestList\b`), httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequests": {
+			"totalCount": 0, "nodes": [], "pageInfo": { "hasNextPage": false }
+		} } } }`))
+
+	_, err := runCommand(http, true, `--exit-code`)
+	assert.Equal(t, cmdutil.SilentError, err)
+}
+
+func TestPRList_exitCode_withJSON(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestList\b`), httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequests": {
+			"totalCount": 0, "nodes": [], "pageInfo": { "hasNextPage": false }
+		} } } }`))
+
+	output, err := runCommand(http, true, `--exit-code --json number`)
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "[]\n", output.String())
+}
+
 func TestPRList_withInvalidLimitFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -281,6 +315,51 @@ func TestPRList_withInvalidLimitFlag(t *testing.T) {
 	assert.EqualError(t, err, "invalid value for --limit: 0")
 }
 
+func TestPRList_cache(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+
+	requestCount := 0
+	backend := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requestCount++
+		return &http.Response{
+			Request:    req,
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"repository":{"pullRequests":{"totalCount":1,"nodes":[{"number":1,"title":"cached PR","url":"https://github.com/OWNER/REPO/pull/1","createdAt":"2022-08-24T20:01:12Z","headRefName":"feature","state":"OPEN","isDraft":false}],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`)),
+		}, nil
+	})
+
+	// build a real gh HTTP client so the on-disk response cache that
+	// api.NewCachedHTTPClient relies on is actually wired up, same as it
+	// would be for the default factory-provided client.
+	httpClient, err := ghAPI.NewHTTPClient(ghAPI.ClientOptions{
+		Host:      "github.com",
+		AuthToken: "faketoken",
+		Transport: backend,
+		CacheDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	opts := &ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return httpClient, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Now:          time.Now,
+		State:        "open",
+		LimitResults: 30,
+		CacheTTL:     time.Minute,
+	}
+
+	require.NoError(t, listRun(opts))
+	require.NoError(t, listRun(opts))
+
+	assert.Equal(t, 1, requestCount, "second `gh pr list --cache` within the TTL should be served from the response cache")
+}
+
 func TestPRList_web(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -39,12 +40,35 @@ type mergePayload struct {
 	authorEmail     string
 }
 
+// MergeResult describes the outcome of a successful, non-queued merge mutation.
+type MergeResult struct {
+	URL            string `json:"url"`
+	MergeCommitOID string `json:"mergeCommitOid"`
+	Verified       bool   `json:"verified"`
+}
+
+var mergeResultFields = []string{"url", "mergeCommitOid", "verified"}
+
+func (r *MergeResult) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
 // TODO: drop after githubv4 gets updated
 type EnablePullRequestAutoMergeInput struct {
 	githubv4.MergePullRequestInput
 }
 
-func mergePullRequest(client *http.Client, payload mergePayload) error {
+// headMismatchErrorMessage is the text GitHub returns when expectedHeadOid
+// no longer matches the pull request's current head, i.e. the branch moved
+// in the time between the caller checking the SHA and the merge mutation running.
+const headMismatchErrorMessage = "Head branch was modified. Review and try the merge again."
+
+// isHeadMismatchError reports whether err is the expectedHeadOid rejection above.
+func isHeadMismatchError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), headMismatchErrorMessage)
+}
+
+func mergePullRequest(client *http.Client, payload mergePayload) (*MergeResult, error) {
 	input := githubv4.MergePullRequestInput{
 		PullRequestID: githubv4.ID(payload.pullRequestID),
 	}
@@ -92,15 +116,56 @@ func mergePullRequest(client *http.Client, payload mergePayload) error {
 			} `graphql:"enablePullRequestAutoMerge(input: $input)"`
 		}
 		variables["input"] = EnablePullRequestAutoMergeInput{input}
-		return gql.Mutate(payload.repo.RepoHost(), "PullRequestAutoMerge", &mutation, variables)
+		err := gql.Mutate(payload.repo.RepoHost(), "PullRequestAutoMerge", &mutation, variables)
+		return nil, err
 	}
 
 	var mutation struct {
 		MergePullRequest struct {
 			ClientMutationId string
+			PullRequest      struct {
+				URL         string `graphql:"url"`
+				MergeCommit struct {
+					OID       string `graphql:"oid"`
+					Signature struct {
+						IsValid bool
+					}
+				}
+			}
 		} `graphql:"mergePullRequest(input: $input)"`
 	}
-	return gql.Mutate(payload.repo.RepoHost(), "PullRequestMerge", &mutation, variables)
+	if err := gql.Mutate(payload.repo.RepoHost(), "PullRequestMerge", &mutation, variables); err != nil {
+		return nil, err
+	}
+
+	mergeCommit := mutation.MergePullRequest.PullRequest.MergeCommit
+	return &MergeResult{
+		URL:            mutation.MergePullRequest.PullRequest.URL,
+		MergeCommitOID: mergeCommit.OID,
+		Verified:       mergeCommit.Signature.IsValid,
+	}, nil
+}
+
+// pullRequestHeadOid looks up the pull request's current head commit, used to build a
+// retry hint after a merge is rejected for no longer matching --match-head-commit.
+func pullRequestHeadOid(client *http.Client, repo ghrepo.Interface, number int) (string, error) {
+	var query struct {
+		Repository struct {
+			PullRequest struct {
+				HeadRefOid string `graphql:"headRefOid"`
+			} `graphql:"pullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(repo.RepoOwner()),
+		"name":   githubv4.String(repo.RepoName()),
+		"number": githubv4.Int(number),
+	}
+
+	gql := api.NewClientFromHTTP(client)
+	err := gql.Query(repo.RepoHost(), "PullRequestHeadRefOid", &query, variables)
+	return query.Repository.PullRequest.HeadRefOid, err
 }
 
 func disableAutoMerge(client *http.Client, repo ghrepo.Interface, prID string) error {
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -12,8 +16,10 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/findsh"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/surveyext"
 	"github.com/spf13/cobra"
@@ -47,11 +53,17 @@ type MergeOptions struct {
 	Subject string
 	Editor  editor
 
+	SquashTitleFromPR bool
+	SquashBodyFromPR  bool
+
 	UseAdmin                bool
 	IsDeleteBranchIndicated bool
 	CanDeleteLocalBranch    bool
 	MergeStrategyEmpty      bool
 	MatchHeadCommit         string
+	PostMergeHook           string
+
+	Exporter cmdutil.Exporter
 }
 
 // ErrAlreadyInMergeQueue indicates that the pull request is already in a merge queue
@@ -143,6 +155,26 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 				return err
 			}
 
+			if opts.SquashTitleFromPR || opts.SquashBodyFromPR {
+				if !flagSquash {
+					return cmdutil.FlagErrorf("`--squash-title-from-pr` and `--squash-body-from-pr` can only be used with `--squash`")
+				}
+				if err := cmdutil.MutuallyExclusive(
+					"specify only one of `--subject` or `--squash-title-from-pr`",
+					opts.Subject != "",
+					opts.SquashTitleFromPR,
+				); err != nil {
+					return err
+				}
+				if err := cmdutil.MutuallyExclusive(
+					"specify only one of `--body`/`--body-file` or `--squash-body-from-pr`",
+					bodyProvided || bodyFileProvided,
+					opts.SquashBodyFromPR,
+				); err != nil {
+					return err
+				}
+			}
+
 			if bodyProvided || bodyFileProvided {
 				opts.BodySet = true
 				if bodyFileProvided {
@@ -179,13 +211,54 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVarP(&flagMerge, "merge", "m", false, "Merge the commits with the base branch")
 	cmd.Flags().BoolVarP(&flagRebase, "rebase", "r", false, "Rebase the commits onto the base branch")
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
+	cmd.Flags().BoolVar(&opts.SquashTitleFromPR, "squash-title-from-pr", false, "Use the pull request title and number as the squash commit subject")
+	cmd.Flags().BoolVar(&opts.SquashBodyFromPR, "squash-body-from-pr", false, "Use the pull request body as the squash commit body")
 	cmd.Flags().BoolVar(&opts.AutoMergeEnable, "auto", false, "Automatically merge only after necessary requirements are met")
 	cmd.Flags().BoolVar(&opts.AutoMergeDisable, "disable-auto", false, "Disable auto-merge for this pull request")
 	cmd.Flags().StringVar(&opts.MatchHeadCommit, "match-head-commit", "", "Commit `SHA` that the pull request head must match to allow merge")
 	cmd.Flags().StringVarP(&opts.AuthorEmail, "author-email", "A", "", "Email `text` for merge commit author")
+	cmd.Flags().StringVar(&opts.PostMergeHook, "post-merge-hook", "", "Command `cmd` to run after a successful merge; GH_PR_NUMBER and GH_MERGE_SHA are set in its environment")
+	// cmdutil.AddJSONFlags isn't used here: it binds `--template` to the `-t` shorthand,
+	// which `--subject` already owns on this command. `--json` itself still works; only
+	// `--jq`/`--template`/`--jsonl` are unavailable until that conflict is resolved.
+	cmd.Flags().StringSlice("json", nil, "Output JSON with the specified `fields`")
+	_ = cmd.RegisterFlagCompletionFunc("json", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return mergeResultFields, cobra.ShellCompDirectiveNoSpace
+	})
+	oldPreRun := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if oldPreRun != nil {
+			if err := oldPreRun(c, args); err != nil {
+				return err
+			}
+		}
+		jsonFields, err := c.Flags().GetStringSlice("json")
+		if err != nil || len(jsonFields) == 0 {
+			return err
+		}
+		for _, f := range jsonFields {
+			if !stringSliceContains(mergeResultFields, f) {
+				sort.Strings(mergeResultFields)
+				return cmdutil.FlagErrorf("Unknown JSON field: %q\nAvailable fields:\n  %s", f, strings.Join(mergeResultFields, "\n  "))
+			}
+		}
+		exporter := cmdutil.NewJSONExporter()
+		exporter.SetFields(jsonFields)
+		opts.Exporter = exporter
+		return nil
+	}
 	return cmd
 }
 
+func stringSliceContains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
 // mergeContext contains state and dependencies to merge a pull request.
 type mergeContext struct {
 	pr                 *api.PullRequest
@@ -288,6 +361,14 @@ func (m *mergeContext) merge() error {
 		authorEmail:     m.opts.AuthorEmail,
 	}
 
+	if m.opts.SquashTitleFromPR {
+		payload.commitSubject = fmt.Sprintf("%s (#%d)", m.pr.Title, m.pr.Number)
+	}
+	if m.opts.SquashBodyFromPR {
+		payload.commitBody = m.pr.Body
+		payload.setCommitBody = true
+	}
+
 	if m.shouldAddToMergeQueue() {
 		if !m.opts.MergeStrategyEmpty {
 			// only warn for now
@@ -338,8 +419,11 @@ func (m *mergeContext) merge() error {
 		}
 	}
 
-	err := mergePullRequest(m.httpClient, payload)
+	result, err := mergePullRequest(m.httpClient, payload)
 	if err != nil {
+		if isHeadMismatchError(err) {
+			return m.headMismatchError()
+		}
 		return err
 	}
 
@@ -366,7 +450,67 @@ func (m *mergeContext) merge() error {
 	case PullRequestMergeMethodSquash:
 		action = "Squashed and merged"
 	}
-	return m.infof("%s %s pull request %s#%d (%s)\n", m.cs.SuccessIconWithColor(m.cs.Magenta), action, ghrepo.FullName(m.baseRepo), m.pr.Number, m.pr.Title)
+	if err := m.infof("%s %s pull request %s#%d (%s)\n", m.cs.SuccessIconWithColor(m.cs.Magenta), action, ghrepo.FullName(m.baseRepo), m.pr.Number, m.pr.Title); err != nil {
+		return err
+	}
+	if result.Verified {
+		_ = m.infof("%s Commit signature verified\n", m.cs.SuccessIconWithColor(m.cs.Green))
+	}
+
+	if err := m.runPostMergeHook(result); err != nil {
+		return err
+	}
+
+	if m.opts.Exporter != nil {
+		return m.opts.Exporter.Write(m.opts.IO, result)
+	}
+
+	return nil
+}
+
+// runPostMergeHook runs the command given to --post-merge-hook, if any, after a
+// successful, immediate merge. GH_PR_NUMBER and GH_MERGE_SHA are set in its
+// environment so the hook can act on the pull request that was just merged.
+func (m *mergeContext) runPostMergeHook(result *MergeResult) error {
+	if m.opts.PostMergeHook == "" {
+		return nil
+	}
+
+	shPath, err := findsh.Find()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return errors.New("unable to locate sh to execute --post-merge-hook with")
+		}
+		return err
+	}
+
+	hookCmd := exec.Command(shPath, "-c", m.opts.PostMergeHook)
+	hookCmd.Env = append(os.Environ(),
+		fmt.Sprintf("GH_PR_NUMBER=%d", m.pr.Number),
+		fmt.Sprintf("GH_MERGE_SHA=%s", result.MergeCommitOID),
+	)
+	hookCmd.Stdout = m.opts.IO.Out
+	hookCmd.Stderr = m.opts.IO.ErrOut
+	hookCmd.Stdin = m.opts.IO.In
+
+	if err := run.PrepareCmd(hookCmd).Run(); err != nil {
+		return fmt.Errorf("--post-merge-hook failed: %w", err)
+	}
+
+	return nil
+}
+
+// headMismatchError reports that the pull request's head branch moved since
+// --match-head-commit was checked, and suggests retrying with the new head SHA.
+func (m *mergeContext) headMismatchError() error {
+	headOid, err := pullRequestHeadOid(m.httpClient, m.baseRepo, m.pr.Number)
+	if err != nil {
+		return err
+	}
+
+	_ = m.warnf("%s Pull request %s#%d's head branch was modified after --match-head-commit was checked; new head is %s\n", m.cs.FailureIcon(), ghrepo.FullName(m.baseRepo), m.pr.Number, headOid)
+	_ = m.warnf("Run the same command with `--match-head-commit %s` to retry the merge.\n", headOid)
+	return cmdutil.SilentError
 }
 
 // Delete local branch if requested and if allowed.
@@ -483,9 +627,13 @@ func (m *mergeContext) infof(format string, args ...interface{}) error {
 
 // Creates a new MergeContext from MergeOptions.
 func NewMergeContext(opts *MergeOptions) (*mergeContext, error) {
+	fields := []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "baseRefName", "headRefOid", "isInMergeQueue", "isMergeQueueEnabled"}
+	if opts.SquashBodyFromPR {
+		fields = append(fields, "body")
+	}
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
-		Fields:   []string{"id", "number", "state", "title", "lastCommit", "mergeStateStatus", "headRepositoryOwner", "headRefName", "baseRefName", "headRefOid", "isInMergeQueue", "isMergeQueueEnabled"},
+		Fields:   fields,
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
 	if err != nil {
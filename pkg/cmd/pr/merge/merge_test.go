@@ -566,6 +566,168 @@ func TestPrMerge_withMatchCommitHeadFlag(t *testing.T) {
 	}
 }
 
+func TestPrMerge_verifiedCommitSignature(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.StringResponse(`{"data":{"mergePullRequest":{"pullRequest":{
+			"url": "https://github.com/OWNER/REPO/pull/1",
+			"mergeCommit": {"oid": "MERGECOMMITSHA", "signature": {"isValid": true}}
+		}}}}`),
+	)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.Contains(t, output.Stderr(), "Merged pull request OWNER/REPO#1 (The title of the PR)")
+	assert.Contains(t, output.Stderr(), "Commit signature verified")
+}
+
+func TestPrMerge_headMismatch(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.StringResponse(`{"data":null,"errors":[{"type":"UNPROCESSABLE","message":"Head branch was modified. Review and try the merge again."}]}`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query PullRequestHeadRefOid\b`),
+		httpmock.StringResponse(`{"data":{"repository":{"pullRequest":{"headRefOid":"NEWSHA"}}}}`),
+	)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge --match-head-commit OLDSHA")
+	assert.Equal(t, cmdutil.SilentError, err)
+
+	assert.Contains(t, output.Stderr(), "head branch was modified after --match-head-commit was checked; new head is NEWSHA")
+	assert.Contains(t, output.Stderr(), "Run the same command with `--match-head-commit NEWSHA` to retry the merge.")
+}
+
+func TestPrMerge_json(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.StringResponse(`{"data":{"mergePullRequest":{"pullRequest":{
+			"url": "https://github.com/OWNER/REPO/pull/1",
+			"mergeCommit": {"oid": "MERGECOMMITSHA", "signature": {"isValid": true}}
+		}}}}`),
+	)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge --json url,mergeCommitOid,verified")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.JSONEq(t, `{"url":"https://github.com/OWNER/REPO/pull/1","mergeCommitOid":"MERGECOMMITSHA","verified":true}`, output.String())
+}
+
+func Test_mergeContext_runPostMergeHook(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	ctx := &mergeContext{
+		pr: &api.PullRequest{Number: 42},
+		opts: &MergeOptions{
+			IO:            io,
+			PostMergeHook: `echo "pr=$GH_PR_NUMBER sha=$GH_MERGE_SHA"`,
+		},
+	}
+
+	err := ctx.runPostMergeHook(&MergeResult{MergeCommitOID: "abc123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "pr=42 sha=abc123\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_mergeContext_runPostMergeHook_failure(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	ctx := &mergeContext{
+		pr:   &api.PullRequest{Number: 42},
+		opts: &MergeOptions{IO: io, PostMergeHook: "exit 1"},
+	}
+
+	err := ctx.runPostMergeHook(&MergeResult{MergeCommitOID: "abc123"})
+	assert.ErrorContains(t, err, "--post-merge-hook failed")
+}
+
+func Test_mergeContext_runPostMergeHook_empty(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	ctx := &mergeContext{opts: &MergeOptions{IO: io}}
+	assert.NoError(t, ctx.runPostMergeHook(&MergeResult{}))
+}
+
+func TestPrMerge_jsonUnknownField(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	_, err := runCommand(http, nil, "main", true, "pr merge 1 --merge --json bogus")
+	assert.EqualError(t, err, "Unknown JSON field: \"bogus\"\nAvailable fields:\n  mergeCommitOid\n  url\n  verified")
+}
+
 func TestPrMerge_withAuthorFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -1057,6 +1219,68 @@ func TestPrMerge_squash(t *testing.T) {
 	`), output.Stderr())
 }
 
+func TestPrMerge_squashTitleAndBodyFromPR(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"3",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           3,
+			Title:            "The title of the PR",
+			Body:             "The body of the PR",
+			State:            "OPEN",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			assert.Equal(t, "The title of the PR (#3)", input["commitHeadline"].(string))
+			assert.Equal(t, "The body of the PR", input["commitBody"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 3 --squash --squash-title-from-pr --squash-body-from-pr")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Squashed and merged pull request OWNER/REPO#3 (The title of the PR)
+	`), output.Stderr())
+}
+
+func TestPrMerge_squashTitleFromPR_withoutSquash(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 3 --merge --squash-title-from-pr")
+	assert.EqualError(t, err, "`--squash-title-from-pr` and `--squash-body-from-pr` can only be used with `--squash`")
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestPrMerge_squashTitleFromPR_conflictsWithSubject(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	output, err := runCommand(http, nil, "main", true, `pr merge 3 --squash --squash-title-from-pr --subject "custom subject"`)
+	assert.EqualError(t, err, "specify only one of `--subject` or `--squash-title-from-pr`")
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
 func TestPrMerge_alreadyMerged(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
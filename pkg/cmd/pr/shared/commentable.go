@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
@@ -43,6 +44,8 @@ type CommentableOptions struct {
 	InputType             InputType
 	Body                  string
 	EditLast              bool
+	CreateIfNone          bool
+	Marker                string
 	Quiet                 bool
 	Host                  string
 }
@@ -84,7 +87,7 @@ func CommentableRun(opts *CommentableOptions) error {
 		return err
 	}
 	opts.Host = repo.RepoHost()
-	if opts.EditLast {
+	if opts.EditLast || opts.CreateIfNone {
 		return updateComment(commentable, opts)
 	}
 	return createComment(commentable, opts)
@@ -128,7 +131,7 @@ func createComment(commentable Commentable, opts *CommentableOptions) error {
 	}
 
 	apiClient := api.NewClientFromHTTP(httpClient)
-	params := api.CommentCreateInput{Body: opts.Body, SubjectId: commentable.Identifier()}
+	params := api.CommentCreateInput{Body: addMarker(opts.Body, opts.Marker), SubjectId: commentable.Identifier()}
 	url, err := api.CommentCreate(apiClient, opts.Host, params)
 	if err != nil {
 		return err
@@ -141,9 +144,46 @@ func createComment(commentable Commentable, opts *CommentableOptions) error {
 	return nil
 }
 
+// addMarker prepends a hidden HTML comment identifying comments created with the same
+// marker, so a later run can find and update them instead of creating a duplicate.
+func addMarker(body, marker string) string {
+	if marker == "" {
+		return body
+	}
+	return fmt.Sprintf("<!-- %s -->\n%s", marker, body)
+}
+
+// commentsWithMarker returns the comments whose body contains the given marker. An
+// empty marker matches every comment, preserving the plain --edit-last behavior.
+func commentsWithMarker(comments []api.Comment, marker string) []api.Comment {
+	if marker == "" {
+		return comments
+	}
+	var matched []api.Comment
+	for _, c := range comments {
+		if strings.Contains(c.Content(), marker) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// stripMarker removes a marker comment this package added via addMarker, so it isn't
+// shown back to the user as part of the editable body.
+func stripMarker(body, marker string) string {
+	if marker == "" {
+		return body
+	}
+	prefix := fmt.Sprintf("<!-- %s -->\n", marker)
+	return strings.TrimPrefix(body, prefix)
+}
+
 func updateComment(commentable Commentable, opts *CommentableOptions) error {
-	comments := commentable.CurrentUserComments()
+	comments := commentsWithMarker(commentable.CurrentUserComments(), opts.Marker)
 	if len(comments) == 0 {
+		if opts.CreateIfNone {
+			return createComment(commentable, opts)
+		}
 		return fmt.Errorf("no comments found for current user")
 	}
 
@@ -159,7 +199,7 @@ func updateComment(commentable Commentable, opts *CommentableOptions) error {
 	case InputTypeEditor:
 		var body string
 		var err error
-		initialValue := lastComment.Content()
+		initialValue := stripMarker(lastComment.Content(), opts.Marker)
 		if opts.Interactive {
 			body, err = opts.InteractiveEditSurvey(initialValue)
 		} else {
@@ -187,7 +227,7 @@ func updateComment(commentable Commentable, opts *CommentableOptions) error {
 	}
 
 	apiClient := api.NewClientFromHTTP(httpClient)
-	params := api.CommentUpdateInput{Body: opts.Body, CommentId: lastComment.Identifier()}
+	params := api.CommentUpdateInput{Body: addMarker(opts.Body, opts.Marker), CommentId: lastComment.Identifier()}
 	url, err := api.CommentUpdate(apiClient, opts.Host, params)
 	if err != nil {
 		return err
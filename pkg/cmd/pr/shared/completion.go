@@ -11,6 +11,82 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 )
 
+// PullRequestsForCompletion returns open pull requests formatted as "number\ttitle"
+// completion choices, most recently created first.
+func PullRequestsForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	type response struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					Number int
+					Title  string
+				}
+			}
+		}
+	}
+
+	query := `
+	query PullRequestsForCompletion($owner: String!, $repo: String!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequests(states: OPEN, first: 30, orderBy: {field: CREATED_AT, direction: DESC}) {
+				nodes {
+					number
+					title
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	client := api.NewClientFromHTTP(api.NewCachedHTTPClient(httpClient, time.Minute*2))
+	var result response
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	results := make([]string, 0, len(result.Repository.PullRequests.Nodes))
+	for _, pr := range result.Repository.PullRequests.Nodes {
+		results = append(results, fmt.Sprintf("%d\t%s", pr.Number, pr.Title))
+	}
+	return results, nil
+}
+
+// LabelsForCompletion returns the names of a repository's labels as completion choices.
+func LabelsForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	client := api.NewClientFromHTTP(api.NewCachedHTTPClient(httpClient, time.Minute*2))
+
+	labels, err := api.RepoLabels(client, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, len(labels))
+	for i, label := range labels {
+		results[i] = label.Name
+	}
+	return results, nil
+}
+
+// MilestonesForCompletion returns the titles of a repository's open milestones as completion choices.
+func MilestonesForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	client := api.NewClientFromHTTP(api.NewCachedHTTPClient(httpClient, time.Minute*2))
+
+	milestones, err := api.RepoMilestones(client, repo, "open")
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]string, len(milestones))
+	for i, milestone := range milestones {
+		results[i] = milestone.Title
+	}
+	return results, nil
+}
+
 func RequestableReviewersForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
 	client := api.NewClientFromHTTP(api.NewCachedHTTPClient(httpClient, time.Minute*2))
 
@@ -423,6 +423,41 @@ func FetchOptions(client *api.Client, repo ghrepo.Interface, editable *Editable)
 	return nil
 }
 
+// ResolveEditableMetadataIDs looks up the GraphQL node IDs for only the
+// reviewers, assignees, labels, projects, and milestone named in editable's
+// Add/Remove fields, rather than FetchOptions' full pre-fetch of every
+// label, milestone, assignable user, project, and team in the repo. It's
+// meant for non-interactive edits, where the exact names to resolve are
+// already known from flags and no option list needs to be built for a
+// prompt.
+func ResolveEditableMetadataIDs(client *api.Client, repo ghrepo.Interface, editable *Editable) error {
+	input := api.RepoResolveInput{}
+
+	if editable.Reviewers.Edited {
+		input.Reviewers = append(editable.Reviewers.Add, editable.Reviewers.Remove...)
+	}
+	if editable.Assignees.Edited {
+		input.Assignees = append(editable.Assignees.Add, editable.Assignees.Remove...)
+	}
+	if editable.Labels.Edited {
+		input.Labels = append(editable.Labels.Add, editable.Labels.Remove...)
+	}
+	if editable.Projects.Edited {
+		input.Projects = append(editable.Projects.Add, editable.Projects.Remove...)
+	}
+	if editable.Milestone.Edited && editable.Milestone.Value != "" && editable.Milestone.Value != noMilestone {
+		input.Milestones = []string{editable.Milestone.Value}
+	}
+
+	metadata, err := api.RepoResolveMetadataIDs(client, repo, input)
+	if err != nil {
+		return err
+	}
+
+	editable.Metadata = *metadata
+	return nil
+}
+
 func multiSelectSurvey(p EditPrompter, message string, defaults, options []string) (results []string, err error) {
 	if len(options) == 0 {
 		return nil, nil
@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEditableMetadataIDs(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+		httpmock.StringResponse(`
+		{ "data": {
+			"u000": { "login": "monalisa", "id": "MONAID" },
+			"repository": {
+				"l000": { "name": "bug", "id": "BUGID" }
+			}
+		} }
+		`))
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+
+	editable := &Editable{
+		Assignees: EditableSlice{Add: []string{"monalisa"}, Edited: true},
+		Labels:    EditableSlice{Add: []string{"bug"}, Edited: true},
+	}
+
+	err := ResolveEditableMetadataIDs(client, repo, editable)
+	require.NoError(t, err)
+
+	memberIDs, err := editable.Metadata.MembersToIDs([]string{"monalisa"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MONAID"}, memberIDs)
+
+	labelIDs, err := editable.Metadata.LabelsToIDs([]string{"bug"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"BUGID"}, labelIDs)
+}
+
+func TestResolveEditableMetadataIDs_noEdits(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+
+	err := ResolveEditableMetadataIDs(client, repo, &Editable{})
+	require.NoError(t, err)
+}
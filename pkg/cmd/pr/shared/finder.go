@@ -141,7 +141,7 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 	numberFieldOnly := fields.Len() == 1 && fields.Contains("number")
 	fields.AddValues([]string{"id", "number"}) // for additional preload queries below
 
-	if fields.Contains("isInMergeQueue") || fields.Contains("isMergeQueueEnabled") {
+	if fields.Contains("isInMergeQueue") || fields.Contains("isMergeQueueEnabled") || fields.Contains("mergeQueueEntry") {
 		cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
 		detector := fd.NewDetector(cachedClient, f.repo.RepoHost())
 		prFeatures, err := detector.PullRequestFeatures()
@@ -151,6 +151,7 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 		if !prFeatures.MergeQueue {
 			fields.Remove("isInMergeQueue")
 			fields.Remove("isMergeQueueEnabled")
+			fields.Remove("mergeQueueEntry")
 		}
 	}
 
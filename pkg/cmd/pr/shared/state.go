@@ -23,6 +23,11 @@ type IssueMetadataState struct {
 	Body  string
 	Title string
 
+	// BaseBranch and HeadBranch are only populated for pull requests, so that a
+	// recovered draft can be resubmitted against the same branches.
+	BaseBranch string
+	HeadBranch string
+
 	Metadata   []string
 	Reviewers  []string
 	Assignees  []string
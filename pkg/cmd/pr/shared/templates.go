@@ -37,6 +37,16 @@ func (t *issueTemplate) Body() []byte {
 	return []byte(t.Gbody)
 }
 
+// Labels and Assignees are not exposed by the GraphQL issue template API, so they are
+// only ever populated for templates read from the filesystem.
+func (t *issueTemplate) Labels() []string {
+	return nil
+}
+
+func (t *issueTemplate) Assignees() []string {
+	return nil
+}
+
 func (t *pullRequestTemplate) Name() string {
 	return t.Gname
 }
@@ -49,6 +59,14 @@ func (t *pullRequestTemplate) Body() []byte {
 	return []byte(t.Gbody)
 }
 
+func (t *pullRequestTemplate) Labels() []string {
+	return nil
+}
+
+func (t *pullRequestTemplate) Assignees() []string {
+	return nil
+}
+
 func listIssueTemplates(httpClient *http.Client, repo ghrepo.Interface) ([]Template, error) {
 	var query struct {
 		Repository struct {
@@ -109,6 +127,8 @@ type Template interface {
 	Name() string
 	NameForSubmit() string
 	Body() []byte
+	Labels() []string
+	Assignees() []string
 }
 
 type iprompter interface {
@@ -294,3 +314,13 @@ func (t *filesystemTemplate) NameForSubmit() string {
 func (t *filesystemTemplate) Body() []byte {
 	return githubtemplate.ExtractContents(t.path)
 }
+
+func (t *filesystemTemplate) Labels() []string {
+	labels, _ := githubtemplate.ExtractMetadata(t.path)
+	return labels
+}
+
+func (t *filesystemTemplate) Assignees() []string {
+	_, assignees := githubtemplate.ExtractMetadata(t.path)
+	return assignees
+}
@@ -1,6 +1,7 @@
 package status
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -26,6 +27,11 @@ type pullRequestsPayload struct {
 	ReviewRequested api.PullRequestAndTotalCount
 	CurrentPR       *api.PullRequest
 	DefaultBranch   string
+
+	// Warnings describes any sections of the payload that could not be
+	// loaded because of a partial GraphQL failure. The rest of the payload
+	// is still safe to render.
+	Warnings []string
 }
 
 func pullRequestStatus(httpClient *http.Client, repo ghrepo.Interface, options requestOptions) (*pullRequestsPayload, error) {
@@ -148,8 +154,16 @@ func pullRequestStatus(httpClient *http.Client, repo ghrepo.Interface, options r
 	}
 
 	var resp response
+	var warnings []string
 	if err := apiClient.GraphQL(repo.RepoHost(), query, variables, &resp); err != nil {
-		return nil, err
+		var gqlErr api.GraphQLError
+		if !errors.As(err, &gqlErr) {
+			return nil, err
+		}
+		// The response is partially populated; fall back to rendering
+		// whichever sections came back and surface the rest as warnings
+		// instead of failing the whole command.
+		warnings = partialFailureWarnings(gqlErr)
 	}
 
 	var viewerCreated []api.PullRequest
@@ -183,11 +197,42 @@ func pullRequestStatus(httpClient *http.Client, repo ghrepo.Interface, options r
 		},
 		CurrentPR:     currentPR,
 		DefaultBranch: resp.Repository.DefaultBranchRef.Name,
+		Warnings:      warnings,
 	}
 
 	return &payload, nil
 }
 
+// partialFailureWarnings turns the errors of a partially successful
+// GraphQL response into human-readable messages naming the section of the
+// status that failed to load.
+func partialFailureWarnings(gqlErr api.GraphQLError) []string {
+	sectionNames := map[string]string{
+		"repository":      "the pull request for the current branch",
+		"viewerCreated":   "pull requests created by you",
+		"reviewRequested": "pull requests requesting your review",
+	}
+
+	seen := set.NewStringSet()
+	var warnings []string
+	for _, e := range gqlErr.Errors {
+		section := ""
+		if len(e.Path) > 0 {
+			section, _ = e.Path[0].(string)
+		}
+		name, ok := sectionNames[section]
+		if !ok {
+			name = "part of your pull request status"
+		}
+		if seen.Contains(name) {
+			continue
+		}
+		seen.Add(name)
+		warnings = append(warnings, fmt.Sprintf("failed to load %s: %s", name, e.Message))
+	}
+	return warnings
+}
+
 func pullRequestFragment(hostname string, conflictStatus bool, statusCheckRollupWithCountByState bool) (string, error) {
 	fields := []string{
 		"number", "title", "state", "url", "isDraft", "isCrossRepository",
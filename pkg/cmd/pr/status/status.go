@@ -85,11 +85,15 @@ func statusRun(opts *StatusOptions) error {
 	var currentBranch string
 	var currentPRNumber int
 	var currentPRHeadRef string
+	var detachedHead bool
 
 	if !opts.HasRepoOverride {
 		currentBranch, err = opts.Branch()
-		if err != nil && !errors.Is(err, git.ErrNotOnAnyBranch) {
-			return fmt.Errorf("could not query for pull request for current branch: %w", err)
+		if err != nil {
+			if !errors.Is(err, git.ErrNotOnAnyBranch) {
+				return fmt.Errorf("could not query for pull request for current branch: %w", err)
+			}
+			detachedHead = true
 		}
 
 		remotes, _ := opts.Remotes()
@@ -130,6 +134,11 @@ func statusRun(opts *StatusOptions) error {
 	}
 	defer opts.IO.StopPager()
 
+	cs := opts.IO.ColorScheme()
+	for _, w := range prPayload.Warnings {
+		fmt.Fprintf(opts.IO.ErrOut, "%s %s\n", cs.WarningIcon(), w)
+	}
+
 	if opts.Exporter != nil {
 		data := map[string]interface{}{
 			"currentBranch": nil,
@@ -143,7 +152,6 @@ func statusRun(opts *StatusOptions) error {
 	}
 
 	out := opts.IO.Out
-	cs := opts.IO.ColorScheme()
 
 	fmt.Fprintln(out, "")
 	fmt.Fprintf(out, "Relevant pull requests in %s\n", ghrepo.FullName(baseRepo))
@@ -157,6 +165,8 @@ func statusRun(opts *StatusOptions) error {
 		}
 		if currentPR != nil {
 			printPrs(opts.IO, 1, *currentPR)
+		} else if detachedHead {
+			shared.PrintMessage(opts.IO, "  You are in detached HEAD state. Check out a branch to see its pull request")
 		} else if currentPRHeadRef == "" {
 			shared.PrintMessage(opts.IO, "  There is no current branch")
 		} else {
@@ -246,6 +256,10 @@ func printPrs(io *iostreams.IOStreams, totalCount int, prs ...api.PullRequest) {
 		reviews := pr.ReviewStatus()
 
 		if pr.State == "OPEN" {
+			if pr.IsDraft {
+				fmt.Fprintf(w, " - %s", shared.StateTitleWithColor(cs, pr))
+			}
+
 			reviewStatus := reviews.ChangesRequested || reviews.Approved || reviews.ReviewRequired
 			if checks.Total > 0 || reviewStatus {
 				// show checks & reviews on their own line
@@ -103,7 +103,7 @@ func TestPRStatus(t *testing.T) {
 		regexp.MustCompile(`#8.*\[strawberries\]`),
 		regexp.MustCompile(`#9.*\[apples\].*✓ Auto-merge enabled`),
 		regexp.MustCompile(`#10.*\[blueberries\]`),
-		regexp.MustCompile(`#11.*\[figs\]`),
+		regexp.MustCompile(`#11.*\[figs\].*- Draft`),
 	}
 
 	for _, r := range expectedPrs {
@@ -346,6 +346,46 @@ Requesting a code review from you
 	}
 }
 
+func TestPRStatus_partialFailure(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.StringResponse(`{
+		"data": {
+			"viewerCreated": {
+				"totalCount": 1,
+				"edges": [
+					{
+						"node": {
+							"number": 8,
+							"title": "Strawberries are not actually berries",
+							"state": "OPEN",
+							"url": "https://github.com/cli/cli/pull/8",
+							"headRefName": "strawberries",
+							"isDraft": false,
+							"autoMergeRequest": null
+						}
+					}
+				]
+			}
+		},
+		"errors": [
+			{ "type": "SERVICE_UNAVAILABLE", "message": "search is temporarily unavailable", "path": ["reviewRequested"] }
+		]
+	}`))
+
+	output, err := runCommand(http, "blueberries", true, "")
+	if err != nil {
+		t.Errorf("error running command `pr status`: %v", err)
+	}
+
+	if !strings.Contains(output.String(), "#8  Strawberries are not actually berries") {
+		t.Errorf("expected the successfully loaded section to still render, got %q", output.String())
+	}
+	if !strings.Contains(output.Stderr(), "failed to load pull requests requesting your review: search is temporarily unavailable") {
+		t.Errorf("expected a warning about the failed section, got %q", output.Stderr())
+	}
+}
+
 func TestPRStatus_detachedHead(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -360,7 +400,7 @@ func TestPRStatus_detachedHead(t *testing.T) {
 Relevant pull requests in OWNER/REPO
 
 Current branch
-  There is no current branch
+  You are in detached HEAD state. Check out a branch to see its pull request
 
 Created by you
   You have no open pull requests
@@ -2,6 +2,8 @@ package view
 
 import (
 	"fmt"
+	"net/http"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -20,24 +22,27 @@ import (
 )
 
 type ViewOptions struct {
-	IO      *iostreams.IOStreams
-	Browser browser.Browser
+	IO         *iostreams.IOStreams
+	Browser    browser.Browser
+	HttpClient func() (*http.Client, error)
 
 	Finder   shared.PRFinder
 	Exporter cmdutil.Exporter
 
-	SelectorArg string
-	BrowserMode bool
-	Comments    bool
+	SelectorArg   string
+	BrowserMode   bool
+	Comments      bool
+	TimelineLimit int
 
 	Now func() time.Time
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
 	opts := &ViewOptions{
-		IO:      f.IOStreams,
-		Browser: f.Browser,
-		Now:     time.Now,
+		IO:         f.IOStreams,
+		Browser:    f.Browser,
+		HttpClient: f.HttpClient,
+		Now:        time.Now,
 	}
 
 	cmd := &cobra.Command{
@@ -63,6 +68,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.TimelineLimit < 1 {
+				return cmdutil.FlagErrorf("invalid value for --timeline-limit: %v", opts.TimelineLimit)
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -72,6 +81,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open a pull request in the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View pull request comments")
+	cmd.Flags().IntVar(&opts.TimelineLimit, "timeline-limit", 20, "Maximum number of timeline events to include in `--json timelineItems` output")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	return cmd
@@ -81,8 +91,8 @@ var defaultFields = []string{
 	"url", "number", "title", "state", "body", "author", "autoMergeRequest",
 	"isDraft", "maintainerCanModify", "mergeable", "additions", "deletions", "commitsCount",
 	"baseRefName", "headRefName", "headRepositoryOwner", "headRepository", "isCrossRepository",
-	"reviewRequests", "reviews", "assignees", "labels", "projectCards", "milestone",
-	"comments", "reactionGroups", "createdAt", "statusCheckRollup",
+	"reviewRequests", "reviews", "reviewDecision", "assignees", "labels", "projectCards", "milestone",
+	"comments", "reactionGroups", "createdAt", "statusCheckRollup", "isInMergeQueue", "mergeQueueEntry",
 }
 
 func viewRun(opts *ViewOptions) error {
@@ -100,6 +110,23 @@ func viewRun(opts *ViewOptions) error {
 		return err
 	}
 
+	if opts.Exporter != nil && slices.Contains(opts.Exporter.Fields(), "files") {
+		httpClient, err := opts.HttpClient()
+		if err != nil {
+			return err
+		}
+		apiClient := api.NewClientFromHTTP(httpClient)
+		if err := api.PullRequestFilesWithPatch(apiClient, baseRepo, pr.Number, pr.Files.Nodes); err != nil {
+			return err
+		}
+	}
+
+	if opts.Exporter != nil && slices.Contains(opts.Exporter.Fields(), "timelineItems") {
+		if items := pr.TimelineItems.Nodes; len(items) > opts.TimelineLimit {
+			pr.TimelineItems.Nodes = items[len(items)-opts.TimelineLimit:]
+		}
+	}
+
 	connectedToTerminal := opts.IO.IsStdoutTTY()
 
 	if opts.BrowserMode {
@@ -167,6 +194,13 @@ func printRawPrPreview(io *iostreams.IOStreams, pr *api.PullRequest) error {
 			strings.ToLower(pr.AutoMergeRequest.MergeMethod))
 	}
 	fmt.Fprintf(out, "auto-merge:\t%s\n", autoMerge)
+	if pr.IsInMergeQueue {
+		if pr.MergeQueueEntry != nil {
+			fmt.Fprintf(out, "merge-queue:\tqueued\t%d\n", pr.MergeQueueEntry.Position)
+		} else {
+			fmt.Fprintf(out, "merge-queue:\tqueued\n")
+		}
+	}
 
 	fmt.Fprintln(out, "--")
 	fmt.Fprintln(out, pr.Body)
@@ -216,6 +250,10 @@ func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.P
 		fmt.Fprint(out, cs.Bold("Reviewers: "))
 		fmt.Fprintln(out, reviewers)
 	}
+	if reviewDecision := prReviewDecisionText(*pr, cs); reviewDecision != "" {
+		fmt.Fprint(out, cs.Bold("Review decision: "))
+		fmt.Fprintln(out, reviewDecision)
+	}
 	if assignees := prAssigneeList(*pr); assignees != "" {
 		fmt.Fprint(out, cs.Bold("Assignees: "))
 		fmt.Fprintln(out, assignees)
@@ -256,6 +294,20 @@ func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.P
 		)
 	}
 
+	// Merge queue status
+	if pr.IsInMergeQueue {
+		if pr.MergeQueueEntry != nil {
+			fmt.Fprintf(out,
+				"%s %s (position %d)\n",
+				cs.Bold("Merge queue:"),
+				cs.Yellow("queued"),
+				pr.MergeQueueEntry.Position,
+			)
+		} else {
+			fmt.Fprintf(out, "%s %s\n", cs.Bold("Merge queue:"), cs.Yellow("queued"))
+		}
+	}
+
 	// Body
 	var md string
 	var err error
@@ -338,6 +390,21 @@ func prReviewerList(pr api.PullRequest, cs *iostreams.ColorScheme) string {
 	return reviewerList
 }
 
+// prReviewDecisionText renders the pull request's overall reviewDecision as a
+// colored, human-readable label, matching the wording `gh pr status` uses.
+func prReviewDecisionText(pr api.PullRequest, cs *iostreams.ColorScheme) string {
+	switch pr.ReviewDecision {
+	case "APPROVED":
+		return cs.Green("Approved")
+	case "CHANGES_REQUESTED":
+		return cs.Red("Changes requested")
+	case "REVIEW_REQUIRED":
+		return cs.Yellow("Review required")
+	default:
+		return ""
+	}
+}
+
 const ghostName = "ghost"
 
 // parseReviewers parses given Reviews and ReviewRequests
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/v2/api"
@@ -74,6 +75,12 @@ func Test_NewCmdView(t *testing.T) {
 				Comments:    true,
 			},
 		},
+		{
+			name:    "invalid timeline limit",
+			args:    "123 --timeline-limit 0",
+			isTTY:   true,
+			wantErr: "invalid value for --timeline-limit: 0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -124,6 +131,9 @@ func runCommand(rt http.RoundTripper, branch string, isTTY bool, cli string) (*t
 	factory := &cmdutil.Factory{
 		IOStreams: ios,
 		Browser:   browser,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
 	}
 
 	cmd := NewCmdView(factory, nil)
@@ -547,6 +557,58 @@ func TestPRView_Preview(t *testing.T) {
 				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
 			},
 		},
+		"Open PR in merge queue": {
+			branch: "master",
+			args:   "12",
+			fixtures: map[string]string{
+				"PullRequestByNumber": "./fixtures/prViewPreviewInMergeQueue.json",
+			},
+			expectedOutputs: []string{
+				`Blueberries are from a fork OWNER/REPO#12`,
+				`Merge queue:.*queued.*\(position 3\)`,
+				`blueberries taste good`,
+				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
+			},
+		},
+		"Open PR with review decision approved": {
+			branch: "master",
+			args:   "12",
+			fixtures: map[string]string{
+				"PullRequestByNumber": "./fixtures/prViewPreviewWithReviewDecisionApproved.json",
+			},
+			expectedOutputs: []string{
+				`Blueberries are from a fork OWNER/REPO#12`,
+				`Review decision:.*Approved\n`,
+				`blueberries taste good`,
+				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
+			},
+		},
+		"Open PR with review decision changes requested": {
+			branch: "master",
+			args:   "12",
+			fixtures: map[string]string{
+				"PullRequestByNumber": "./fixtures/prViewPreviewWithReviewDecisionChangesRequested.json",
+			},
+			expectedOutputs: []string{
+				`Blueberries are from a fork OWNER/REPO#12`,
+				`Review decision:.*Changes requested\n`,
+				`blueberries taste good`,
+				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
+			},
+		},
+		"Open PR with review decision required": {
+			branch: "master",
+			args:   "12",
+			fixtures: map[string]string{
+				"PullRequestByNumber": "./fixtures/prViewPreviewWithReviewDecisionReviewRequired.json",
+			},
+			expectedOutputs: []string{
+				`Blueberries are from a fork OWNER/REPO#12`,
+				`Review decision:.*Review required\n`,
+				`blueberries taste good`,
+				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -818,3 +880,100 @@ func TestPRView_nontty_Comments(t *testing.T) {
 		})
 	}
 }
+
+func TestPRView_JSON_filesWithPatch(t *testing.T) {
+	pr := &api.PullRequest{
+		Number: 123,
+		Files: struct {
+			Nodes []api.PullRequestFile
+		}{
+			Nodes: []api.PullRequestFile{
+				{Path: "small.go", Additions: 1, Deletions: 1},
+				{Path: "huge.go", Additions: 500, Deletions: 0},
+			},
+		},
+	}
+	shared.RunCommandFinder("123", pr, ghrepo.New("OWNER", "REPO"))
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+		httpmock.JSONResponse([]map[string]string{
+			{"filename": "small.go", "patch": "@@ -1 +1 @@\n-old\n+new\n"},
+			{"filename": "huge.go", "patch": strings.Repeat("+x\n", 20000)},
+		}),
+	)
+
+	output, err := runCommand(http, "master", true, "123 --json files")
+	require.NoError(t, err)
+
+	var result struct {
+		Files []struct {
+			Path  string  `json:"path"`
+			Patch *string `json:"patch"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &result))
+
+	require.Len(t, result.Files, 2)
+	require.NotNil(t, result.Files[0].Patch)
+	assert.Equal(t, "@@ -1 +1 @@\n-old\n+new\n", *result.Files[0].Patch)
+	assert.Nil(t, result.Files[1].Patch)
+}
+
+func TestPRView_JSON_timelineItems_respectsLimit(t *testing.T) {
+	makeItem := func(typeName string) api.PullRequestTimelineItem {
+		return api.PullRequestTimelineItem{TypeName: typeName}
+	}
+	pr := &api.PullRequest{
+		Number: 123,
+		TimelineItems: struct {
+			Nodes []api.PullRequestTimelineItem
+		}{
+			Nodes: []api.PullRequestTimelineItem{
+				makeItem("IssueComment"),
+				makeItem("LabeledEvent"),
+				makeItem("PullRequestReview"),
+				makeItem("PullRequestCommit"),
+			},
+		},
+	}
+	shared.RunCommandFinder("123", pr, ghrepo.New("OWNER", "REPO"))
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	output, err := runCommand(http, "master", true, "123 --json timelineItems --timeline-limit 2")
+	require.NoError(t, err)
+
+	var result struct {
+		TimelineItems []struct {
+			TypeName string `json:"__typename"`
+		} `json:"timelineItems"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &result))
+
+	require.Len(t, result.TimelineItems, 2)
+	assert.Equal(t, "PullRequestReview", result.TimelineItems[0].TypeName)
+	assert.Equal(t, "PullRequestCommit", result.TimelineItems[1].TypeName)
+}
+
+func TestPRView_JSON_withoutFilesField_skipsPatchLookup(t *testing.T) {
+	pr := &api.PullRequest{
+		Number: 123,
+		Files: struct {
+			Nodes []api.PullRequestFile
+		}{
+			Nodes: []api.PullRequestFile{{Path: "small.go"}},
+		},
+	}
+	shared.RunCommandFinder("123", pr, ghrepo.New("OWNER", "REPO"))
+
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	output, err := runCommand(http, "master", true, "123 --json number")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"number": 123}`, output.String())
+}
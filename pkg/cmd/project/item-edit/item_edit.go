@@ -2,6 +2,8 @@ package itemedit
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +30,11 @@ type editItemOpts struct {
 	singleSelectOptionID string
 	iterationID          string
 	clear                bool
+	// resolve field and value by name
+	owner      string
+	projectNum int32
+	fieldName  string
+	value      string
 	// format
 	exporter cmdutil.Exporter
 }
@@ -59,42 +66,71 @@ type ClearProjectV2FieldValue struct {
 func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error) *cobra.Command {
 	opts := editItemOpts{}
 	editItemCmd := &cobra.Command{
-		Use:   "item-edit",
+		Use:   "item-edit [<number>]",
 		Short: "Edit an item in a project",
 		Long: heredoc.Docf(`
 			Edit either a draft issue or a project item. Both usages require the ID of the item to edit.
-			
+
 			For non-draft issues, the ID of the project is also required, and only a single field value can be updated per invocation.
 
+			Instead of passing %[1]s--field-id%[1]s, %[1]s--project-id%[1]s and a raw value flag, the project
+			number can be given as the argument along with %[1]s--owner%[1]s, %[1]s--field%[1]s and %[1]s--value%[1]s to
+			resolve the field (and, for single select fields, the option) by name instead of by ID. Field and option
+			names are matched case-insensitively. For iteration fields, %[1]s--value%[1]s accepts %[1]s@current%[1]s or
+			%[1]s@next%[1]s in addition to an iteration's title.
+
 			Remove project item field value using %[1]s--clear%[1]s flag.
 		`, "`"),
+		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
 			# edit an item's text field value
 			gh project item-edit --id <item-ID> --field-id <field-ID> --project-id <project-ID> --text "new text"
 
+			# edit an item's field value by name
+			gh project item-edit 1 --owner monalisa --id <item-ID> --field Status --value "In Progress"
+
+			# move an item to the current iteration
+			gh project item-edit 1 --owner monalisa --id <item-ID> --field Sprint --value @current
+
 			# clear an item's field value
 			gh project item-edit --id <item-ID> --field-id <field-ID> --project-id <project-ID> --clear
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmdutil.MutuallyExclusive(
-				"only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
+				"only one of `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--field`/`--value` may be used",
 				opts.text != "",
 				opts.number != 0,
 				opts.date != "",
 				opts.singleSelectOptionID != "",
 				opts.iterationID != "",
+				opts.fieldName != "",
 			); err != nil {
 				return err
 			}
 
 			if err := cmdutil.MutuallyExclusive(
-				"cannot use `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` in conjunction with `--clear`",
-				opts.text != "" || opts.number != 0 || opts.date != "" || opts.singleSelectOptionID != "" || opts.iterationID != "",
+				"cannot use `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--field`/`--value` in conjunction with `--clear`",
+				opts.text != "" || opts.number != 0 || opts.date != "" || opts.singleSelectOptionID != "" || opts.iterationID != "" || opts.fieldName != "",
 				opts.clear,
 			); err != nil {
 				return err
 			}
 
+			if (opts.fieldName != "") != (opts.value != "") {
+				return cmdutil.FlagErrorf("`--field` and `--value` must be used together")
+			}
+
+			if opts.fieldName != "" {
+				if len(args) == 0 {
+					return cmdutil.FlagErrorf("a project number is required when using `--field`")
+				}
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.projectNum = int32(num)
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -129,6 +165,10 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 	editItemCmd.Flags().StringVar(&opts.iterationID, "iteration-id", "", "ID of the iteration value to set on the field")
 	editItemCmd.Flags().BoolVar(&opts.clear, "clear", false, "Remove field value")
 
+	editItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Required when editing by field name.")
+	editItemCmd.Flags().StringVar(&opts.fieldName, "field", "", "Name of the field to update, resolved by name instead of a field ID")
+	editItemCmd.Flags().StringVar(&opts.value, "value", "", "Value to set for --field; an option name for single select fields, or @current/@next for iteration fields")
+
 	_ = editItemCmd.MarkFlagRequired("id")
 
 	return editItemCmd
@@ -140,6 +180,14 @@ func runEditItem(config editItemConfig) error {
 		return clearItemFieldValue(config)
 	}
 
+	// resolve field (and option) by name before updating item values
+	if config.opts.fieldName != "" {
+		if err := resolveNamedFieldValue(&config); err != nil {
+			return err
+		}
+		return updateItemValues(config)
+	}
+
 	// update draft issue
 	if config.opts.title != "" || config.opts.body != "" {
 		return updateDraftIssue(config)
@@ -156,6 +204,117 @@ func runEditItem(config editItemConfig) error {
 	return cmdutil.SilentError
 }
 
+// resolveNamedFieldValue looks up the field named by opts.fieldName on the given project and
+// populates opts.fieldID, opts.projectID and the appropriate typed value (opts.text, opts.number,
+// opts.date, opts.singleSelectOptionID or opts.iterationID) from opts.value.
+func resolveNamedFieldValue(config *editItemConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.ProjectFields(owner, config.opts.projectNum, 0)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	var field *queries.ProjectField
+	names := make([]string, 0, len(project.Fields.Nodes))
+	for i := range project.Fields.Nodes {
+		names = append(names, project.Fields.Nodes[i].Name())
+		if strings.EqualFold(project.Fields.Nodes[i].Name(), config.opts.fieldName) {
+			field = &project.Fields.Nodes[i]
+			break
+		}
+	}
+	if field == nil {
+		return cmdutil.FlagErrorf("no field found with name %q; valid fields are: %s", config.opts.fieldName, strings.Join(names, ", "))
+	}
+	config.opts.fieldID = field.ID()
+
+	switch field.Type() {
+	case "ProjectV2SingleSelectField":
+		options := field.Options()
+		optionNames := make([]string, 0, len(options))
+		for _, o := range options {
+			optionNames = append(optionNames, o.Name)
+			if strings.EqualFold(o.Name, config.opts.value) {
+				config.opts.singleSelectOptionID = o.ID
+				return nil
+			}
+		}
+		return cmdutil.FlagErrorf("no option found with name %q for field %q; valid options are: %s", config.opts.value, field.Name(), strings.Join(optionNames, ", "))
+	case "ProjectV2IterationField":
+		iterationID, err := resolveIterationID(*field, config.opts.value)
+		if err != nil {
+			return err
+		}
+		config.opts.iterationID = iterationID
+		return nil
+	default:
+		switch field.Field.DataType {
+		case "NUMBER":
+			number, err := strconv.ParseFloat(config.opts.value, 32)
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid number for field %q: %v", field.Name(), config.opts.value)
+			}
+			config.opts.number = float32(number)
+		case "DATE":
+			config.opts.date = config.opts.value
+		default:
+			config.opts.text = config.opts.value
+		}
+		return nil
+	}
+}
+
+// resolveIterationID resolves an iteration field's value to an iteration ID. value may be
+// "@current" or "@next", or the title of one of the field's configured iterations.
+func resolveIterationID(field queries.ProjectField, value string) (string, error) {
+	iterations := field.Iterations()
+	sorted := make([]queries.ProjectV2IterationFieldIteration, len(iterations))
+	copy(sorted, iterations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StartDate < sorted[j].StartDate })
+
+	now := time.Now()
+	switch value {
+	case "@current":
+		for _, it := range sorted {
+			start, err := time.Parse("2006-01-02", it.StartDate)
+			if err != nil {
+				continue
+			}
+			end := start.AddDate(0, 0, it.Duration)
+			if !now.Before(start) && now.Before(end) {
+				return it.ID, nil
+			}
+		}
+		return "", cmdutil.FlagErrorf("no current iteration found for field %q", field.Name())
+	case "@next":
+		for _, it := range sorted {
+			start, err := time.Parse("2006-01-02", it.StartDate)
+			if err != nil {
+				continue
+			}
+			if start.After(now) {
+				return it.ID, nil
+			}
+		}
+		return "", cmdutil.FlagErrorf("no next iteration found for field %q", field.Name())
+	default:
+		titles := make([]string, 0, len(sorted))
+		for _, it := range sorted {
+			titles = append(titles, it.Title)
+			if strings.EqualFold(it.Title, value) {
+				return it.ID, nil
+			}
+		}
+		return "", cmdutil.FlagErrorf("no iteration found with title %q for field %q; valid values are \"@current\", \"@next\", or one of: %s", value, field.Name(), strings.Join(titles, ", "))
+	}
+}
+
 func buildEditDraftIssue(config editItemConfig) (*EditProjectDraftIssue, map[string]interface{}) {
 	return &EditProjectDraftIssue{}, map[string]interface{}{
 		"input": githubv4.UpdateProjectV2DraftIssueInput{
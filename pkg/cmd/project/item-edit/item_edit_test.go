@@ -2,12 +2,14 @@ package itemedit
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -30,7 +32,7 @@ func TestNewCmdeditItem(t *testing.T) {
 			name:        "invalid-flags",
 			cli:         "--id 123 --text t --date 2023-01-01",
 			wantsErr:    true,
-			wantsErrMsg: "only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
+			wantsErrMsg: "only one of `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--field`/`--value` may be used",
 		},
 		{
 			name: "item-id",
@@ -95,6 +97,29 @@ func TestNewCmdeditItem(t *testing.T) {
 				itemID:      "123",
 			},
 		},
+		{
+			name: "field-and-value",
+			cli:  "1 --owner monalisa --id 123 --field Status --value \"In Progress\"",
+			wants: editItemOpts{
+				itemID:     "123",
+				owner:      "monalisa",
+				projectNum: 1,
+				fieldName:  "Status",
+				value:      "In Progress",
+			},
+		},
+		{
+			name:        "field-without-value",
+			cli:         "1 --owner monalisa --id 123 --field Status",
+			wantsErr:    true,
+			wantsErrMsg: "`--field` and `--value` must be used together",
+		},
+		{
+			name:        "field-without-number",
+			cli:         "--owner monalisa --id 123 --field Status --value Done",
+			wantsErr:    true,
+			wantsErrMsg: "a project number is required when using `--field`",
+		},
 		{
 			name: "clear",
 			cli:  "--id 123 --field-id FIELD_ID --project-id PROJECT_ID --clear",
@@ -154,6 +179,10 @@ func TestNewCmdeditItem(t *testing.T) {
 			assert.Equal(t, tt.wants.singleSelectOptionID, gotOpts.singleSelectOptionID)
 			assert.Equal(t, tt.wants.iterationID, gotOpts.iterationID)
 			assert.Equal(t, tt.wants.clear, gotOpts.clear)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.projectNum, gotOpts.projectNum)
+			assert.Equal(t, tt.wants.fieldName, gotOpts.fieldName)
+			assert.Equal(t, tt.wants.value, gotOpts.value)
 		})
 	}
 }
@@ -575,3 +604,317 @@ func TestRunItemEdit_JSON(t *testing.T) {
 		`{"id":"DI_item_id","title":"a title","body":"a new body","type":"DraftIssue"}`,
 		stdout.String())
 }
+
+func TestRunItemEdit_FieldByName(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// resolve field and project by name
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitDefault,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project_id",
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"name":       "Status",
+									"id":         "field_id",
+									"options": []map[string]interface{}{
+										{"id": "todo_id", "name": "Todo"},
+										{"id": "progress_id", "name": "In Progress"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// edit item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"project_id","itemId":"item_id","fieldId":"field_id","value":{"singleSelectOptionId":"progress_id"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"ID": "item_id",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"title":      "title",
+							"number":     1,
+							"repository": map[string]interface{}{
+								"nameWithOwner": "my-repo",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			itemID:     "item_id",
+			owner:      "monalisa",
+			projectNum: 1,
+			fieldName:  "status",
+			value:      "in progress",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	require.NoError(t, err)
+	assert.Equal(t, "Edited item \"title\"\n", stdout.String())
+}
+
+func TestRunItemEdit_FieldByName_UnknownOption(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitDefault,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project_id",
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"name":       "Status",
+									"id":         "field_id",
+									"options": []map[string]interface{}{
+										{"id": "todo_id", "name": "Todo"},
+										{"id": "progress_id", "name": "In Progress"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			itemID:     "item_id",
+			owner:      "monalisa",
+			projectNum: 1,
+			fieldName:  "status",
+			value:      "Doing",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	require.Error(t, err)
+	assert.Equal(t, `no option found with name "Doing" for field "Status"; valid options are: Todo, In Progress`, err.Error())
+}
+
+func TestRunItemEdit_FieldByName_UnknownField(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitDefault,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project_id",
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2Field",
+									"name":       "Title",
+									"id":         "title_field_id",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			itemID:     "item_id",
+			owner:      "monalisa",
+			projectNum: 1,
+			fieldName:  "Status",
+			value:      "Done",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	require.Error(t, err)
+	assert.Equal(t, `no field found with name "Status"; valid fields are: Title`, err.Error())
+}
+
+func TestResolveIterationID(t *testing.T) {
+	today := time.Now()
+	current := queries.ProjectV2IterationFieldIteration{
+		ID:        "current_id",
+		Title:     "Sprint 1",
+		StartDate: today.AddDate(0, 0, -3).Format("2006-01-02"),
+		Duration:  10,
+	}
+	next := queries.ProjectV2IterationFieldIteration{
+		ID:        "next_id",
+		Title:     "Sprint 2",
+		StartDate: today.AddDate(0, 0, 7).Format("2006-01-02"),
+		Duration:  10,
+	}
+	field := queries.ProjectField{TypeName: "ProjectV2IterationField"}
+	field.IterationField.Configuration.Iterations = []queries.ProjectV2IterationFieldIteration{next, current}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantID  string
+		wantErr string
+	}{
+		{name: "current", value: "@current", wantID: "current_id"},
+		{name: "next", value: "@next", wantID: "next_id"},
+		{name: "by title", value: "sprint 2", wantID: "next_id"},
+		{name: "unknown title", value: "Sprint 3", wantErr: `no iteration found with title "Sprint 3" for field ""; valid values are "@current", "@next", or one of: Sprint 1, Sprint 2`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resolveIterationID(field, tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
@@ -724,9 +724,12 @@ type ProjectField struct {
 		DataType string
 	} `graphql:"... on ProjectV2Field"`
 	IterationField struct {
-		ID       string
-		Name     string
-		DataType string
+		ID            string
+		Name          string
+		DataType      string
+		Configuration struct {
+			Iterations []ProjectV2IterationFieldIteration
+		}
 	} `graphql:"... on ProjectV2IterationField"`
 	SingleSelectField struct {
 		ID       string
@@ -765,6 +768,22 @@ func (p ProjectField) Type() string {
 	return p.TypeName
 }
 
+// ProjectV2IterationFieldIteration is a single iteration in an iteration field's configuration.
+type ProjectV2IterationFieldIteration struct {
+	ID        string
+	Title     string
+	StartDate string
+	Duration  int
+}
+
+// Iterations returns the configured iterations of an iteration field, or nil for other field types.
+func (p ProjectField) Iterations() []ProjectV2IterationFieldIteration {
+	if p.TypeName == "ProjectV2IterationField" {
+		return p.IterationField.Configuration.Iterations
+	}
+	return nil
+}
+
 type SingleSelectFieldOptions struct {
 	ID   string
 	Name string
@@ -416,7 +416,11 @@ func createRun(opts *CreateOptions) error {
 		params["body"] = opts.Body
 	}
 	if opts.Target != "" {
-		params["target_commitish"] = opts.Target
+		resolvedTarget, err := resolveTargetCommitish(httpClient, baseRepo, opts.Target)
+		if err != nil {
+			return fmt.Errorf("could not resolve --target %q: %w", opts.Target, err)
+		}
+		params["target_commitish"] = resolvedTarget
 	}
 	if opts.IsLatest != nil {
 		// valid values: true/false/legacy
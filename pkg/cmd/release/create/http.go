@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghinstance"
@@ -254,3 +256,46 @@ func deleteRelease(httpClient *http.Client, release *shared.Release) error {
 	}
 	return nil
 }
+
+var abbreviatedSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{7,39}$`)
+
+// resolveTargetCommitish expands an abbreviated commit SHA passed as --target into the
+// full SHA via the commits API, since the releases API's target_commitish field doesn't
+// reliably accept abbreviated SHAs. Branch names and full SHAs are returned unchanged.
+func resolveTargetCommitish(httpClient *http.Client, repo ghrepo.Interface, target string) (string, error) {
+	if !abbreviatedSHAPattern.MatchString(target) {
+		return target, nil
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/commits/%s", repo.RepoOwner(), repo.RepoName(), target)
+	url := ghinstance.RESTPrefix(repo.RepoHost()) + path
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3.sha")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	success := resp.StatusCode >= 200 && resp.StatusCode < 300
+	if !success {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	sha := strings.TrimSpace(string(b))
+	if sha == "" {
+		return "", fmt.Errorf("could not resolve commit %q", target)
+	}
+
+	return sha, nil
+}
@@ -265,6 +265,7 @@ func downloadAsset(dest *destinationWriter, httpClient *http.Client, assetURL, f
 	if err != nil {
 		return err
 	}
+	req = req.WithContext(api.WithIdleRequestTimeout(req.Context()))
 
 	req.Header.Set("Accept", "application/octet-stream")
 	if isArchive {
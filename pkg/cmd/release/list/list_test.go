@@ -151,6 +151,51 @@ func Test_listRun(t *testing.T) {
 			`),
 			wantStderr: ``,
 		},
+		{
+			name:  "exclude drafts",
+			isTTY: true,
+			opts: ListOptions{
+				LimitResults:  30,
+				ExcludeDrafts: true,
+			},
+			wantStdout: heredoc.Doc(`
+				TITLE                  TYPE         TAG NAME      PUBLISHED
+				The big 1.0            Latest       v1.0.0        about 1 day ago
+				1.0 release candidate  Pre-release  v1.0.0-pre.2  about 1 day ago
+				New features                        v0.9.2        about 1 day ago
+			`),
+			wantStderr: ``,
+		},
+		{
+			name:  "exclude pre-releases",
+			isTTY: true,
+			opts: ListOptions{
+				LimitResults:       30,
+				ExcludePreReleases: true,
+			},
+			wantStdout: heredoc.Doc(`
+				TITLE         TYPE    TAG NAME  PUBLISHED
+				v1.1.0        Draft   v1.1.0    about 1 day ago
+				The big 1.0   Latest  v1.0.0    about 1 day ago
+				New features          v0.9.2    about 1 day ago
+			`),
+			wantStderr: ``,
+		},
+		{
+			name:  "exclude drafts and pre-releases",
+			isTTY: true,
+			opts: ListOptions{
+				LimitResults:       30,
+				ExcludeDrafts:      true,
+				ExcludePreReleases: true,
+			},
+			wantStdout: heredoc.Doc(`
+				TITLE         TYPE    TAG NAME  PUBLISHED
+				The big 1.0   Latest  v1.0.0    about 1 day ago
+				New features          v0.9.2    about 1 day ago
+			`),
+			wantStderr: ``,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
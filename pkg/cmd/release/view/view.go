@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
@@ -29,9 +30,11 @@ type ViewOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser
 	Exporter   cmdutil.Exporter
+	Config     func() (config.Config, error)
 
 	TagName string
 	WebMode bool
+	Width   int
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -39,6 +42,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		Browser:    f.Browser,
+		Config:     f.Config,
 	}
 
 	cmd := &cobra.Command{
@@ -67,6 +71,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the release in the browser")
+	cmd.Flags().IntVar(&opts.Width, "width", 0, "Set the width for markdown rendering, defaulting to terminal width")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.ReleaseFields)
 
 	return cmd
@@ -116,7 +121,7 @@ func viewRun(opts *ViewOptions) error {
 	}
 
 	if opts.IO.IsStdoutTTY() {
-		if err := renderReleaseTTY(opts.IO, release); err != nil {
+		if err := renderReleaseTTY(opts, release); err != nil {
 			return err
 		}
 	} else {
@@ -128,7 +133,8 @@ func viewRun(opts *ViewOptions) error {
 	return nil
 }
 
-func renderReleaseTTY(io *iostreams.IOStreams, release *shared.Release) error {
+func renderReleaseTTY(opts *ViewOptions, release *shared.Release) error {
+	io := opts.IO
 	iofmt := io.ColorScheme()
 	w := io.Out
 
@@ -144,9 +150,17 @@ func renderReleaseTTY(io *iostreams.IOStreams, release *shared.Release) error {
 		fmt.Fprintf(w, "%s\n", iofmt.Gray(fmt.Sprintf("%s released this %s", release.Author.Login, text.FuzzyAgo(time.Now(), *release.PublishedAt))))
 	}
 
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	width := opts.Width
+	if width == 0 {
+		width = io.TerminalWidth()
+	}
 	renderedDescription, err := markdown.Render(release.Body,
-		markdown.WithTheme(io.TerminalTheme()),
-		markdown.WithWrap(io.TerminalWidth()))
+		markdown.StyleFromConfig(cfg.GlamourStyle(""), io.TerminalTheme(), io.ErrOut),
+		markdown.WithWrap(width))
 	if err != nil {
 		return err
 	}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -23,9 +26,16 @@ type CloneOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	GitArgs      []string
-	Repository   string
-	UpstreamName string
+	GitArgs           []string
+	Repository        string
+	UpstreamName      string
+	RecurseSubmodules bool
+	SparsePaths       []string
+	Depth             int
+
+	Org        string
+	Visibility string
+	Limit      int
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -39,8 +49,13 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 	cmd := &cobra.Command{
 		DisableFlagsInUseLine: true,
 
-		Use:   "clone <repository> [<directory>] [-- <gitflags>...]",
-		Args:  cmdutil.MinimumArgs(1, "cannot clone: repository argument required"),
+		Use:   "clone [<repository> [<directory>] | --org <org>] [-- <gitflags>...]",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.Org != "" {
+				return cobra.MaximumNArgs(0)(cmd, args)
+			}
+			return cmdutil.MinimumArgs(1, "cannot clone: repository argument required")(cmd, args)
+		},
 		Short: "Clone a repository locally",
 		Long: heredoc.Docf(`
 			Clone a GitHub repository locally. Pass additional %[1]sgit clone%[1]s flags by listing
@@ -55,10 +70,23 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 			the remote after the owner of the parent repository.
 
 			If the repository is a fork, its parent repository will be set as the default remote repository.
+
+			Use %[1]s--recurse-submodules%[1]s to clone submodules, with their remote URLs rewritten to use
+			the same protocol as the parent clone.
+
+			Use %[1]s--sparse%[1]s to perform a partial clone that only populates the working tree with the
+			given paths. The flag can be repeated to include multiple paths.
+
+			Use %[1]s--org%[1]s to clone all of an organization's repositories into the current directory,
+			one subdirectory per repository. Directories that already exist are skipped, and any clone
+			failures are reported without stopping the rest of the run.
 		`, "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.Repository = args[0]
-			opts.GitArgs = args[1:]
+			opts.GitArgs = []string{}
+			if len(args) > 0 {
+				opts.Repository = args[0]
+				opts.GitArgs = args[1:]
+			}
 
 			if runF != nil {
 				return runF(opts)
@@ -69,6 +97,12 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 	}
 
 	cmd.Flags().StringVarP(&opts.UpstreamName, "upstream-remote-name", "u", "upstream", "Upstream remote name when cloning a fork")
+	cmd.Flags().BoolVar(&opts.RecurseSubmodules, "recurse-submodules", false, "Clone submodules as well, configuring their URLs to use the selected protocol")
+	cmd.Flags().StringSliceVar(&opts.SparsePaths, "sparse", nil, "Sparse-checkout `path`; performs a partial clone and includes only the given paths in the working tree")
+	cmd.Flags().IntVar(&opts.Depth, "depth", 0, "Create a shallow clone with a history truncated to the specified number of commits")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Clone all repositories of an `organization` into the current directory")
+	cmdutil.StringEnumFlag(cmd, &opts.Visibility, "visibility", "", "", []string{"public", "private", "internal"}, "Filter `--org` repositories by visibility")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of `--org` repositories to clone")
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -80,6 +114,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 }
 
 func cloneRun(opts *CloneOptions) error {
+	if opts.Org != "" {
+		return cloneOrgRepos(opts)
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -156,11 +194,38 @@ func cloneRun(opts *CloneOptions) error {
 
 	gitClient := opts.GitClient
 	ctx := context.Background()
-	cloneDir, err := gitClient.Clone(ctx, canonicalCloneURL, opts.GitArgs)
+
+	cloneArgs := opts.GitArgs
+	if opts.Depth > 0 {
+		cloneArgs = append(cloneArgs, "--depth", strconv.Itoa(opts.Depth))
+	}
+	if len(opts.SparsePaths) > 0 {
+		cloneArgs = append(cloneArgs, "--filter=blob:none", "--sparse")
+	}
+	if opts.RecurseSubmodules {
+		cloneArgs = append(cloneArgs, "--recurse-submodules")
+		if protocol == "ssh" {
+			// Submodule URLs recorded in .gitmodules are conventionally https; rewrite them
+			// to ssh so submodules are fetched using the same protocol as the parent clone.
+			httpsPrefix := ghinstance.HostPrefix(repo.RepoHost())
+			sshPrefix := fmt.Sprintf("git@%s:", repo.RepoHost())
+			cloneArgs = append(cloneArgs, "-c", fmt.Sprintf("url.%s.insteadOf=%s", sshPrefix, httpsPrefix))
+		}
+	}
+
+	cloneDir, err := gitClient.Clone(ctx, canonicalCloneURL, cloneArgs)
 	if err != nil {
 		return err
 	}
 
+	if len(opts.SparsePaths) > 0 {
+		gc := gitClient.Copy()
+		gc.RepoDir = cloneDir
+		if err := gc.SparseCheckoutSet(ctx, opts.SparsePaths); err != nil {
+			return err
+		}
+	}
+
 	// If the repo is a fork, add the parent as an upstream remote and set the parent as the default repo.
 	if canonicalRepo.Parent != nil {
 		protocol := cfg.GitProtocol(canonicalRepo.Parent.RepoHost())
@@ -198,3 +263,88 @@ func cloneRun(opts *CloneOptions) error {
 	}
 	return nil
 }
+
+// cloneOrgRepos clones every repository belonging to opts.Org into a subdirectory of the
+// current directory named after the repository, skipping any that have already been cloned
+// and reporting failures without aborting the rest of the run.
+func cloneOrgRepos(opts *CloneOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	host, _ := cfg.Authentication().DefaultHost()
+
+	repos, err := listOrgRepos(apiClient, host, opts.Org, opts.Visibility, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	protocol := cfg.GitProtocol(host)
+	gitClient := opts.GitClient
+	ctx := context.Background()
+
+	var failed []string
+	for _, repo := range repos {
+		if _, err := os.Stat(repo.RepoName()); err == nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s already exists, skipping\n", repo.RepoName())
+			continue
+		}
+
+		cloneURL := ghrepo.FormatRemoteURL(repo, protocol)
+		if _, err := gitClient.Clone(ctx, cloneURL, opts.GitArgs); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to clone %s: %v\n", ghrepo.FullName(repo), err)
+			failed = append(failed, ghrepo.FullName(repo))
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Cloned %s\n", ghrepo.FullName(repo))
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to clone %d of %d repositories: %s", len(failed), len(repos), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// listOrgRepos fetches up to limit of an organization's repositories from the REST API,
+// optionally filtered by visibility.
+func listOrgRepos(client *api.Client, hostname, org, visibility string, limit int) ([]ghrepo.Interface, error) {
+	type restRepo struct {
+		Name string `json:"name"`
+	}
+
+	path := fmt.Sprintf("orgs/%s/repos?per_page=100", org)
+	if visibility != "" {
+		path += "&visibility=" + visibility
+	}
+
+	var names []string
+	for path != "" {
+		var page []restRepo
+		var err error
+		path, err = client.RESTWithNext(hostname, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			names = append(names, r.Name)
+			if limit > 0 && len(names) >= limit {
+				path = ""
+				break
+			}
+		}
+	}
+
+	repos := make([]ghrepo.Interface, len(names))
+	for i, name := range names {
+		repos[i] = ghrepo.NewWithHost(org, name, hostname)
+	}
+	return repos, nil
+}
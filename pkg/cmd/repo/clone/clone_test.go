@@ -2,6 +2,7 @@ package clone
 
 import (
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/cli/cli/v2/git"
@@ -54,8 +55,59 @@ func TestNewCmdClone(t *testing.T) {
 		},
 		{
 			name:    "unknown argument",
-			args:    "OWNER/REPO --depth 1",
-			wantErr: "unknown flag: --depth\nSeparate git clone flags with '--'.",
+			args:    "OWNER/REPO --mirror",
+			wantErr: "unknown flag: --mirror\nSeparate git clone flags with '--'.",
+		},
+		{
+			name: "depth flag",
+			args: "OWNER/REPO --depth 1",
+			wantOpts: CloneOptions{
+				Repository: "OWNER/REPO",
+				GitArgs:    []string{},
+				Depth:      1,
+			},
+		},
+		{
+			name: "recurse-submodules flag",
+			args: "OWNER/REPO --recurse-submodules",
+			wantOpts: CloneOptions{
+				Repository:        "OWNER/REPO",
+				GitArgs:           []string{},
+				RecurseSubmodules: true,
+			},
+		},
+		{
+			name: "sparse flag repeated",
+			args: "OWNER/REPO --sparse foo --sparse bar",
+			wantOpts: CloneOptions{
+				Repository:  "OWNER/REPO",
+				GitArgs:     []string{},
+				SparsePaths: []string{"foo", "bar"},
+			},
+		},
+		{
+			name: "org flag without repository argument",
+			args: "--org my-org",
+			wantOpts: CloneOptions{
+				GitArgs: []string{},
+				Org:     "my-org",
+				Limit:   30,
+			},
+		},
+		{
+			name: "org flag with visibility and limit",
+			args: "--org my-org --visibility private --limit 5",
+			wantOpts: CloneOptions{
+				GitArgs:    []string{},
+				Org:        "my-org",
+				Visibility: "private",
+				Limit:      5,
+			},
+		},
+		{
+			name:    "org flag with repository argument",
+			args:    "OWNER/REPO --org my-org",
+			wantErr: "accepts at most 0 arg(s), received 1",
 		},
 	}
 	for _, tt := range testCases {
@@ -90,6 +142,14 @@ func TestNewCmdClone(t *testing.T) {
 
 			assert.Equal(t, tt.wantOpts.Repository, opts.Repository)
 			assert.Equal(t, tt.wantOpts.GitArgs, opts.GitArgs)
+			assert.Equal(t, tt.wantOpts.Depth, opts.Depth)
+			assert.Equal(t, tt.wantOpts.RecurseSubmodules, opts.RecurseSubmodules)
+			assert.Equal(t, tt.wantOpts.SparsePaths, opts.SparsePaths)
+			assert.Equal(t, tt.wantOpts.Org, opts.Org)
+			assert.Equal(t, tt.wantOpts.Visibility, opts.Visibility)
+			if tt.wantOpts.Org != "" {
+				assert.Equal(t, tt.wantOpts.Limit, opts.Limit)
+			}
 		})
 	}
 }
@@ -125,11 +185,7 @@ func runCloneCommand(httpClient *http.Client, cli string) (*test.CmdOut, error)
 
 	_, err = cmd.ExecuteC()
 
-	if err != nil {
-		return nil, err
-	}
-
-	return &test.CmdOut{OutBuf: stdout, ErrBuf: stderr}, nil
+	return &test.CmdOut{OutBuf: stdout, ErrBuf: stderr}, err
 }
 
 func Test_RepoClone(t *testing.T) {
@@ -217,6 +273,60 @@ func Test_RepoClone(t *testing.T) {
 	}
 }
 
+func Test_RepoClone_gitProtocolFromConfig(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git clone git@github.com:OWNER/REPO.git`, 0, "")
+
+	cfg := config.NewBlankConfig()
+	cfg.Set("github.com", "git_protocol", "ssh")
+
+	ios, stdin, stdout, stderr := iostreams.Test()
+	fac := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return httpClient, nil
+		},
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		GitClient: &git.Client{
+			GhPath:  "some/path/gh",
+			GitPath: "some/path/git",
+		},
+	}
+
+	cmd := NewCmdClone(fac, nil)
+
+	argv, err := shlex.Split("OWNER/REPO")
+	require.NoError(t, err)
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(stdin)
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func Test_RepoClone_hasParent(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
@@ -297,6 +407,205 @@ func Test_RepoClone_hasParent_upstreamRemoteName(t *testing.T) {
 	}
 }
 
+func Test_RepoClone_depth(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone --depth 1 https://github.com/OWNER/REPO.git`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --depth 1")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_sparse(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone --filter=blob:none --sparse https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO sparse-checkout set foo bar`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --sparse foo --sparse bar")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_sparse_singlePath(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone --filter=blob:none --sparse https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO sparse-checkout set services/api`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --sparse services/api")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_recurseSubmodules(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone --recurse-submodules https://github.com/OWNER/REPO.git`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --recurse-submodules")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_recurseSubmodules_sshProtocol(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cfg := config.NewBlankConfig()
+	cfg.Set("github.com", "git_protocol", "ssh")
+
+	ios, stdin, stdout, stderr := iostreams.Test()
+	fac := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return httpClient, nil
+		},
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+		GitClient: &git.Client{
+			GhPath:  "some/path/gh",
+			GitPath: "some/path/git",
+		},
+	}
+
+	cmd := NewCmdClone(fac, nil)
+
+	argv, err := shlex.Split("OWNER/REPO --recurse-submodules")
+	require.NoError(t, err)
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(stdin)
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone --recurse-submodules -c url\.git@github\.com:\.insteadOf=https://github\.com/ git@github\.com:OWNER/REPO\.git`, 0, "")
+
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+}
+
+func Test_RepoClone_hasParent_withSparseAndDepth(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					},
+					"parent": {
+						"name": "ORIG",
+						"owner": {
+							"login": "hubot"
+						},
+						"defaultBranchRef": {
+							"name": "trunk"
+						}
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone --depth 1 --filter=blob:none --sparse https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO sparse-checkout set foo`, 0, "")
+	cs.Register(`git -C REPO remote add -t trunk upstream https://github.com/hubot/ORIG.git`, 0, "")
+	cs.Register(`git -C REPO fetch upstream`, 0, "")
+	cs.Register(`git -C REPO remote set-branches upstream *`, 0, "")
+	cs.Register(`git -C REPO config --add remote.upstream.gh-resolved base`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --depth 1 --sparse foo")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
 func Test_RepoClone_withoutUsername(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
@@ -331,3 +640,37 @@ func Test_RepoClone_withoutUsername(t *testing.T) {
 	assert.Equal(t, "", output.String())
 	assert.Equal(t, "", output.Stderr())
 }
+
+func Test_RepoClone_org(t *testing.T) {
+	oldwd, err := os.Getwd()
+	require.NoError(t, err)
+	tempDir := t.TempDir()
+	require.NoError(t, os.Chdir(tempDir))
+	t.Cleanup(func() { _ = os.Chdir(oldwd) })
+
+	require.NoError(t, os.Mkdir("existing-repo", 0755))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/my-org/repos"),
+		httpmock.StringResponse(`[
+			{"name": "existing-repo"},
+			{"name": "new-repo"},
+			{"name": "broken-repo"}
+		]`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone https://github\.com/my-org/new-repo\.git`, 0, "")
+	cs.Register(`git clone https://github\.com/my-org/broken-repo\.git`, 1, "")
+
+	output, err := runCloneCommand(httpClient, "--org my-org")
+	assert.EqualError(t, err, "failed to clone 1 of 3 repositories: my-org/broken-repo")
+
+	assert.Contains(t, output.String(), "Cloned my-org/new-repo\n")
+	assert.Contains(t, output.Stderr(), "existing-repo already exists, skipping\n")
+	assert.Contains(t, output.Stderr(), "failed to clone my-org/broken-repo")
+}
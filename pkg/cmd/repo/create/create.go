@@ -117,7 +117,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			} else {
 				// exactly one visibility flag required
 				if !opts.Public && !opts.Private && !opts.Internal {
-					return cmdutil.FlagErrorf("`--public`, `--private`, or `--internal` required when not running interactively")
+					return cmdutil.NewFlagRequiredOneOfInNonInteractiveError("--public", "--private", "--internal")
 				}
 				err := cmdutil.MutuallyExclusive(
 					"expected exactly one of `--public`, `--private`, or `--internal`",
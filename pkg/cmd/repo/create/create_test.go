@@ -54,7 +54,7 @@ func TestNewCmdCreate(t *testing.T) {
 			tty:      true,
 			cli:      "NEWREPO",
 			wantsErr: true,
-			errMsg:   "`--public`, `--private`, or `--internal` required when not running interactively",
+			errMsg:   "could not prompt: pass one of --public, --private, or --internal to run non-interactively",
 		},
 		{
 			name:     "multiple visibility",
@@ -96,7 +96,7 @@ func TestNewCmdCreate(t *testing.T) {
 				Push:   true,
 			},
 			wantsErr: true,
-			errMsg:   "`--public`, `--private`, or `--internal` required when not running interactively",
+			errMsg:   "could not prompt: pass one of --public, --private, or --internal to run non-interactively",
 		},
 		{
 			name:     "source with template",
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -58,6 +59,7 @@ type EditOptions struct {
 	InteractiveMode bool
 	Detector        fd.Detector
 	Prompter        iprompter
+	Confirmed       bool
 	// Cache of current repo topics to avoid retrieving them
 	// in multiple flows.
 	topicsCache []string
@@ -167,6 +169,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 	cmdutil.NilBoolFlag(cmd, &opts.Edits.AllowUpdateBranch, "allow-update-branch", "", "Allow a pull request head branch that is behind its base branch to be updated")
 	cmd.Flags().StringSliceVar(&opts.AddTopics, "add-topic", nil, "Add repository topic")
 	cmd.Flags().StringSliceVar(&opts.RemoveTopics, "remove-topic", nil, "Remove repository topic")
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt when changing visibility to public")
 
 	return cmd
 }
@@ -222,6 +225,8 @@ func editRun(ctx context.Context, opts *EditOptions) error {
 		if err != nil {
 			return err
 		}
+	} else if err := confirmPublicVisibilityChange(opts); err != nil {
+		return err
 	}
 
 	apiPath := fmt.Sprintf("repos/%s/%s", repo.RepoOwner(), repo.RepoName())
@@ -234,10 +239,14 @@ func editRun(ctx context.Context, opts *EditOptions) error {
 
 	g := errgroup.Group{}
 
+	var visibilityErr error
 	if body.Len() > 3 {
 		g.Go(func() error {
 			apiClient := api.NewClientFromHTTP(opts.HTTPClient)
 			_, err := api.CreateRepoTransformToV4(apiClient, repo.RepoHost(), "PATCH", apiPath, body)
+			if err != nil && opts.Edits.Visibility != nil {
+				visibilityErr = err
+			}
 			return err
 		})
 	}
@@ -269,6 +278,9 @@ func editRun(ctx context.Context, opts *EditOptions) error {
 
 	err := g.Wait()
 	if err != nil {
+		if visibilityErr != nil {
+			return visibilityChangeError(visibilityErr)
+		}
 		return err
 	}
 
@@ -385,14 +397,22 @@ func interactiveRepoEdit(opts *EditOptions, r *api.Repository) error {
 				return err
 			}
 			confirmed := true
-			if visibilityOptions[selected] == "private" &&
-				(r.StargazerCount > 0 || r.Watchers.TotalCount > 0) {
+			switch {
+			case visibilityOptions[selected] == "private" &&
+				(r.StargazerCount > 0 || r.Watchers.TotalCount > 0):
 				cs := opts.IO.ColorScheme()
 				fmt.Fprintf(opts.IO.ErrOut, "%s Changing the repository visibility to private will cause permanent loss of stars and watchers.\n", cs.WarningIcon())
 				confirmed, err = p.Confirm("Do you want to change visibility to private?", false)
 				if err != nil {
 					return err
 				}
+			case visibilityOptions[selected] == "public" && strings.EqualFold(r.Visibility, "private"):
+				cs := opts.IO.ColorScheme()
+				fmt.Fprintf(opts.IO.ErrOut, "%s Changing the repository visibility to public will expose its contents to everyone.\n", cs.WarningIcon())
+				confirmed, err = p.Confirm("Do you want to change visibility to public?", false)
+				if err != nil {
+					return err
+				}
 			}
 			if confirmed {
 				opts.Edits.Visibility = &visibilityOptions[selected]
@@ -463,6 +483,64 @@ func interactiveRepoEdit(opts *EditOptions, r *api.Repository) error {
 	return nil
 }
 
+// confirmPublicVisibilityChange asks for confirmation before a non-interactive
+// --visibility public change that would expose a currently private repository,
+// the same way interactiveRepoEdit does for the wizard's equivalent choice.
+func confirmPublicVisibilityChange(opts *EditOptions) error {
+	if opts.Edits.Visibility == nil || !strings.EqualFold(*opts.Edits.Visibility, "public") {
+		return nil
+	}
+
+	detector := opts.Detector
+	if detector == nil {
+		cachedClient := api.NewCachedHTTPClient(opts.HTTPClient, time.Hour*24)
+		detector = fd.NewDetector(cachedClient, opts.Repository.RepoHost())
+	}
+	repoFeatures, err := detector.RepositoryFeatures()
+	if err != nil || !repoFeatures.VisibilityField {
+		return nil
+	}
+
+	apiClient := api.NewClientFromHTTP(opts.HTTPClient)
+	fetchedRepo, err := api.FetchRepository(apiClient, opts.Repository, []string{"visibility"})
+	if err != nil || !strings.EqualFold(fetchedRepo.Visibility, "private") {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Changing the repository visibility to public will expose its contents to everyone.\n", cs.WarningIcon())
+
+	if opts.Confirmed {
+		return nil
+	}
+	if !opts.IO.CanPrompt() {
+		return cmdutil.FlagErrorf("--yes required to change visibility to public when not running interactively")
+	}
+	confirmed, err := opts.Prompter.Confirm("Do you want to change visibility to public?", false)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return cmdutil.CancelError
+	}
+	return nil
+}
+
+// visibilityChangeError turns a failed visibility PATCH into a message that
+// calls out organization policy, since GitHub's API rejects these changes
+// without saying so explicitly.
+func visibilityChangeError(err error) error {
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && (httpErr.StatusCode == http.StatusForbidden || httpErr.StatusCode == http.StatusUnprocessableEntity) {
+		msg := httpErr.Message
+		if msg == "" {
+			msg = httpErr.Error()
+		}
+		return fmt.Errorf("could not change repository visibility: %s\nThis is often caused by an organization policy that restricts visibility changes; an organization owner may need to adjust it first.", msg)
+	}
+	return err
+}
+
 func parseTopics(s string) []string {
 	topics := strings.Split(s, ",")
 	for i, topic := range topics {
@@ -173,6 +173,140 @@ func Test_editRun(t *testing.T) {
 	}
 }
 
+func Test_editRun_visibilityChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        EditOptions
+		promptStubs func(*prompter.MockPrompter)
+		httpStubs   func(*testing.T, *httpmock.Registry)
+		tty         bool
+		wantsErr    string
+	}{
+		{
+			name: "requires confirmation when not running interactively",
+			opts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("public"),
+				},
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"visibility":"PRIVATE"}}}`))
+			},
+			wantsErr: "--yes required to change visibility to public when not running interactively",
+		},
+		{
+			name: "skips confirmation with --yes",
+			opts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("public"),
+				},
+				Confirmed: true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"visibility":"PRIVATE"}}}`))
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, "public", payload["visibility"])
+					}))
+			},
+		},
+		{
+			name: "prompts for confirmation when running interactively",
+			opts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("public"),
+				},
+			},
+			tty: true,
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterConfirm("Do you want to change visibility to public?", func(_ string, _ bool) (bool, error) {
+					return false, nil
+				})
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"visibility":"PRIVATE"}}}`))
+			},
+			wantsErr: "CancelError",
+		},
+		{
+			name: "no confirmation needed when already public",
+			opts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("public"),
+				},
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"visibility":"PUBLIC"}}}`))
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, "public", payload["visibility"])
+					}))
+			},
+		},
+		{
+			name: "reports organization policy errors in plain language",
+			opts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("private"),
+				},
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.StatusJSONResponse(403, map[string]string{"message": "Visibility cannot be changed due to an organization policy"}))
+			},
+			wantsErr: "could not change repository visibility: Visibility cannot be changed due to an organization policy\nThis is often caused by an organization policy that restricts visibility changes; an organization owner may need to adjust it first.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+			ios.SetStdinTTY(tt.tty)
+			ios.SetStderrTTY(tt.tty)
+
+			httpReg := &httpmock.Registry{}
+			defer httpReg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, httpReg)
+			}
+
+			pm := prompter.NewMockPrompter(t)
+			tt.opts.Prompter = pm
+			if tt.promptStubs != nil {
+				tt.promptStubs(pm)
+			}
+
+			opts := &tt.opts
+			opts.HTTPClient = &http.Client{Transport: httpReg}
+			opts.IO = ios
+
+			err := editRun(context.Background(), opts)
+			if tt.wantsErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantsErr)
+			}
+		})
+	}
+}
+
 func Test_editRun_interactive(t *testing.T) {
 	editList := []string{
 		"Default Branch Name",
@@ -421,6 +555,56 @@ func Test_editRun_interactive(t *testing.T) {
 					}))
 			},
 		},
+		{
+			name: "confirms before changing visibility from private to public",
+			opts: EditOptions{
+				Repository:      ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				InteractiveMode: true,
+			},
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterMultiSelect("What do you want to edit?", nil, editList,
+					func(_ string, _, opts []string) ([]int, error) {
+						return []int{8}, nil
+					})
+				pm.RegisterSelect("Visibility", []string{"public", "private", "internal"},
+					func(_, _ string, opts []string) (int, error) {
+						return prompter.IndexFor(opts, "public")
+					})
+				pm.RegisterConfirm("Do you want to change visibility to public?", func(_ string, _ bool) (bool, error) {
+					return true, nil
+				})
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`
+					{
+						"data": {
+							"repository": {
+								"visibility": "private",
+								"description": "description",
+								"homePageUrl": "https://url.com",
+								"defaultBranchRef": {
+									"name": "main"
+								},
+								"isInOrganization": false,
+								"repositoryTopics": {
+									"nodes": [{
+										"topic": {
+											"name": "x"
+										}
+									}]
+								}
+							}
+						}
+					}`))
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, "public", payload["visibility"])
+					}))
+			},
+		},
 		{
 			name: "updates repo merge options",
 			opts: EditOptions{
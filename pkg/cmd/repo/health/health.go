@@ -0,0 +1,275 @@
+package health
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type HealthOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	RepoArg   string
+	ExitCode  bool
+	Threshold int
+}
+
+func NewCmdHealth(f *cmdutil.Factory, runF func(*HealthOptions) error) *cobra.Command {
+	opts := &HealthOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "health [<repository>]",
+		Short: "Check a repository's community health files",
+		Long: heredoc.Doc(`
+			Check a repository for the community health files GitHub recommends
+			before open-sourcing a project: a README, a LICENSE, a CODE_OF_CONDUCT,
+			a CONTRIBUTING guide, issue and pull request templates, and a
+			SECURITY policy.
+
+			With no argument, checks the current repository.
+
+			Missing files are reported along with the path GitHub expects them at.
+
+			Use '--exit-code' to fail when the health percentage is below
+			'--threshold', which is useful in scripts and CI.
+		`),
+		Example: heredoc.Doc(`
+			# check the current repository
+			$ gh repo health
+
+			# check a specific repository
+			$ gh repo health cli/cli
+
+			# fail if a repository's health score is below 80%
+			$ gh repo health cli/cli --exit-code --threshold 80
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if opts.Threshold < 0 || opts.Threshold > 100 {
+				return cmdutil.FlagErrorf("--threshold must be between 0 and 100")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return healthRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.ExitCode, "exit-code", false, "Exit with non-zero status if the health percentage is below the threshold")
+	cmd.Flags().IntVar(&opts.Threshold, "threshold", 100, "Minimum health percentage required when using `--exit-code`")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, healthFields)
+
+	return cmd
+}
+
+func healthRun(opts *HealthOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var repo ghrepo.Interface
+	if opts.RepoArg == "" {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		repoSelector := opts.RepoArg
+		if !strings.Contains(repoSelector, "/") {
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			hostname, _ := cfg.Authentication().DefaultHost()
+
+			currentUser, err := api.CurrentLoginName(apiClient, hostname)
+			if err != nil {
+				return err
+			}
+			repoSelector = currentUser + "/" + repoSelector
+		}
+
+		repo, err = ghrepo.FromFullName(repoSelector)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	report, err := communityHealth(apiClient, repo)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, report)
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n\n", cs.Bold("Community health for"), cs.Bold(ghrepo.FullName(repo)))
+	}
+
+	t := tableprinter.New(opts.IO, tableprinter.WithHeader("", "FILE", "PATH"))
+	for _, item := range report.Items {
+		if item.Present {
+			t.AddField(cs.SuccessIconWithColor(cs.Green))
+		} else {
+			t.AddField(cs.Red("✗"))
+		}
+		t.AddField(item.Name)
+		if item.Present {
+			t.AddField("")
+		} else {
+			t.AddField(cs.Gray(item.Path))
+		}
+		t.EndRow()
+	}
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(opts.IO.Out)
+	fmt.Fprintf(opts.IO.Out, "%s %d%%\n", cs.Bold("Health percentage:"), report.Percentage)
+
+	if opts.ExitCode && report.Percentage < opts.Threshold {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+type checkItem struct {
+	Name    string `json:"name"`
+	Present bool   `json:"present"`
+	Path    string `json:"path,omitempty"`
+}
+
+// HealthReport summarizes the community health files present in a repository.
+type HealthReport struct {
+	Percentage int         `json:"percentage"`
+	Items      []checkItem `json:"items"`
+}
+
+func (r *HealthReport) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+var healthFields = []string{"percentage", "items"}
+
+// suggestedPaths lists the community health files GitHub checks for, in the
+// order they should be reported, along with the path GitHub recommends
+// creating them at when they're missing.
+var suggestedPaths = []struct {
+	key  string
+	name string
+	path string
+}{
+	{"readme", "README", "README.md"},
+	{"license", "LICENSE", "LICENSE"},
+	{"code_of_conduct", "Code of conduct", "CODE_OF_CONDUCT.md"},
+	{"contributing", "Contributing guidelines", "CONTRIBUTING.md"},
+	{"issue_template", "Issue template", ".github/ISSUE_TEMPLATE.md"},
+	{"pull_request_template", "Pull request template", ".github/PULL_REQUEST_TEMPLATE.md"},
+	{"security", "Security policy", "SECURITY.md"},
+}
+
+type communityProfile struct {
+	HealthPercentage int `json:"health_percentage"`
+	Files            map[string]*struct {
+		Name string `json:"name"`
+	} `json:"files"`
+}
+
+// communityHealth fetches the repository's community profile. Private
+// repositories 404 on this endpoint, so it falls back to checking for each
+// file's existence individually via the contents API.
+func communityHealth(apiClient *api.Client, repo ghrepo.Interface) (*HealthReport, error) {
+	path := fmt.Sprintf("repos/%s/%s/community/profile", repo.RepoOwner(), repo.RepoName())
+	var profile communityProfile
+	err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &profile)
+	if err == nil {
+		items := make([]checkItem, 0, len(suggestedPaths))
+		for _, sp := range suggestedPaths {
+			items = append(items, newCheckItem(sp, profile.Files[sp.key] != nil))
+		}
+		return &HealthReport{Percentage: profile.HealthPercentage, Items: items}, nil
+	}
+
+	httpErr, ok := err.(api.HTTPError)
+	if !ok || httpErr.StatusCode != 404 {
+		return nil, err
+	}
+
+	items := make([]checkItem, 0, len(suggestedPaths))
+	present := 0
+	for _, sp := range suggestedPaths {
+		ok, err := fileExists(apiClient, repo, sp.path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			present++
+		}
+		items = append(items, newCheckItem(sp, ok))
+	}
+
+	percentage := 0
+	if len(suggestedPaths) > 0 {
+		percentage = present * 100 / len(suggestedPaths)
+	}
+
+	return &HealthReport{Percentage: percentage, Items: items}, nil
+}
+
+func newCheckItem(sp struct{ key, name, path string }, present bool) checkItem {
+	item := checkItem{Name: sp.name, Present: present}
+	if !present {
+		item.Path = sp.path
+	}
+	return item
+}
+
+func fileExists(apiClient *api.Client, repo ghrepo.Interface, path string) (bool, error) {
+	apiPath := fmt.Sprintf("repos/%s/%s/contents/%s", repo.RepoOwner(), repo.RepoName(), path)
+	err := apiClient.REST(repo.RepoHost(), "GET", apiPath, nil, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if httpErr, ok := err.(api.HTTPError); ok && httpErr.StatusCode == 404 {
+		return false, nil
+	}
+	return false, err
+}
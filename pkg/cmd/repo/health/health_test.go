@@ -0,0 +1,211 @@
+package health
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdHealth(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  HealthOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no arguments",
+			input:  "",
+			output: HealthOptions{RepoArg: "", ExitCode: false, Threshold: 100},
+		},
+		{
+			name:   "repo argument",
+			input:  "OWNER/REPO",
+			output: HealthOptions{RepoArg: "OWNER/REPO", ExitCode: false, Threshold: 100},
+		},
+		{
+			name:   "exit-code and threshold flags",
+			input:  "OWNER/REPO --exit-code --threshold 80",
+			output: HealthOptions{RepoArg: "OWNER/REPO", ExitCode: true, Threshold: 80},
+		},
+		{
+			name:    "threshold out of range",
+			input:   "OWNER/REPO --threshold 101",
+			wantErr: true,
+			errMsg:  "--threshold must be between 0 and 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *HealthOptions
+			cmd := NewCmdHealth(f, func(opts *HealthOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.output.ExitCode, gotOpts.ExitCode)
+			assert.Equal(t, tt.output.Threshold, gotOpts.Threshold)
+		})
+	}
+}
+
+func TestHealthRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *HealthOptions
+		httpStubs  func(*httpmock.Registry)
+		wantErr    string
+		wantStdout string
+	}{
+		{
+			name: "full community profile",
+			opts: &HealthOptions{},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/community/profile"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"health_percentage": 100,
+						"files": map[string]interface{}{
+							"readme":                map[string]interface{}{"name": "README.md"},
+							"license":               map[string]interface{}{"name": "LICENSE"},
+							"code_of_conduct":       map[string]interface{}{"name": "CODE_OF_CONDUCT.md"},
+							"contributing":          map[string]interface{}{"name": "CONTRIBUTING.md"},
+							"issue_template":        map[string]interface{}{"name": "ISSUE_TEMPLATE.md"},
+							"pull_request_template": map[string]interface{}{"name": "PULL_REQUEST_TEMPLATE.md"},
+							"security":              map[string]interface{}{"name": "SECURITY.md"},
+						},
+					}),
+				)
+			},
+			wantStdout: "Health percentage: 100%\n",
+		},
+		{
+			name: "missing files reported with suggested paths",
+			opts: &HealthOptions{},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/community/profile"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"health_percentage": 43,
+						"files": map[string]interface{}{
+							"readme":                nil,
+							"license":               map[string]interface{}{"name": "LICENSE"},
+							"code_of_conduct":       nil,
+							"contributing":          nil,
+							"issue_template":        nil,
+							"pull_request_template": nil,
+							"security":              nil,
+						},
+					}),
+				)
+			},
+			wantStdout: "Health percentage: 43%\n",
+		},
+		{
+			name: "private repo falls back to contents API",
+			opts: &HealthOptions{},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/community/profile"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/README.md"),
+					httpmock.StatusStringResponse(200, "{}"),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/LICENSE"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/CODE_OF_CONDUCT.md"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/CONTRIBUTING.md"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/ISSUE_TEMPLATE.md"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/PULL_REQUEST_TEMPLATE.md"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/SECURITY.md"),
+					httpmock.StatusStringResponse(404, `{"message":"Not Found"}`),
+				)
+			},
+			wantStdout: "Health percentage: 14%\n",
+		},
+		{
+			name: "exit-code fails below threshold",
+			opts: &HealthOptions{ExitCode: true, Threshold: 80},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/community/profile"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"health_percentage": 43,
+						"files":             map[string]interface{}{},
+					}),
+				)
+			},
+			wantErr: "SilentError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.httpStubs(reg)
+			defer reg.Verify(t)
+
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+
+			err := healthRun(tt.opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, stdout.String(), tt.wantStdout)
+		})
+	}
+}
@@ -22,7 +22,7 @@ type FilterOptions struct {
 	Visibility  string // private, public, internal
 	Fork        bool
 	Source      bool
-	Language    string
+	Language    []string
 	Topic       []string
 	Archived    bool
 	NonArchived bool
@@ -30,7 +30,7 @@ type FilterOptions struct {
 }
 
 func listRepos(client *http.Client, hostname string, limit int, owner string, filter FilterOptions) (*RepositoryList, error) {
-	if filter.Language != "" || filter.Archived || filter.NonArchived || len(filter.Topic) > 0 || filter.Visibility == "internal" {
+	if len(filter.Language) > 0 || filter.Archived || filter.NonArchived || len(filter.Topic) > 0 || filter.Visibility == "internal" {
 		return searchRepos(client, hostname, limit, owner, filter)
 	}
 
@@ -160,6 +160,9 @@ pagination:
 
 		listResult.TotalCount = result.Search.RepositoryCount
 		for _, repo := range result.Search.Nodes {
+			if !matchesAnyLanguage(repo, filter.Language) {
+				continue
+			}
 			if listResult.Owner == "" && repo.NameWithOwner != "" {
 				idx := strings.IndexRune(repo.NameWithOwner, '/')
 				listResult.Owner = repo.NameWithOwner[:idx]
@@ -179,6 +182,24 @@ pagination:
 	return &listResult, nil
 }
 
+// matchesAnyLanguage reports whether repo's primary language matches one of languages.
+// When the search query already carries a single `language:` qualifier, or no language
+// filter was requested, every repo matches here and the check is a no-op.
+func matchesAnyLanguage(repo api.Repository, languages []string) bool {
+	if len(languages) <= 1 {
+		return true
+	}
+	if repo.PrimaryLanguage == nil {
+		return false
+	}
+	for _, l := range languages {
+		if strings.EqualFold(repo.PrimaryLanguage.Name, l) {
+			return true
+		}
+	}
+	return false
+}
+
 func searchQuery(owner string, filter FilterOptions) string {
 	if owner == "" {
 		owner = "@me"
@@ -201,13 +222,21 @@ func searchQuery(owner string, filter FilterOptions) string {
 		archived = &falseBool
 	}
 
+	// A single language maps directly to the `language:` qualifier. Multiple
+	// languages are OR'd client-side in matchesAnyLanguage instead, since
+	// repeating the qualifier would AND them together and match nothing.
+	var language string
+	if len(filter.Language) == 1 {
+		language = filter.Language[0]
+	}
+
 	q := search.Query{
 		Keywords: []string{"sort:updated-desc"},
 		Qualifiers: search.Qualifiers{
 			Archived: archived,
 			Fork:     fork,
 			Is:       []string{filter.Visibility},
-			Language: filter.Language,
+			Language: language,
 			Topic:    filter.Topic,
 			User:     []string{owner},
 		},
@@ -47,7 +47,7 @@ func Test_listReposWithLanguage(t *testing.T) {
 
 	client := http.Client{Transport: &reg}
 	res, err := listRepos(&client, "github.com", 10, "", FilterOptions{
-		Language: "go",
+		Language: []string{"go"},
 	})
 	require.NoError(t, err)
 
@@ -60,6 +60,53 @@ func Test_listReposWithLanguage(t *testing.T) {
 	assert.Equal(t, `sort:updated-desc fork:true language:go user:@me`, searchData.Variables["query"])
 }
 
+func Test_listReposWithMultipleLanguages(t *testing.T) {
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+
+	var searchData struct {
+		Query     string
+		Variables map[string]interface{}
+	}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryListSearch\b`),
+		func(req *http.Request) (*http.Response, error) {
+			jsonData, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = json.Unmarshal(jsonData, &searchData)
+			if err != nil {
+				return nil, err
+			}
+
+			respBody, err := os.Open("./fixtures/repoSearchMultiLanguage.json")
+			if err != nil {
+				return nil, err
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Request:    req,
+				Body:       respBody,
+			}, nil
+		},
+	)
+
+	client := http.Client{Transport: &reg}
+	res, err := listRepos(&client, "github.com", 10, "", FilterOptions{
+		Language: []string{"go", "rust"},
+	})
+	require.NoError(t, err)
+
+	// The query carries no `language:` qualifier for multiple languages; the OR
+	// is applied client-side instead, so only the Go and Rust repos survive.
+	assert.NotContains(t, searchData.Variables["query"], "language:")
+	assert.Equal(t, 2, len(res.Repositories))
+	assert.Equal(t, "octocat/hello-world", res.Repositories[0].NameWithOwner)
+	assert.Equal(t, "octocat/rusty", res.Repositories[1].NameWithOwner)
+}
+
 func Test_searchQuery(t *testing.T) {
 	type args struct {
 		owner  string
@@ -126,7 +173,7 @@ func Test_searchQuery(t *testing.T) {
 			args: args{
 				owner: "",
 				filter: FilterOptions{
-					Language: "ruby",
+					Language: []string{"ruby"},
 				},
 			},
 			want: `sort:updated-desc fork:true language:ruby user:@me`,
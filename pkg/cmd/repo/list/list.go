@@ -24,6 +24,7 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	Exporter   cmdutil.Exporter
 	Detector   fd.Detector
+	ExitCode   bool
 
 	Limit int
 	Owner string
@@ -31,14 +32,17 @@ type ListOptions struct {
 	Visibility  string
 	Fork        bool
 	Source      bool
-	Language    string
+	Language    []string
 	Topic       []string
 	Archived    bool
 	NonArchived bool
+	Columns     []string
 
 	Now func() time.Time
 }
 
+var tableColumns = []string{"name", "description", "info", "updated"}
+
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := ListOptions{
 		IO:         f.IOStreams,
@@ -99,12 +103,14 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of repositories to list")
 	cmd.Flags().BoolVar(&opts.Source, "source", false, "Show only non-forks")
 	cmd.Flags().BoolVar(&opts.Fork, "fork", false, "Show only forks")
-	cmd.Flags().StringVarP(&opts.Language, "language", "l", "", "Filter by primary coding language")
+	cmd.Flags().StringSliceVarP(&opts.Language, "language", "l", nil, "Filter by primary coding language")
 	cmd.Flags().StringSliceVarP(&opts.Topic, "topic", "", nil, "Filter by topic")
 	cmdutil.StringEnumFlag(cmd, &opts.Visibility, "visibility", "", "", []string{"public", "private", "internal"}, "Filter by repository visibility")
 	cmd.Flags().BoolVar(&opts.Archived, "archived", false, "Show only archived repositories")
 	cmd.Flags().BoolVar(&opts.NonArchived, "no-archived", false, "Omit archived repositories")
+	cmdutil.StringSliceEnumFlag(cmd, &opts.Columns, "columns", "", nil, tableColumns, "Only print the specified columns")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.RepositoryFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
 
 	cmd.Flags().BoolVar(&flagPrivate, "private", false, "Show only private repositories")
 	cmd.Flags().BoolVar(&flagPublic, "public", false, "Show only public repositories")
@@ -114,7 +120,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	return cmd
 }
 
-var defaultFields = []string{"nameWithOwner", "description", "isPrivate", "isFork", "isArchived", "createdAt", "pushedAt"}
+var defaultFields = []string{"nameWithOwner", "description", "isPrivate", "isFork", "isArchived", "createdAt", "pushedAt", "url"}
 
 func listRun(opts *ListOptions) error {
 	httpClient, err := opts.HttpClient()
@@ -172,11 +178,36 @@ func listRun(opts *ListOptions) error {
 	defer opts.IO.StopPager()
 
 	if opts.Exporter != nil {
-		return opts.Exporter.Write(opts.IO, listResult.Repositories)
+		if err := opts.Exporter.Write(opts.IO, listResult.Repositories); err != nil {
+			return err
+		}
+		if opts.ExitCode && len(listResult.Repositories) == 0 {
+			return cmdutil.SilentError
+		}
+		return nil
+	}
+
+	showColumn := func(name string) bool {
+		if len(opts.Columns) == 0 {
+			return true
+		}
+		for _, c := range opts.Columns {
+			if c == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	var headers []string
+	for _, c := range tableColumns {
+		if showColumn(c) {
+			headers = append(headers, strings.ToUpper(c))
+		}
 	}
 
 	cs := opts.IO.ColorScheme()
-	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "DESCRIPTION", "INFO", "UPDATED"))
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
 
 	totalMatchCount := len(listResult.Repositories)
 	for _, repo := range listResult.Repositories {
@@ -192,10 +223,21 @@ func listRun(opts *ListOptions) error {
 			t = &repo.CreatedAt
 		}
 
-		tp.AddField(repo.NameWithOwner, tableprinter.WithColor(cs.Bold))
-		tp.AddField(text.RemoveExcessiveWhitespace(repo.Description))
-		tp.AddField(info, tableprinter.WithColor(infoColor))
-		tp.AddTimeField(opts.Now(), *t, cs.Gray)
+		if showColumn("name") {
+			repoURL := repo.URL
+			tp.AddField(repo.NameWithOwner, tableprinter.WithColor(func(s string) string {
+				return cs.Hyperlink(cs.Bold(s), repoURL)
+			}))
+		}
+		if showColumn("description") {
+			tp.AddField(text.RemoveExcessiveWhitespace(repo.Description))
+		}
+		if showColumn("info") {
+			tp.AddField(info, tableprinter.WithColor(infoColor))
+		}
+		if showColumn("updated") {
+			tp.AddTimeField(opts.Now(), *t, cs.Gray)
+		}
 		tp.EndRow()
 	}
 
@@ -203,13 +245,19 @@ func listRun(opts *ListOptions) error {
 		fmt.Fprintln(opts.IO.ErrOut, "warning: this query uses the Search API which is capped at 1000 results maximum")
 	}
 	if opts.IO.IsStdoutTTY() {
-		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || filter.Language != "" || len(filter.Topic) > 0
+		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || len(filter.Language) > 0 || len(filter.Topic) > 0
 		title := listHeader(listResult.Owner, totalMatchCount, listResult.TotalCount, hasFilters)
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
 	if totalMatchCount > 0 {
-		return tp.Render()
+		if err := tp.Render(); err != nil {
+			return err
+		}
+	}
+
+	if opts.ExitCode && totalMatchCount == 0 {
+		return cmdutil.SilentError
 	}
 
 	return nil
@@ -37,7 +37,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -52,7 +52,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -67,7 +67,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -82,7 +82,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "public",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -97,7 +97,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "private",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -112,7 +112,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        true,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -127,7 +127,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      true,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -142,7 +142,22 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "go",
+				Language:    []string{"go"},
+				Topic:       []string(nil),
+				Archived:    false,
+				NonArchived: false,
+			},
+		},
+		{
+			name: "with multiple languages",
+			cli:  "--language go --language rust",
+			wants: ListOptions{
+				Limit:       30,
+				Owner:       "",
+				Visibility:  "",
+				Fork:        false,
+				Source:      false,
+				Language:    []string{"go", "rust"},
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: false,
@@ -157,7 +172,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    true,
 				NonArchived: false,
@@ -172,7 +187,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string(nil),
 				Archived:    false,
 				NonArchived: true,
@@ -187,7 +202,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string{"cli"},
 				Archived:    false,
 				NonArchived: false,
@@ -202,7 +217,7 @@ func TestNewCmdList(t *testing.T) {
 				Visibility:  "",
 				Fork:        false,
 				Source:      false,
-				Language:    "",
+				Language:    nil,
 				Topic:       []string{"cli", "multiple-topic"},
 				Archived:    false,
 				NonArchived: false,
@@ -233,6 +248,27 @@ func TestNewCmdList(t *testing.T) {
 			cli:      "-L 0",
 			wantsErr: "invalid limit: 0",
 		},
+		{
+			name: "with columns",
+			cli:  "--columns name,updated",
+			wants: ListOptions{
+				Limit:       30,
+				Owner:       "",
+				Visibility:  "",
+				Fork:        false,
+				Source:      false,
+				Language:    nil,
+				Topic:       []string(nil),
+				Archived:    false,
+				NonArchived: false,
+				Columns:     []string{"name", "updated"},
+			},
+		},
+		{
+			name:     "with invalid column",
+			cli:      "--columns bogus",
+			wantsErr: "invalid argument \"bogus\" for \"--columns\" flag: valid values are {name|description|info|updated}",
+		},
 	}
 
 	for _, tt := range tests {
@@ -267,6 +303,7 @@ func TestNewCmdList(t *testing.T) {
 			assert.Equal(t, tt.wants.Source, gotOpts.Source)
 			assert.Equal(t, tt.wants.Archived, gotOpts.Archived)
 			assert.Equal(t, tt.wants.NonArchived, gotOpts.NonArchived)
+			assert.Equal(t, tt.wants.Columns, gotOpts.Columns)
 		})
 	}
 }
@@ -347,6 +384,41 @@ func TestRepoList_nontty(t *testing.T) {
 	`), stdout.String())
 }
 
+func TestRepoList_exitCode(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	httpReg := &httpmock.Registry{}
+	defer httpReg.Verify(t)
+
+	httpReg.Register(
+		httpmock.GraphQL(`query RepositoryList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repositoryOwner": { "login": "octocat", "repositories": {
+				"totalCount": 0, "nodes": [], "pageInfo": { "hasNextPage": false }
+			} } } }`),
+	)
+
+	opts := ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: httpReg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Now:      time.Now,
+		Limit:    30,
+		ExitCode: true,
+	}
+
+	err := listRun(&opts)
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "\nThere are no repositories in @octocat\n\n", stdout.String())
+}
+
 func TestRepoList_tty(t *testing.T) {
 	ios, _, stdout, stderr := iostreams.Test()
 	ios.SetStdoutTTY(true)
@@ -392,6 +464,108 @@ func TestRepoList_tty(t *testing.T) {
 	`), stdout.String())
 }
 
+func TestRepoList_hyperlinks(t *testing.T) {
+	httpReg := &httpmock.Registry{}
+	defer httpReg.Verify(t)
+
+	repoListResponse := `{"data":{"repositoryOwner":{"login":"octocat","repositories":{
+		"totalCount": 1,
+		"nodes": [
+			{
+				"nameWithOwner": "octocat/hello-world",
+				"url": "https://github.com/octocat/hello-world",
+				"isFork": false,
+				"isPrivate": false,
+				"isArchived": false,
+				"pushedAt": "2021-02-19T06:34:58Z",
+				"visibility": "PUBLIC"
+			}
+		]
+	}}}}`
+	httpReg.Register(httpmock.GraphQL(`query RepositoryList\b`), httpmock.StringResponse(repoListResponse))
+	httpReg.Register(httpmock.GraphQL(`query RepositoryList\b`), httpmock.StringResponse(repoListResponse))
+
+	newOpts := func(hyperlinksMode string) (*ListOptions, *bytes.Buffer) {
+		ios, _, stdout, _ := iostreams.Test()
+		ios.SetStdoutTTY(true)
+		ios.SetStdinTTY(true)
+		ios.SetStderrTTY(true)
+		ios.SetColorEnabled(true)
+		ios.SetHyperlinksMode(hyperlinksMode)
+		return &ListOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: httpReg}, nil
+			},
+			Config: func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+			Now: func() time.Time {
+				t, _ := time.Parse(time.RFC822, "19 Feb 21 15:00 UTC")
+				return t
+			},
+			Limit: 30,
+		}, stdout
+	}
+
+	enabled, enabledOut := newOpts("always")
+	assert.NoError(t, listRun(enabled))
+	assert.Contains(t, enabledOut.String(), "\033]8;;https://github.com/octocat/hello-world\033\\")
+	assert.Contains(t, enabledOut.String(), "octocat/hello-world")
+	assert.Contains(t, enabledOut.String(), "\033]8;;\033\\")
+
+	disabled, disabledOut := newOpts("never")
+	assert.NoError(t, listRun(disabled))
+	assert.NotContains(t, disabledOut.String(), "\033]8;;")
+	assert.Contains(t, disabledOut.String(), "octocat/hello-world")
+}
+
+func TestRepoList_columns(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	httpReg := &httpmock.Registry{}
+	defer httpReg.Verify(t)
+
+	httpReg.Register(
+		httpmock.GraphQL(`query RepositoryList\b`),
+		httpmock.FileResponse("./fixtures/repoList.json"),
+	)
+
+	opts := ListOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: httpReg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Now: func() time.Time {
+			t, _ := time.Parse(time.RFC822, "19 Feb 21 15:00 UTC")
+			return t
+		},
+		Limit:   30,
+		Columns: []string{"name", "updated"},
+	}
+
+	err := listRun(&opts)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stderr.String())
+
+	assert.Equal(t, heredoc.Doc(`
+
+		Showing 3 of 3 repositories in @octocat
+
+		NAME                 UPDATED
+		octocat/hello-world  about 8 hours ago
+		octocat/cli          about 8 hours ago
+		octocat/testing      about 7 days ago
+	`), stdout.String())
+}
+
 func TestRepoList_filtering(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -11,6 +11,7 @@ import (
 	repoEditCmd "github.com/cli/cli/v2/pkg/cmd/repo/edit"
 	repoForkCmd "github.com/cli/cli/v2/pkg/cmd/repo/fork"
 	gardenCmd "github.com/cli/cli/v2/pkg/cmd/repo/garden"
+	repoHealthCmd "github.com/cli/cli/v2/pkg/cmd/repo/health"
 	repoListCmd "github.com/cli/cli/v2/pkg/cmd/repo/list"
 	repoRenameCmd "github.com/cli/cli/v2/pkg/cmd/repo/rename"
 	repoDefaultCmd "github.com/cli/cli/v2/pkg/cmd/repo/setdefault"
@@ -48,6 +49,7 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 
 	cmdutil.AddGroup(cmd, "Targeted commands",
 		repoViewCmd.NewCmdView(f, nil),
+		repoHealthCmd.NewCmdHealth(f, nil),
 		repoCloneCmd.NewCmdClone(f, nil),
 		repoForkCmd.NewCmdFork(f, nil),
 		repoDefaultCmd.NewCmdSetDefault(f, nil),
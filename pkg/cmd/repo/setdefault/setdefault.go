@@ -86,7 +86,23 @@ func NewCmdSetDefault(f *cmdutil.Factory, runF func(*SetDefaultOptions) error) *
 				}
 			}
 
-			if !opts.ViewMode && !opts.IO.CanPrompt() && opts.Repo == nil {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--view` or `--unset`",
+				opts.ViewMode,
+				opts.UnsetMode,
+			); err != nil {
+				return err
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"cannot specify a repository when using `--unset`",
+				opts.UnsetMode,
+				opts.Repo != nil,
+			); err != nil {
+				return err
+			}
+
+			if !opts.ViewMode && !opts.UnsetMode && !opts.IO.CanPrompt() && opts.Repo == nil {
 				return cmdutil.FlagErrorf("repository required when not running interactively")
 			}
 
@@ -74,6 +74,20 @@ func TestNewCmdSetDefault(t *testing.T) {
 			wantErr: true,
 			errMsg:  "must be run from inside a git repository",
 		},
+		{
+			name:     "view and unset flags",
+			gitStubs: func(cs *run.CommandStubber) {},
+			input:    "--view --unset",
+			wantErr:  true,
+			errMsg:   "specify only one of `--view` or `--unset`",
+		},
+		{
+			name:     "repository argument with unset flag",
+			gitStubs: func(cs *run.CommandStubber) {},
+			input:    "cli/cli --unset",
+			wantErr:  true,
+			errMsg:   "cannot specify a repository when using `--unset`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -118,6 +132,35 @@ func TestNewCmdSetDefault(t *testing.T) {
 	}
 }
 
+func TestNewCmdSetDefault_unsetNonInteractive(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+	io.SetStdinTTY(false)
+	io.SetStderrTTY(false)
+	f := &cmdutil.Factory{
+		IOStreams: io,
+		GitClient: &git.Client{GitPath: "/fake/path/to/git"},
+	}
+
+	var gotOpts *SetDefaultOptions
+	cmd := NewCmdSetDefault(f, func(opts *SetDefaultOptions) error {
+		gotOpts = opts
+		return nil
+	})
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--unset"})
+
+	cs, teardown := run.Stub()
+	defer teardown(t)
+	cs.Register(`git rev-parse --git-dir`, 0, ".git")
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.True(t, gotOpts.UnsetMode)
+}
+
 func TestDefaultRun(t *testing.T) {
 	repo1, _ := ghrepo.FromFullName("OWNER/REPO")
 	repo2, _ := ghrepo.FromFullName("OWNER2/REPO2")
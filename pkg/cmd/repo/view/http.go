@@ -56,6 +56,27 @@ func RepositoryReadme(client *http.Client, repo ghrepo.Interface, branch string)
 	}, nil
 }
 
+// RepositoryContributors fetches every contributor to repo via the REST
+// contributors endpoint, in the descending-by-contributions order the API
+// already returns them in.
+func RepositoryContributors(client *http.Client, repo ghrepo.Interface) ([]api.RepositoryContributor, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/contributors?per_page=100", ghrepo.FullName(repo))
+
+	var contributors []api.RepositoryContributor
+	for path != "" {
+		var page []api.RepositoryContributor
+		var err error
+		path, err = apiClient.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		contributors = append(contributors, page...)
+	}
+
+	return contributors, nil
+}
+
 func getReadmePath(repo ghrepo.Interface, branch string) string {
 	path := fmt.Sprintf("repos/%s/readme", ghrepo.FullName(repo))
 	if branch != "" {
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/config"
@@ -28,9 +31,12 @@ type ViewOptions struct {
 	Exporter   cmdutil.Exporter
 	Config     func() (config.Config, error)
 
-	RepoArg string
-	Web     bool
-	Branch  string
+	RepoArg    string
+	Web        bool
+	Branch     string
+	Width      int
+	Card       bool
+	ReadmeOnly bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -51,7 +57,12 @@ With no argument, the repository for the current directory is displayed.
 
 With '--web', open the repository in a web browser instead.
 
-With '--branch', view a specific branch of the repository.`,
+With '--branch', view a specific branch of the repository.
+
+With '--card', render a compact summary card instead of the README.
+
+With '--readme-only', print only the README, without the repository
+description header.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -66,14 +77,46 @@ With '--branch', view a specific branch of the repository.`,
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open a repository in the browser")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "View a specific branch of the repository")
-	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.RepositoryFields)
+	cmd.Flags().IntVar(&opts.Width, "width", 0, "Set the width for markdown rendering, defaulting to terminal width")
+	cmd.Flags().BoolVar(&opts.Card, "card", false, "Render a compact repository summary card")
+	cmd.Flags().BoolVar(&opts.ReadmeOnly, "readme-only", false, "Print only the README")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, jsonFields)
+
+	cmd.MarkFlagsMutuallyExclusive("readme-only", "web")
+	cmd.MarkFlagsMutuallyExclusive("readme-only", "card")
+	cmd.MarkFlagsMutuallyExclusive("readme-only", "json")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
 	return cmd
 }
 
-var defaultFields = []string{"name", "owner", "description"}
+var defaultFields = []string{"name", "owner", "description", "languages"}
+
+var cardFields = []string{
+	"nameWithOwner", "description", "stargazerCount", "forkCount",
+	"primaryLanguage", "licenseInfo", "pushedAt",
+}
+
+// jsonFields is api.RepositoryFields plus the fields this command resolves
+// itself via extra REST calls rather than the repository GraphQL query.
+var jsonFields = append(api.RepositoryFields, "contributorCount", "topContributors")
+
+// contributorFields are not part of the repository GraphQL schema, so they
+// must be excluded from the fields sent to api.FetchRepository and resolved
+// separately via RepositoryContributors.
+var contributorFields = []string{"contributorCount", "topContributors"}
+
+func withoutContributorFields(fields []string) []string {
+	filtered := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if slices.Contains(contributorFields, f) {
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+	return filtered
+}
 
 func viewRun(opts *ViewOptions) error {
 	httpClient, err := opts.HttpClient()
@@ -109,18 +152,60 @@ func viewRun(opts *ViewOptions) error {
 		}
 	}
 
+	if opts.ReadmeOnly {
+		readme, err := RepositoryReadme(httpClient, toView, opts.Branch)
+		if err != nil {
+			if errors.Is(err, NotFoundError) {
+				return fmt.Errorf("%s does not have a README", ghrepo.FullName(toView))
+			}
+			return err
+		}
+
+		opts.IO.DetectTerminalTheme()
+		if err := opts.IO.StartPager(); err == nil {
+			defer opts.IO.StopPager()
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+		}
+
+		readmeContent := readme.Content
+		if opts.IO.IsStdoutTTY() {
+			readmeContent, err = renderReadmeContent(opts, readme)
+			if err != nil {
+				return err
+			}
+		}
+		fmt.Fprintln(opts.IO.Out, readmeContent)
+		return nil
+	}
+
 	var readme *RepoReadme
 	fields := defaultFields
+	if opts.Card {
+		fields = cardFields
+	}
 	if opts.Exporter != nil {
 		fields = opts.Exporter.Fields()
 	}
 
-	repo, err := api.FetchRepository(apiClient, toView, fields)
+	repo, err := api.FetchRepository(apiClient, toView, withoutContributorFields(fields))
 	if err != nil {
 		return err
 	}
 
-	if !opts.Web && opts.Exporter == nil {
+	if opts.Exporter != nil && (slices.Contains(fields, "contributorCount") || slices.Contains(fields, "topContributors")) {
+		contributors, err := RepositoryContributors(httpClient, toView)
+		if err != nil {
+			return err
+		}
+		repo.ContributorCount = len(contributors)
+		if len(contributors) > 10 {
+			contributors = contributors[:10]
+		}
+		repo.TopContributors = contributors
+	}
+
+	if !opts.Web && !opts.Card && opts.Exporter == nil {
 		readme, err = RepositoryReadme(httpClient, toView, opts.Branch)
 		if err != nil && !errors.Is(err, NotFoundError) {
 			return err
@@ -146,6 +231,10 @@ func viewRun(opts *ViewOptions) error {
 		return opts.Exporter.Write(opts.IO, repo)
 	}
 
+	if opts.Card {
+		return renderCard(opts.IO, repo)
+	}
+
 	fullName := ghrepo.FullName(toView)
 	stdout := opts.IO.Out
 
@@ -164,7 +253,7 @@ func viewRun(opts *ViewOptions) error {
 	repoTmpl := heredoc.Doc(`
 		{{.FullName}}
 		{{.Description}}
-
+		{{.Languages}}
 		{{.Readme}}
 
 		{{.View}}
@@ -180,17 +269,11 @@ func viewRun(opts *ViewOptions) error {
 	var readmeContent string
 	if readme == nil {
 		readmeContent = cs.Gray("This repository does not have a README")
-	} else if isMarkdownFile(readme.Filename) {
-		var err error
-		readmeContent, err = markdown.Render(readme.Content,
-			markdown.WithTheme(opts.IO.TerminalTheme()),
-			markdown.WithWrap(opts.IO.TerminalWidth()),
-			markdown.WithBaseURL(readme.BaseURL))
+	} else {
+		readmeContent, err = renderReadmeContent(opts, readme)
 		if err != nil {
-			return fmt.Errorf("error rendering markdown: %w", err)
+			return err
 		}
-	} else {
-		readmeContent = readme.Content
 	}
 
 	description := repo.Description
@@ -198,14 +281,27 @@ func viewRun(opts *ViewOptions) error {
 		description = cs.Gray("No description provided")
 	}
 
+	var languages string
+	if termWidth := opts.IO.TerminalWidth(); termWidth >= minLanguageBarWidth {
+		barWidth := termWidth
+		if barWidth > maxLanguageBarWidth {
+			barWidth = maxLanguageBarWidth
+		}
+		if bar := renderLanguageBar(repo.Languages.Edges, barWidth); bar != "" {
+			languages = bar + "\n"
+		}
+	}
+
 	repoData := struct {
 		FullName    string
 		Description string
+		Languages   string
 		Readme      string
 		View        string
 	}{
 		FullName:    cs.Bold(fullName),
 		Description: description,
+		Languages:   languages,
 		Readme:      readmeContent,
 		View:        cs.Gray(fmt.Sprintf("View this repository on GitHub: %s", openURL)),
 	}
@@ -213,6 +309,68 @@ func viewRun(opts *ViewOptions) error {
 	return tmpl.Execute(stdout, repoData)
 }
 
+const (
+	minLanguageBarWidth = 40
+	maxLanguageBarWidth = 80
+)
+
+// renderLanguageBar renders a single line of proportional, per-language
+// colored blocks summarizing a repository's language breakdown, matching
+// the order (by size, descending) the languages field is queried in.
+func renderLanguageBar(edges []api.RepositoryLanguageEdge, width int) string {
+	var total int
+	for _, e := range edges {
+		total += e.Size
+	}
+	if total == 0 {
+		return ""
+	}
+
+	var bar strings.Builder
+	used := 0
+	for i, e := range edges {
+		segment := int(float64(e.Size) / float64(total) * float64(width))
+		if i == len(edges)-1 {
+			segment = width - used
+		}
+		if segment <= 0 {
+			continue
+		}
+		used += segment
+
+		block := strings.Repeat("█", segment)
+		if e.Node.Color != "" {
+			block = lipgloss.NewStyle().Foreground(lipgloss.Color(e.Node.Color)).Render(block)
+		}
+		bar.WriteString(block)
+	}
+	return bar.String()
+}
+
+// renderReadmeContent renders readme for display, using glamour if it's a markdown file.
+func renderReadmeContent(opts *ViewOptions, readme *RepoReadme) (string, error) {
+	if !isMarkdownFile(readme.Filename) {
+		return readme.Content, nil
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return "", err
+	}
+	width := opts.Width
+	if width == 0 {
+		width = opts.IO.TerminalWidth()
+	}
+	content, err := markdown.Render(readme.Content,
+		markdown.StyleFromConfig(cfg.GlamourStyle(""), opts.IO.TerminalTheme(), opts.IO.ErrOut),
+		markdown.WithWrap(width),
+		markdown.WithBaseURL(readme.BaseURL))
+	if err != nil {
+		return "", fmt.Errorf("error rendering markdown: %w", err)
+	}
+	return content, nil
+}
+
 func isMarkdownFile(filename string) bool {
 	// kind of gross, but i'm assuming that 90% of the time the suffix will just be .md. it didn't
 	// seem worth executing a regex for this given that assumption.
@@ -222,6 +380,56 @@ func isMarkdownFile(filename string) bool {
 		strings.HasSuffix(filename, ".mkdown")
 }
 
+// renderCard prints a compact, boxed summary of repo suitable for sharing on a single screen.
+func renderCard(io *iostreams.IOStreams, repo *api.Repository) error {
+	cs := io.ColorScheme()
+
+	description := repo.Description
+	if description == "" {
+		description = cs.Gray("No description provided")
+	}
+
+	language := "-"
+	if repo.PrimaryLanguage != nil {
+		language = repo.PrimaryLanguage.Name
+	}
+
+	license := "No license"
+	if repo.LicenseInfo != nil && repo.LicenseInfo.Name != "" {
+		license = repo.LicenseInfo.Name
+	}
+
+	lastPush := "never"
+	if repo.PushedAt != nil {
+		lastPush = text.FuzzyAgo(time.Now(), *repo.PushedAt)
+	}
+
+	width := io.TerminalWidth()
+	if width > 60 {
+		width = 60
+	}
+
+	body := fmt.Sprintf(
+		"%s\n%s\n\n%s  %s  %s  %s\n%s",
+		cs.Bold(repo.NameWithOwner),
+		description,
+		cs.Yellow(fmt.Sprintf("★ %d", repo.StargazerCount)),
+		cs.Cyan(fmt.Sprintf("⑂ %d", repo.ForkCount)),
+		language,
+		license,
+		cs.Gray(fmt.Sprintf("Last push: %s", lastPush)),
+	)
+
+	card := lipgloss.NewStyle().
+		Width(width).
+		Padding(0, 1).
+		BorderStyle(lipgloss.RoundedBorder()).
+		Render(body)
+
+	fmt.Fprintln(io.Out, card)
+	return nil
+}
+
 func generateBranchURL(r ghrepo.Interface, branch string) string {
 	if branch == "" {
 		return ghrepo.GenerateRepoURL(r, "")
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
@@ -58,6 +60,19 @@ func TestNewCmdView(t *testing.T) {
 				Branch:  "feat/awesome",
 			},
 		},
+		{
+			name: "sets readme-only",
+			cli:  "--readme-only",
+			wants: ViewOptions{
+				RepoArg:    "",
+				ReadmeOnly: true,
+			},
+		},
+		{
+			name:     "readme-only and web are mutually exclusive",
+			cli:      "--readme-only --web",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,6 +108,7 @@ func TestNewCmdView(t *testing.T) {
 			assert.Equal(t, tt.wants.Web, gotOpts.Web)
 			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
 			assert.Equal(t, tt.wants.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.wants.ReadmeOnly, gotOpts.ReadmeOnly)
 		})
 	}
 }
@@ -155,6 +171,129 @@ func Test_RepoView_Web(t *testing.T) {
 	}
 }
 
+func Test_ViewRun_Card(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+	{ "data": {
+		"repository": {
+			"nameWithOwner": "OWNER/REPO",
+			"description": "a neat repository",
+			"stargazerCount": 42,
+			"forkCount": 7,
+			"primaryLanguage": { "name": "Go" },
+			"licenseInfo": { "name": "MIT License" },
+			"pushedAt": "2020-08-31T15:44:24+02:00"
+	} } }`))
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+
+	opts := &ViewOptions{
+		Card: true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		IO: io,
+	}
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+
+	out := stdout.String()
+	assert.Contains(t, out, "OWNER/REPO")
+	assert.Contains(t, out, "a neat repository")
+	assert.Contains(t, out, "★ 42")
+	assert.Contains(t, out, "⑂ 7")
+	assert.Contains(t, out, "Go")
+	assert.Contains(t, out, "MIT License")
+	assert.Contains(t, out, "Last push:")
+}
+
+func Test_ViewRun_ReadmeOnly(t *testing.T) {
+	tests := []struct {
+		name      string
+		stdoutTTY bool
+		wantOut   string
+	}{
+		{
+			name:      "tty",
+			stdoutTTY: true,
+			wantOut:   "\n  # truly cool readme check it out                                            \n\n\n",
+		},
+		{
+			name:    "nontty",
+			wantOut: "# truly cool readme check it out\n",
+		},
+	}
+
+	for _, tt := range tests {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/readme"),
+			httpmock.StringResponse(`
+		{ "name": "readme.md",
+		"content": "IyB0cnVseSBjb29sIHJlYWRtZSBjaGVjayBpdCBvdXQ="}`))
+
+		opts := &ViewOptions{
+			ReadmeOnly: true,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			},
+			Config: func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+		}
+
+		io, _, stdout, stderr := iostreams.Test()
+		opts.IO = io
+
+		t.Run(tt.name, func(t *testing.T) {
+			io.SetStdoutTTY(tt.stdoutTTY)
+
+			err := viewRun(opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			assert.Equal(t, "", stderr.String())
+			reg.Verify(t)
+		})
+	}
+}
+
+func Test_ViewRun_ReadmeOnly_NoReadme(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/readme"),
+		httpmock.StatusStringResponse(404, `{}`))
+
+	opts := &ViewOptions{
+		ReadmeOnly: true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	opts.IO = io
+
+	err := viewRun(opts)
+	assert.EqualError(t, err, "OWNER/REPO does not have a README")
+	assert.Equal(t, "", stdout.String())
+	reg.Verify(t)
+}
+
 func Test_ViewRun(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -259,6 +398,9 @@ func Test_ViewRun(t *testing.T) {
 			repo, _ := ghrepo.FromFullName(tt.repoName)
 			return repo, nil
 		}
+		tt.opts.Config = func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		}
 
 		reg := &httpmock.Registry{}
 		reg.Register(
@@ -597,6 +739,9 @@ func Test_ViewRun_HandlesSpecialCharacters(t *testing.T) {
 			repo, _ := ghrepo.FromFullName(tt.repoName)
 			return repo, nil
 		}
+		tt.opts.Config = func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		}
 
 		reg := &httpmock.Registry{}
 		reg.Register(
@@ -665,6 +810,46 @@ func Test_viewRun_json(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func Test_viewRun_json_contributors(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.StubRepoInfoResponse("OWNER", "REPO", "main")
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/contributors"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"login": "monalisa", "contributions": 100},
+			{"login": "hubot", "contributions": 42},
+		}),
+	)
+
+	opts := &ViewOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Exporter: &testExporter{
+			fields: []string{"contributorCount", "topContributors"},
+		},
+	}
+
+	_, teardown := run.Stub()
+	defer teardown(t)
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, heredoc.Doc(`
+		contributorCount: 2
+		topContributors: monalisa (100), hubot (42)
+	`), stdout.String())
+}
+
 type testExporter struct {
 	fields []string
 }
@@ -675,7 +860,21 @@ func (e *testExporter) Fields() []string {
 
 func (e *testExporter) Write(io *iostreams.IOStreams, data interface{}) error {
 	r := data.(*api.Repository)
-	fmt.Fprintf(io.Out, "name: %s\n", r.Name)
-	fmt.Fprintf(io.Out, "defaultBranchRef: %s\n", r.DefaultBranchRef.Name)
+	if slices.Contains(e.fields, "name") {
+		fmt.Fprintf(io.Out, "name: %s\n", r.Name)
+	}
+	if slices.Contains(e.fields, "defaultBranchRef") {
+		fmt.Fprintf(io.Out, "defaultBranchRef: %s\n", r.DefaultBranchRef.Name)
+	}
+	if slices.Contains(e.fields, "contributorCount") {
+		fmt.Fprintf(io.Out, "contributorCount: %d\n", r.ContributorCount)
+	}
+	if slices.Contains(e.fields, "topContributors") {
+		names := make([]string, len(r.TopContributors))
+		for i, c := range r.TopContributors {
+			names[i] = fmt.Sprintf("%s (%d)", c.Login, c.Contributions)
+		}
+		fmt.Fprintf(io.Out, "topContributors: %s\n", strings.Join(names, ", "))
+	}
 	return nil
 }
@@ -3,9 +3,11 @@ package root
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +19,58 @@ type helpTopic struct {
 	example string
 }
 
+// formattingHelpLong assembles the "formatting" help topic body. The
+// functions this repo adds on top of the Go template package's own
+// defaults are listed by generating a bullet from cmdutil.TemplateFuncs's
+// registry instead of hand-duplicating their usage and description here,
+// so the two can't drift out of sync.
+func formattingHelpLong() string {
+	intro := heredoc.Docf(`
+		By default, the result of %[1]sgh%[1]s commands are output in line-based plain text format.
+		Some commands support passing the %[1]s--json%[1]s flag, which converts the output to JSON format.
+		Once in JSON, the output can be further formatted according to a required formatting string by
+		adding either the %[1]s--jq%[1]s or %[1]s--template%[1]s flag. This is useful for selecting a subset of data,
+		creating new data structures, displaying the data in a different format, or as input to another
+		command line script.
+
+		The %[1]s--json%[1]s flag requires a comma separated list of fields to fetch. To view the possible JSON
+		field names for a command, omit the string argument to the %[1]s--json%[1]s flag, or pass it the
+		value %[1]shelp%[1]s, when you run the command. A field name can be followed by a dotted path, e.g.
+		%[1]sauthor.login%[1]s, to keep only that sub-field of the value instead of the whole object; an
+		unknown sub-field errors out listing the ones that are actually present.
+		Note that you must pass the %[1]s--json%[1]s flag and field names to use the %[1]s--jq%[1]s or %[1]s--template%[1]s flags.
+
+		The %[1]s--jq%[1]s flag requires a string argument in jq query syntax, and will only print
+		those JSON values which match the query. jq queries can be used to select elements from an
+		array, fields from an object, create a new array, and more. The %[1]sjq%[1]s utility does not need
+		to be installed on the system to use this formatting directive. When connected to a terminal,
+		the output is automatically pretty-printed. To learn about jq query syntax, see:
+		<https://jqlang.github.io/jq/manual/>
+
+		The %[1]s--template%[1]s flag requires a string argument in Go template syntax, and will only print
+		those JSON values which match the query.
+		In addition to the Go template functions in the standard library, the following functions can be used
+		with this formatting directive:
+	`, "`")
+
+	builtinFuncs := heredoc.Docf(`
+		- %[1]scolor <style> <input>%[1]s: colorize input using <https://github.com/mgutz/ansi>
+		- %[1]sjoin <sep> <list>%[1]s: joins values in the list using a separator
+		- %[1]stablerow <fields>...%[1]s: aligns fields in output vertically as a table
+		- %[1]stablerender%[1]s: renders fields added by tablerow in place
+		- %[1]stimeago <time>%[1]s: renders a timestamp as relative to now
+	`, "`")
+
+	var generatedFuncs strings.Builder
+	for _, line := range cmdutil.TemplateFuncsHelp() {
+		fmt.Fprintf(&generatedFuncs, "- %s\n", line)
+	}
+
+	outro := "\nTo learn more about Go templates, see: <https://golang.org/pkg/text/template/>.\n"
+
+	return intro + builtinFuncs + generatedFuncs.String() + outro
+}
+
 var HelpTopics = []helpTopic{
 	{
 		name:  "mintty",
@@ -99,6 +153,13 @@ var HelpTopics = []helpTopic{
 
 			%[1]sGH_PATH%[1]s: set the path to the gh executable, useful for when gh can not properly determine
 			its own path such as in the cygwin terminal.
+
+			%[1]sGH_NO_SSH_ALIAS%[1]s: set to any value to stop gh from resolving SSH host aliases from
+			%[1]s~/.ssh/config%[1]s when matching git remotes to GitHub repositories.
+
+			%[1]sGH_REQUEST_TIMEOUT%[1]s: the maximum duration, e.g. %[1]s30s%[1]s, a single HTTP request is
+			allowed to run before it's canceled. If not specified, falls back to the %[1]srequest_timeout%[1]s
+			config value, then to no timeout at all.
 		`, "`"),
 	},
 	{
@@ -108,42 +169,7 @@ var HelpTopics = []helpTopic{
 	{
 		name:  "formatting",
 		short: "Formatting options for JSON data exported from gh",
-		long: heredoc.Docf(`
-			By default, the result of %[1]sgh%[1]s commands are output in line-based plain text format.
-			Some commands support passing the %[1]s--json%[1]s flag, which converts the output to JSON format.
-			Once in JSON, the output can be further formatted according to a required formatting string by
-			adding either the %[1]s--jq%[1]s or %[1]s--template%[1]s flag. This is useful for selecting a subset of data,
-			creating new data structures, displaying the data in a different format, or as input to another
-			command line script.
-
-			The %[1]s--json%[1]s flag requires a comma separated list of fields to fetch. To view the possible JSON
-			field names for a command omit the string argument to the %[1]s--json%[1]s flag when you run the command.
-			Note that you must pass the %[1]s--json%[1]s flag and field names to use the %[1]s--jq%[1]s or %[1]s--template%[1]s flags.
-
-			The %[1]s--jq%[1]s flag requires a string argument in jq query syntax, and will only print
-			those JSON values which match the query. jq queries can be used to select elements from an
-			array, fields from an object, create a new array, and more. The %[1]sjq%[1]s utility does not need
-			to be installed on the system to use this formatting directive. When connected to a terminal,
-			the output is automatically pretty-printed. To learn about jq query syntax, see:
-			<https://jqlang.github.io/jq/manual/>
-
-			The %[1]s--template%[1]s flag requires a string argument in Go template syntax, and will only print
-			those JSON values which match the query.
-			In addition to the Go template functions in the standard library, the following functions can be used
-			with this formatting directive:
-			- %[1]sautocolor%[1]s: like %[1]scolor%[1]s, but only emits color to terminals
-			- %[1]scolor <style> <input>%[1]s: colorize input using <https://github.com/mgutz/ansi>
-			- %[1]sjoin <sep> <list>%[1]s: joins values in the list using a separator
-			- %[1]spluck <field> <list>%[1]s: collects values of a field from all items in the input
-			- %[1]stablerow <fields>...%[1]s: aligns fields in output vertically as a table
-			- %[1]stablerender%[1]s: renders fields added by tablerow in place
-			- %[1]stimeago <time>%[1]s: renders a timestamp as relative to now
-			- %[1]stimefmt <format> <time>%[1]s: formats a timestamp using Go's %[1]sTime.Format%[1]s function
-			- %[1]struncate <length> <input>%[1]s: ensures input fits within length
-			- %[1]shyperlink <url> <text>%[1]s: renders a terminal hyperlink
-
-			To learn more about Go templates, see: <https://golang.org/pkg/text/template/>.
-		`, "`"),
+		long:  formattingHelpLong(),
 		example: heredoc.Doc(`
 			# default output format
 			$ gh pr list
@@ -225,7 +251,7 @@ var HelpTopics = []helpTopic{
 
 			# adding the --template flag and modifying the display format
 			$ gh pr list --json number,title,headRefName,updatedAt --template \
-				'{{range .}}{{tablerow (printf "#%v" .number | autocolor "green") .title .headRefName (timeago .updatedAt)}}{{end}}'
+				'{{range .}}{{tablerow (printf "#%v" .number | color "green") .title .headRefName (timeago .updatedAt)}}{{end}}'
 
 			#123  A helpful contribution      contribution-branch       about 1 day ago
 			#124  Improve the docs            docs-branch               about 2 days ago
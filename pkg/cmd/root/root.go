@@ -10,9 +10,11 @@ import (
 	aliasCmd "github.com/cli/cli/v2/pkg/cmd/alias"
 	"github.com/cli/cli/v2/pkg/cmd/alias/shared"
 	apiCmd "github.com/cli/cli/v2/pkg/cmd/api"
+	attestationCmd "github.com/cli/cli/v2/pkg/cmd/attestation"
 	authCmd "github.com/cli/cli/v2/pkg/cmd/auth"
 	browseCmd "github.com/cli/cli/v2/pkg/cmd/browse"
 	cacheCmd "github.com/cli/cli/v2/pkg/cmd/cache"
+	checksCmd "github.com/cli/cli/v2/pkg/cmd/checks"
 	codespaceCmd "github.com/cli/cli/v2/pkg/cmd/codespace"
 	completionCmd "github.com/cli/cli/v2/pkg/cmd/completion"
 	configCmd "github.com/cli/cli/v2/pkg/cmd/config"
@@ -22,6 +24,8 @@ import (
 	gpgKeyCmd "github.com/cli/cli/v2/pkg/cmd/gpg-key"
 	issueCmd "github.com/cli/cli/v2/pkg/cmd/issue"
 	labelCmd "github.com/cli/cli/v2/pkg/cmd/label"
+	milestoneCmd "github.com/cli/cli/v2/pkg/cmd/milestone"
+	notificationsCmd "github.com/cli/cli/v2/pkg/cmd/notifications"
 	orgCmd "github.com/cli/cli/v2/pkg/cmd/org"
 	prCmd "github.com/cli/cli/v2/pkg/cmd/pr"
 	projectCmd "github.com/cli/cli/v2/pkg/cmd/project"
@@ -70,6 +74,36 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 			"versionInfo": versionCmd.Format(version, buildDate),
 		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if noTruncate, _ := cmd.Flags().GetBool("no-truncate"); noTruncate {
+				io.SetTableTruncationDisabled(true)
+			} else if truncate, err := cfg.GetOrDefault("", "display.truncate"); err == nil && truncate == "false" {
+				io.SetTableTruncationDisabled(true)
+			}
+
+			if format, _ := cmd.Flags().GetString("format"); format != "" {
+				if format != "table" && format != "tsv" && format != "csv" {
+					return cmdutil.FlagErrorf("invalid argument %q for \"--format\" flag: valid values are {table|tsv|csv}", format)
+				}
+				io.SetTableFormat(format)
+			}
+			if cmd.Flags().Changed("headers") {
+				headers, _ := cmd.Flags().GetBool("headers")
+				io.SetTableHeaders(headers)
+			}
+
+			if hyperlinks, err := cfg.GetOrDefault("", "display.hyperlinks"); err == nil && hyperlinks != "" {
+				io.SetHyperlinksMode(hyperlinks)
+			}
+
+			if timestamps, _ := cmd.Flags().GetString("timestamps"); timestamps != "" {
+				if timestamps != "relative" && timestamps != "absolute" && timestamps != "iso8601" {
+					return cmdutil.FlagErrorf("invalid argument %q for \"--timestamps\" flag: valid values are {relative|absolute|iso8601}", timestamps)
+				}
+				io.SetTableTimestampFormat(timestamps)
+			} else if timestamps, err := cfg.GetOrDefault("", "display.timestamps"); err == nil && timestamps != "" {
+				io.SetTableTimestampFormat(timestamps)
+			}
+
 			// require that the user is authenticated before running most commands
 			if cmdutil.IsAuthCheckEnabled(cmd) && !cmdutil.CheckAuth(cfg) {
 				parent := cmd.Parent()
@@ -88,6 +122,10 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	// cmd.SetErr(f.IOStreams.ErrOut) // just let it default to os.Stderr instead
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+	cmd.PersistentFlags().Bool("no-truncate", false, "Render table output without truncating any field")
+	cmd.PersistentFlags().String("format", "", "Output format for tabular data: {table|tsv|csv}")
+	cmd.PersistentFlags().Bool("headers", false, "Include or omit the header row in tabular output")
+	cmd.PersistentFlags().String("timestamps", "", "Render table timestamps as {relative|absolute|iso8601}")
 
 	// override Cobra's default behaviors unless an opt-out has been set
 	if os.Getenv("GH_COBRA") == "" {
@@ -123,6 +161,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(versionCmd.NewCmdVersion(f, version, buildDate))
 	cmd.AddCommand(actionsCmd.NewCmdActions(f))
 	cmd.AddCommand(aliasCmd.NewCmdAlias(f))
+	cmd.AddCommand(attestationCmd.NewCmdAttestation(f))
 	cmd.AddCommand(authCmd.NewCmdAuth(f))
 	cmd.AddCommand(configCmd.NewCmdConfig(f))
 	cmd.AddCommand(creditsCmd.NewCmdCredits(f, nil))
@@ -135,6 +174,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(variableCmd.NewCmdVariable(f))
 	cmd.AddCommand(sshKeyCmd.NewCmdSSHKey(f))
 	cmd.AddCommand(statusCmd.NewCmdStatus(f, nil))
+	cmd.AddCommand(notificationsCmd.NewCmdNotifications(f))
 	cmd.AddCommand(codespaceCmd.NewCmdCodespace(f))
 	cmd.AddCommand(projectCmd.NewCmdProject(f))
 
@@ -149,9 +189,11 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(releaseCmd.NewCmdRelease(&repoResolvingCmdFactory))
 	cmd.AddCommand(repoCmd.NewCmdRepo(&repoResolvingCmdFactory))
 	cmd.AddCommand(rulesetCmd.NewCmdRuleset(&repoResolvingCmdFactory))
+	cmd.AddCommand(checksCmd.NewCmdChecks(&repoResolvingCmdFactory, nil))
 	cmd.AddCommand(runCmd.NewCmdRun(&repoResolvingCmdFactory))
 	cmd.AddCommand(workflowCmd.NewCmdWorkflow(&repoResolvingCmdFactory))
 	cmd.AddCommand(labelCmd.NewCmdLabel(&repoResolvingCmdFactory))
+	cmd.AddCommand(milestoneCmd.NewCmdMilestone(&repoResolvingCmdFactory))
 	cmd.AddCommand(cacheCmd.NewCmdCache(&repoResolvingCmdFactory))
 	cmd.AddCommand(apiCmd.NewCmdApi(&repoResolvingCmdFactory, nil))
 
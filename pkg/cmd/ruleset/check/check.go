@@ -123,6 +123,11 @@ func checkRun(opts *CheckOptions) error {
 		opts.Branch = repo.DefaultBranchRef.Name
 	}
 
+	// Only when neither an explicit branch nor `--default` was given are we
+	// checking the branch that a `git push` of the current HEAD would target,
+	// so only then does it make sense to warn about the local HEAD blocking it.
+	checkingCurrentHEAD := opts.Branch == ""
+
 	if opts.Branch == "" {
 		opts.Branch, err = git.CurrentBranch(context.Background())
 		if err != nil {
@@ -159,5 +164,39 @@ func checkRun(opts *CheckOptions) error {
 		fmt.Fprint(w, shared.ParseRulesForDisplay(rules))
 	}
 
+	if checkingCurrentHEAD {
+		return checkSignatureRequirement(opts, rules)
+	}
+
 	return nil
 }
+
+// checkSignatureRequirement warns and exits non-zero when the branch requires
+// signed commits but the local HEAD commit isn't signed, since a push of the
+// current HEAD would be rejected.
+func checkSignatureRequirement(opts *CheckOptions, rules []shared.RulesetRule) error {
+	requiresSignatures := false
+	for _, rule := range rules {
+		if rule.Type == "required_signatures" {
+			requiresSignatures = true
+			break
+		}
+	}
+	if !requiresSignatures {
+		return nil
+	}
+
+	status, err := opts.Git.SignatureStatus(context.Background(), "HEAD")
+	if err != nil {
+		return fmt.Errorf("could not determine signature status of HEAD: %w", err)
+	}
+
+	switch status {
+	case "G", "U":
+		return nil
+	default:
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Branch %s requires signed commits, but the current HEAD commit is not signed; a push would be rejected\n", cs.FailureIcon(), opts.Branch)
+		return cmdutil.SilentError
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -231,3 +233,74 @@ func Test_checkRun(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkRun_currentHEADSignatureRequired(t *testing.T) {
+	tests := []struct {
+		name           string
+		signatureGit   string
+		wantErr        bool
+		wantStderr     string
+		wantSilentExit bool
+	}{
+		{
+			name:         "HEAD has a good signature",
+			signatureGit: "G",
+		},
+		{
+			name:         "HEAD has a good signature of unknown validity",
+			signatureGit: "U",
+		},
+		{
+			name:           "HEAD is unsigned",
+			signatureGit:   "N",
+			wantErr:        true,
+			wantSilentExit: true,
+			wantStderr:     "X Branch my-branch requires signed commits, but the current HEAD commit is not signed; a push would be rejected\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := iostreams.Test()
+			ios.SetStdoutTTY(false)
+			ios.SetStdinTTY(false)
+			ios.SetStderrTTY(false)
+
+			fakeHTTP := &httpmock.Registry{}
+			fakeHTTP.Register(
+				httpmock.REST("GET", "repos/my-org/repo-name/rules/branches/my-branch"),
+				httpmock.FileResponse("./fixtures/rulesetCheck.json"),
+			)
+
+			cs, teardown := run.Stub()
+			defer teardown(t)
+			cs.Register(`git symbolic-ref --quiet HEAD`, 0, "refs/heads/my-branch\n")
+			cs.Register(`git -c log\.ShowSignature=false show -s --pretty=format:%G\? HEAD`, 0, tt.signatureGit)
+
+			opts := &CheckOptions{
+				IO: ios,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: fakeHTTP}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("my-org/repo-name")
+				},
+				Browser: &browser.Stub{},
+				Git:     &git.Client{GitPath: "some/path/git"},
+			}
+
+			err := checkRun(opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.wantSilentExit {
+					assert.Equal(t, cmdutil.SilentError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -25,6 +25,8 @@ type ListOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser.Browser
 
+	Exporter cmdutil.Exporter
+
 	Limit          int
 	IncludeParents bool
 	WebMode        bool
@@ -89,6 +91,8 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().BoolVarP(&opts.IncludeParents, "parents", "p", true, "Whether to include rulesets configured at higher levels that also apply")
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the list of rulesets in the web browser")
 
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.ListFields)
+
 	return cmd
 }
 
@@ -142,6 +146,10 @@ func listRun(opts *ListOptions) error {
 		return shared.NoRulesetsFoundError(opts.Organization, repoI, opts.IncludeParents)
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, result.Rulesets)
+	}
+
 	opts.IO.DetectTerminalTheme()
 	if err := opts.IO.StartPager(); err == nil {
 		defer opts.IO.StopPager()
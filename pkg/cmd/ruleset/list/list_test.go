@@ -340,3 +340,24 @@ func Test_listRun(t *testing.T) {
 		})
 	}
 }
+
+func TestExportRulesets(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	rs := []shared.RulesetGraphQL{{
+		DatabaseId:  42,
+		Name:        "asdf",
+		Target:      "branch",
+		Enforcement: "active",
+		Source: struct {
+			TypeName string `json:"__typename"`
+			Owner    string
+		}{TypeName: "Repository", Owner: "OWNER/REPO"},
+		Rules: struct{ TotalCount int }{TotalCount: 2},
+	}}
+	exporter := cmdutil.NewJSONExporter()
+	exporter.SetFields(shared.ListFields)
+	require.NoError(t, exporter.Write(ios, rs))
+	require.JSONEq(t,
+		`[{"enforcement":"active","id":42,"name":"asdf","rulesCount":2,"source":"OWNER/REPO (repo)","target":"branch"}]`,
+		stdout.String())
+}
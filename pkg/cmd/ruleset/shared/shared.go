@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -9,6 +10,31 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 )
 
+// ListFields are the fields supported by `gh ruleset list --json`.
+var ListFields = []string{
+	"id",
+	"name",
+	"target",
+	"enforcement",
+	"source",
+	"rulesCount",
+}
+
+// ViewFields are the fields supported by `gh ruleset view --json`.
+var ViewFields = []string{
+	"id",
+	"name",
+	"target",
+	"enforcement",
+	"source",
+	"sourceType",
+	"currentUserCanBypass",
+	"bypassActors",
+	"conditions",
+	"rules",
+	"url",
+}
+
 type RulesetGraphQL struct {
 	DatabaseId  int
 	Name        string
@@ -53,6 +79,71 @@ type RulesetRule struct {
 	RulesetId         int    `json:"ruleset_id"`
 }
 
+// ExportData implements cmdutil.Exportable for `gh ruleset list --json`.
+func (rs *RulesetGraphQL) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = rs.DatabaseId
+		case "source":
+			data[f] = RulesetSource(*rs)
+		case "rulesCount":
+			data[f] = rs.Rules.TotalCount
+		default:
+			data[f] = fieldByName(reflect.ValueOf(rs).Elem(), f).Interface()
+		}
+	}
+
+	return data
+}
+
+// ExportData implements cmdutil.Exportable for `gh ruleset view --json`.
+func (rs *RulesetREST) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		switch f {
+		case "currentUserCanBypass":
+			data[f] = rs.CurrentUserCanBypass
+		case "bypassActors":
+			actors := make([]interface{}, 0, len(rs.BypassActors))
+			for _, a := range rs.BypassActors {
+				actors = append(actors, map[string]interface{}{
+					"actorId":    a.ActorId,
+					"actorType":  a.ActorType,
+					"bypassMode": a.BypassMode,
+				})
+			}
+			data[f] = actors
+		case "conditions":
+			data[f] = rs.Conditions
+		case "rules":
+			rules := make([]interface{}, 0, len(rs.Rules))
+			for _, r := range rs.Rules {
+				rules = append(rules, map[string]interface{}{
+					"type":       r.Type,
+					"parameters": r.Parameters,
+				})
+			}
+			data[f] = rules
+		case "url":
+			data[f] = rs.Links.Html.Href
+		default:
+			data[f] = fieldByName(reflect.ValueOf(rs).Elem(), f).Interface()
+		}
+	}
+
+	return data
+}
+
+func fieldByName(v reflect.Value, field string) reflect.Value {
+	return v.FieldByNameFunc(func(s string) bool {
+		return strings.EqualFold(field, s)
+	})
+}
+
 // Returns the source of the ruleset in the format "owner/name (repo)" or "owner (org)"
 func RulesetSource(rs RulesetGraphQL) string {
 	var level string
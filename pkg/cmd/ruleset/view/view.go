@@ -26,6 +26,8 @@ type ViewOptions struct {
 	Browser    browser.Browser
 	Prompter   prompter.Prompter
 
+	Exporter cmdutil.Exporter
+
 	ID              string
 	WebMode         bool
 	IncludeParents  bool
@@ -104,6 +106,8 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Organization, "org", "o", "", "Organization name if the provided ID is an organization-level ruleset")
 	cmd.Flags().BoolVarP(&opts.IncludeParents, "parents", "p", true, "Whether to include rulesets configured at higher levels that also apply")
 
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.ViewFields)
+
 	return cmd
 }
 
@@ -184,6 +188,10 @@ func viewRun(opts *ViewOptions) error {
 		}
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, rs)
+	}
+
 	fmt.Fprintf(w, "\n%s\n", cs.Bold(rs.Name))
 	fmt.Fprintf(w, "ID: %s\n", cs.Cyan(strconv.Itoa(rs.Id)))
 	fmt.Fprintf(w, "Source: %s (%s)\n", rs.Source, rs.SourceType)
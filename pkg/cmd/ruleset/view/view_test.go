@@ -411,3 +411,25 @@ func Test_viewRun(t *testing.T) {
 		})
 	}
 }
+
+func TestExportRuleset(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	rs := &shared.RulesetREST{
+		Id:                   42,
+		Name:                 "asdf",
+		Target:               "branch",
+		Enforcement:          "active",
+		CurrentUserCanBypass: "always",
+		SourceType:           "Repository",
+		Source:               "OWNER/REPO",
+		Rules: []shared.RulesetRule{
+			{Type: "creation"},
+		},
+	}
+	exporter := cmdutil.NewJSONExporter()
+	exporter.SetFields(shared.ViewFields)
+	require.NoError(t, exporter.Write(ios, rs))
+	require.JSONEq(t,
+		`{"bypassActors":[],"conditions":null,"currentUserCanBypass":"always","enforcement":"active","id":42,"name":"asdf","rules":[{"parameters":null,"type":"creation"}],"source":"OWNER/REPO","sourceType":"Repository","target":"branch","url":""}`,
+		stdout.String())
+}
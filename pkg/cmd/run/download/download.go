@@ -3,6 +3,7 @@ package download
 import (
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
@@ -23,11 +24,12 @@ type DownloadOptions struct {
 	DestinationDir string
 	Names          []string
 	FilePatterns   []string
+	Quiet          bool
 }
 
 type platform interface {
 	List(runID string) ([]shared.Artifact, error)
-	Download(url string, dir string) error
+	Download(url string, dir string, size int64, progress io.Writer) error
 }
 type iprompter interface {
 	MultiSelect(string, []string, []string) ([]int, error)
@@ -83,6 +85,8 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			opts.Platform = &apiPlatform{
 				client: httpClient,
 				repo:   baseRepo,
+				io:     opts.IO,
+				quiet:  opts.Quiet,
 			}
 
 			if runF != nil {
@@ -95,6 +99,7 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 	cmd.Flags().StringVarP(&opts.DestinationDir, "dir", "D", ".", "The directory to download artifacts into")
 	cmd.Flags().StringArrayVarP(&opts.Names, "name", "n", nil, "Download artifacts that match any of the given names")
 	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Download artifacts that match a glob pattern")
+	cmd.Flags().BoolVarP(&opts.Quiet, "quiet", "q", false, "Do not print progress while downloading artifacts")
 
 	return cmd
 }
@@ -144,11 +149,9 @@ func runDownload(opts *DownloadOptions) error {
 		}
 	}
 
-	opts.IO.StartProgressIndicator()
-	defer opts.IO.StopProgressIndicator()
-
 	// track downloaded artifacts and avoid re-downloading any of the same name
 	downloaded := set.NewStringSet()
+	toDownload := []shared.Artifact{}
 	for _, a := range artifacts {
 		if a.Expired {
 			continue
@@ -161,19 +164,38 @@ func runDownload(opts *DownloadOptions) error {
 				continue
 			}
 		}
+		downloaded.Add(a.Name)
+		toDownload = append(toDownload, a)
+	}
+
+	if len(toDownload) == 0 {
+		return errors.New("no artifact matches any of the names or patterns provided")
+	}
+
+	// when downloading more than one artifact, track the combined total alongside
+	// each artifact's own progress bar
+	var totalProgress io.WriteCloser
+	if !opts.Quiet && len(toDownload) > 1 {
+		var totalSize int64
+		for _, a := range toDownload {
+			totalSize += int64(a.Size)
+		}
+		totalProgress = opts.IO.StartProgressBar(fmt.Sprintf("Downloading %d artifacts", len(toDownload)), totalSize)
+		defer totalProgress.Close()
+	}
+
+	for _, a := range toDownload {
 		destDir := opts.DestinationDir
 		if len(wantPatterns) != 0 || len(wantNames) != 1 {
 			destDir = filepath.Join(destDir, a.Name)
 		}
-		err := opts.Platform.Download(a.DownloadURL, destDir)
-		if err != nil {
+		var progress io.Writer
+		if totalProgress != nil {
+			progress = totalProgress
+		}
+		if err := opts.Platform.Download(a.DownloadURL, destDir, int64(a.Size), progress); err != nil {
 			return fmt.Errorf("error downloading %s: %w", a.Name, err)
 		}
-		downloaded.Add(a.Name)
-	}
-
-	if downloaded.Len() == 0 {
-		return errors.New("no artifact matches any of the names or patterns provided")
 	}
 
 	return nil
@@ -176,8 +176,8 @@ func Test_runDownload(t *testing.T) {
 						Expired:     false,
 					},
 				}, nil)
-				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1")).Return(nil)
-				p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2")).Return(nil)
+				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1"), int64(0)).Return(nil)
+				p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2"), int64(0)).Return(nil)
 			},
 		},
 		{
@@ -280,7 +280,7 @@ func Test_runDownload(t *testing.T) {
 						Expired:     false,
 					},
 				}, nil)
-				p.On("Download", "http://download.com/artifact2.zip", ".").Return(nil)
+				p.On("Download", "http://download.com/artifact2.zip", ".", int64(0)).Return(nil)
 			},
 			promptStubs: func(pm *prompter.MockPrompter) {
 				pm.RegisterMultiSelect("Select artifacts to download:", nil, []string{"artifact-1", "artifact-2"},
@@ -293,6 +293,7 @@ func Test_runDownload(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			opts := &tt.opts
+			opts.Quiet = true
 			ios, _, stdout, stderr := iostreams.Test()
 			opts.IO = ios
 			opts.Platform = newMockPlatform(t, tt.mockAPI)
@@ -316,6 +317,57 @@ func Test_runDownload(t *testing.T) {
 	}
 }
 
+func Test_runDownload_totalProgress(t *testing.T) {
+	ios, _, _, stderr := iostreams.Test()
+	ios.SetStderrTTY(true)
+
+	opts := &DownloadOptions{
+		IO:             ios,
+		RunID:          "2345",
+		DestinationDir: "./tmp",
+		Prompter:       prompter.NewMockPrompter(t),
+	}
+	opts.Platform = newMockPlatform(t, func(p *mockPlatform) {
+		p.On("List", "2345").Return([]shared.Artifact{
+			{Name: "artifact-1", DownloadURL: "http://download.com/artifact1.zip", Size: 100},
+			{Name: "artifact-2", DownloadURL: "http://download.com/artifact2.zip", Size: 200},
+		}, nil)
+		p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1"), int64(100)).Return(nil)
+		p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2"), int64(200)).Return(nil)
+	})
+
+	require.NoError(t, runDownload(opts))
+
+	out := stderr.String()
+	assert.Contains(t, out, "Downloading 2 artifacts")
+	assert.Contains(t, out, "300B")
+}
+
+func Test_runDownload_quietSuppressesProgress(t *testing.T) {
+	ios, _, _, stderr := iostreams.Test()
+	ios.SetStderrTTY(true)
+
+	opts := &DownloadOptions{
+		IO:             ios,
+		RunID:          "2345",
+		DestinationDir: "./tmp",
+		Quiet:          true,
+		Prompter:       prompter.NewMockPrompter(t),
+	}
+	opts.Platform = newMockPlatform(t, func(p *mockPlatform) {
+		p.On("List", "2345").Return([]shared.Artifact{
+			{Name: "artifact-1", DownloadURL: "http://download.com/artifact1.zip", Size: 100},
+			{Name: "artifact-2", DownloadURL: "http://download.com/artifact2.zip", Size: 200},
+		}, nil)
+		p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("tmp/artifact-1"), int64(100)).Return(nil)
+		p.On("Download", "http://download.com/artifact2.zip", filepath.FromSlash("tmp/artifact-2"), int64(200)).Return(nil)
+	})
+
+	require.NoError(t, runDownload(opts))
+
+	assert.Equal(t, "", stderr.String())
+}
+
 type mockPlatform struct {
 	mock.Mock
 }
@@ -337,7 +389,10 @@ func (p *mockPlatform) List(runID string) ([]shared.Artifact, error) {
 	return args.Get(0).([]shared.Artifact), args.Error(1)
 }
 
-func (p *mockPlatform) Download(url string, dir string) error {
-	args := p.Called(url, dir)
+func (p *mockPlatform) Download(url string, dir string, size int64, progress io.Writer) error {
+	args := p.Called(url, dir, size)
+	if progress != nil {
+		_, _ = progress.Write(make([]byte, size))
+	}
 	return args.Error(0)
 }
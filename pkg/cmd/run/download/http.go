@@ -6,30 +6,39 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
 type apiPlatform struct {
 	client *http.Client
 	repo   ghrepo.Interface
+	io     *iostreams.IOStreams
+	quiet  bool
 }
 
 func (p *apiPlatform) List(runID string) ([]shared.Artifact, error) {
 	return shared.ListArtifacts(p.client, p.repo, runID)
 }
 
-func (p *apiPlatform) Download(url string, dir string) error {
-	return downloadArtifact(p.client, url, dir)
+// Download fetches the artifact at url into destDir, reporting its own progress
+// unless quiet. If progress is non-nil, bytes written are also reported there,
+// letting a caller track a combined total across several artifacts.
+func (p *apiPlatform) Download(url string, dir string, size int64, progress io.Writer) error {
+	label := fmt.Sprintf("Downloading %s", filepath.Base(dir))
+	return downloadArtifact(p.io, p.client, url, dir, label, size, progress, p.quiet)
 }
 
-func downloadArtifact(httpClient *http.Client, url, destDir string) error {
+func downloadArtifact(ios *iostreams.IOStreams, httpClient *http.Client, url, destDir, label string, size int64, progress io.Writer, quiet bool) error {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return err
 	}
+	req = req.WithContext(api.WithIdleRequestTimeout(req.Context()))
 	// The server rejects this :(
 	//req.Header.Set("Accept", "application/zip")
 
@@ -52,12 +61,25 @@ func downloadArtifact(httpClient *http.Client, url, destDir string) error {
 		_ = os.Remove(tmpfile.Name())
 	}()
 
-	size, err := io.Copy(tmpfile, resp.Body)
+	dest := io.Writer(tmpfile)
+	var pb io.WriteCloser
+	if !quiet {
+		pb = ios.StartProgressBar(label, size)
+		dest = io.MultiWriter(dest, pb)
+	}
+	if progress != nil {
+		dest = io.MultiWriter(dest, progress)
+	}
+
+	written, err := io.Copy(dest, resp.Body)
+	if pb != nil {
+		pb.Close()
+	}
 	if err != nil {
 		return fmt.Errorf("error writing zip archive: %w", err)
 	}
 
-	zipfile, err := zip.NewReader(tmpfile, size)
+	zipfile, err := zip.NewReader(tmpfile, written)
 	if err != nil {
 		return fmt.Errorf("error extracting zip archive: %w", err)
 	}
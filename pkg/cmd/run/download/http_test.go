@@ -10,6 +10,7 @@ import (
 
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -67,11 +68,15 @@ func Test_Download(t *testing.T) {
 		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts/12345/zip"),
 		httpmock.FileResponse("./fixtures/myproject.zip"))
 
+	ios, _, _, _ := iostreams.Test()
 	api := &apiPlatform{
 		client: &http.Client{Transport: reg},
+		io:     ios,
 	}
-	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", destDir)
+	progress := &fakeWriter{}
+	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", destDir, 0, progress)
 	require.NoError(t, err)
+	assert.Equal(t, int64(1710), progress.total)
 
 	var paths []string
 	parentPrefix := tmpDir + string(filepath.Separator)
@@ -104,3 +109,38 @@ func Test_Download(t *testing.T) {
 		filepath.Join("artifact", "src", "util.go"),
 	}, paths)
 }
+
+func Test_Download_quiet(t *testing.T) {
+	tmpDir := t.TempDir()
+	destDir := filepath.Join(tmpDir, "artifact")
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/artifacts/12345/zip"),
+		httpmock.FileResponse("./fixtures/myproject.zip"))
+
+	ios, _, _, stderr := iostreams.Test()
+	ios.SetStderrTTY(true)
+	api := &apiPlatform{
+		client: &http.Client{Transport: reg},
+		io:     ios,
+		quiet:  true,
+	}
+	progress := &fakeWriter{}
+	err := api.Download("https://api.github.com/repos/OWNER/REPO/actions/artifacts/12345/zip", destDir, 0, progress)
+	require.NoError(t, err)
+
+	assert.Equal(t, "", stderr.String())
+	assert.Equal(t, int64(1710), progress.total)
+}
+
+type fakeWriter struct {
+	total int64
+}
+
+func (w *fakeWriter) Write(p []byte) (int, error) {
+	w.total += int64(len(p))
+	return len(p), nil
+}
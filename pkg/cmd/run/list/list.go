@@ -26,6 +26,7 @@ type ListOptions struct {
 	Prompter   iprompter
 
 	Exporter cmdutil.Exporter
+	ExitCode bool
 
 	Limit            int
 	WorkflowSelector string
@@ -81,6 +82,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Commit, "commit", "c", "", "Filter runs by the `SHA` of the commit")
 	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "s", "", shared.AllStatuses, "Filter runs by status")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
@@ -126,8 +128,18 @@ func listRun(opts *ListOptions) error {
 		return fmt.Errorf("failed to get runs: %w", err)
 	}
 	runs := runsResult.WorkflowRuns
-	if len(runs) == 0 && opts.Exporter == nil {
-		return cmdutil.NewNoResultsError("no runs found")
+	if len(runs) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			return cmdutil.NewNoResultsError("no runs found")
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(opts.IO, runs); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
+		}
 	}
 
 	if err := opts.IO.StartPager(); err == nil {
@@ -156,7 +168,10 @@ func listRun(opts *ListOptions) error {
 		tp.AddField(run.WorkflowName())
 		tp.AddField(run.HeadBranch, tableprinter.WithColor(cs.Bold))
 		tp.AddField(string(run.Event))
-		tp.AddField(fmt.Sprintf("%d", run.ID), tableprinter.WithColor(cs.Cyan))
+		runURL := run.URL
+		tp.AddField(fmt.Sprintf("%d", run.ID), tableprinter.WithColor(func(s string) string {
+			return cs.Hyperlink(cs.Cyan(s), runURL)
+		}))
 		tp.AddField(run.Duration(opts.now).String())
 		tp.AddTimeField(opts.now, run.StartedTime(), cs.Gray)
 		tp.EndRow()
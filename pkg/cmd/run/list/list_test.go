@@ -497,6 +497,22 @@ func TestListRun(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "no runs found",
 		},
+		{
+			name: "exit code with no results",
+			opts: &ListOptions{
+				Limit:    defaultLimit,
+				ExitCode: true,
+			},
+			isTTY: true,
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{}),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: "SilentError",
+		},
 	}
 
 	for _, tt := range tests {
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmd/run/watch"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -27,6 +29,7 @@ type RerunOptions struct {
 	OnlyFailed bool
 	JobID      string
 	Debug      bool
+	Watch      bool
 
 	Prompt bool
 }
@@ -82,6 +85,7 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 	cmd.Flags().BoolVar(&opts.OnlyFailed, "failed", false, "Rerun only failed jobs, including dependencies")
 	cmd.Flags().StringVarP(&opts.JobID, "job", "j", "", "Rerun a specific job from a run, including dependencies")
 	cmd.Flags().BoolVarP(&opts.Debug, "debug", "d", false, "Rerun with debug logging")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch the rerun until it completes, showing its progress")
 
 	return cmd
 }
@@ -144,6 +148,7 @@ func runRerun(opts *RerunOptions) error {
 		if err != nil {
 			return err
 		}
+		runID = fmt.Sprintf("%d", selectedJob.RunID)
 		if opts.IO.IsStdoutTTY() {
 			fmt.Fprintf(opts.IO.Out, "%s Requested rerun of job %s on run %s%s\n",
 				cs.SuccessIcon(),
@@ -163,6 +168,7 @@ func runRerun(opts *RerunOptions) error {
 		if err != nil {
 			return err
 		}
+		runID = fmt.Sprintf("%d", run.ID)
 		if opts.IO.IsStdoutTTY() {
 			onlyFailedMsg := ""
 			if opts.OnlyFailed {
@@ -176,7 +182,21 @@ func runRerun(opts *RerunOptions) error {
 		}
 	}
 
-	return nil
+	if !opts.Watch {
+		return nil
+	}
+
+	// The rerun creates a new attempt of the same run ID, so watching that ID
+	// picks up the new attempt once GitHub starts queuing it.
+	return watch.WatchRun(&watch.WatchOptions{
+		IO:         opts.IO,
+		HttpClient: opts.HttpClient,
+		BaseRepo:   opts.BaseRepo,
+		Prompter:   opts.Prompter,
+		RunID:      runID,
+		Interval:   3,
+		Now:        time.Now,
+	})
 }
 
 func rerunRun(client *api.Client, repo ghrepo.Interface, run *shared.Run, onlyFailed, debug bool) error {
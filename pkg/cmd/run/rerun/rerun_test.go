@@ -119,6 +119,14 @@ func TestNewCmdRerun(t *testing.T) {
 				Debug: false,
 			},
 		},
+		{
+			name: "watch",
+			cli:  "4321 --watch",
+			wants: RerunOptions{
+				RunID: "4321",
+				Watch: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -370,6 +378,41 @@ func TestRerun(t *testing.T) {
 			wantErr: true,
 			errOut:  "no recent runs have failed; please specify a specific `<run-id>`",
 		},
+		{
+			name: "arg including watch",
+			tty:  true,
+			opts: &RerunOptions{
+				RunID: "1234",
+				Watch: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/rerun"),
+					httpmock.StringResponse("{}"))
+				// WatchRun's own lookup of the (already completed, per this stub) run.
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+			wantOut: "✓ Requested rerun of run 1234\nRun  (1234) has already completed with 'failure'\n",
+		},
 		{
 			name: "unrerunnable",
 			tty:  true,
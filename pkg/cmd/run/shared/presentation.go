@@ -25,6 +25,16 @@ func RenderRunHeader(cs *iostreams.ColorScheme, run Run, ago, prNumber string, a
 	return header
 }
 
+func RenderAttempts(cs *iostreams.ColorScheme, attempts []Run) string {
+	lines := []string{}
+	for _, attempt := range attempts {
+		symbol, symbolColor := Symbol(cs, attempt.Status, attempt.Conclusion)
+		lines = append(lines, fmt.Sprintf("%s Attempt #%d", symbolColor(symbol), attempt.Attempts))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func RenderJobs(cs *iostreams.ColorScheme, jobs []Job, verbose bool) string {
 	lines := []string{}
 	for _, job := range jobs {
@@ -69,6 +69,7 @@ var RunFields = []string{
 	"displayTitle",
 	"headBranch",
 	"headSha",
+	"headCommit",
 	"createdAt",
 	"updatedAt",
 	"startedAt",
@@ -80,6 +81,7 @@ var RunFields = []string{
 	"workflowDatabaseId",
 	"workflowName",
 	"url",
+	"attempt",
 }
 
 var SingleRunFields = append(RunFields, "jobs")
@@ -134,7 +136,14 @@ type Repo struct {
 }
 
 type Commit struct {
+	ID      string
 	Message string
+	Author  CommitAuthor
+}
+
+type CommitAuthor struct {
+	Name  string
+	Email string
 }
 
 // Title is the display title for a run, falling back to the commit subject if unavailable
@@ -174,6 +183,17 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 			data[f] = r.WorkflowID
 		case "workflowName":
 			data[f] = r.WorkflowName()
+		case "attempt":
+			data[f] = r.Attempts
+		case "headCommit":
+			data[f] = map[string]interface{}{
+				"sha":     r.HeadCommit.ID,
+				"message": r.HeadCommit.Message,
+				"author": map[string]interface{}{
+					"name":  r.HeadCommit.Author.Name,
+					"email": r.HeadCommit.Author.Email,
+				},
+			}
 		case "jobs":
 			jobs := make([]interface{}, 0, len(r.Jobs))
 			for _, j := range r.Jobs {
@@ -526,6 +546,37 @@ func GetRun(client *api.Client, repo ghrepo.Interface, runID string, attempt uin
 	return &result, nil
 }
 
+// GetAttempts fetches a summary of every attempt made at the given run, in order from the
+// first attempt to the latest. It is only meaningful to call this for a run with more than
+// one attempt.
+func GetAttempts(client *api.Client, repo ghrepo.Interface, run *Run) ([]Run, error) {
+	attempts := make([]Run, 0, run.Attempts)
+	for i := uint64(1); i <= run.Attempts; i++ {
+		attempt, err := GetRun(client, repo, fmt.Sprintf("%d", run.ID), i)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, *attempt)
+	}
+	return attempts, nil
+}
+
+// AttemptNotFoundError reports that the requested attempt does not exist for the run, and
+// lists the attempt numbers that do.
+func AttemptNotFoundError(client *api.Client, repo ghrepo.Interface, runID string, attempt uint64, run *Run) error {
+	if run == nil {
+		var err error
+		run, err = GetRun(client, repo, runID, 0)
+		if err != nil {
+			return fmt.Errorf("no attempt %d found for run %s", attempt, runID)
+		}
+	}
+	if run.Attempts <= 1 {
+		return fmt.Errorf("no attempt %d found for run %s; run has only 1 attempt", attempt, runID)
+	}
+	return fmt.Errorf("no attempt %d found for run %s; valid attempts are 1-%d", attempt, runID, run.Attempts)
+}
+
 type colorFunc func(string) string
 
 func Symbol(cs *iostreams.ColorScheme, status Status, conclusion Conclusion) (string, colorFunc) {
@@ -58,6 +58,48 @@ func TestGetAnnotations404(t *testing.T) {
 	assert.Equal(t, result, []Annotation{})
 }
 
+func TestGetAttempts(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/123/attempts/1"),
+		httpmock.JSONResponse(TestRun(123, Completed, Failure)))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(TestWorkflow))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/123/attempts/2"),
+		httpmock.JSONResponse(TestRun(123, Completed, Success)))
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+		httpmock.JSONResponse(TestWorkflow))
+
+	httpClient := &http.Client{Transport: reg}
+	apiClient := api.NewClientFromHTTP(httpClient)
+	repo := ghrepo.New("OWNER", "REPO")
+
+	run := TestRun(123, Completed, Success)
+	run.Attempts = 2
+
+	attempts, err := GetAttempts(apiClient, repo, &run)
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	assert.Equal(t, Failure, attempts[0].Conclusion)
+	assert.Equal(t, Success, attempts[1].Conclusion)
+}
+
+func TestAttemptNotFoundError(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	run := TestRun(123, Completed, Success)
+	run.Attempts = 2
+
+	err := AttemptNotFoundError(nil, nil, "123", 5, &run)
+	assert.EqualError(t, err, "no attempt 5 found for run 123; valid attempts are 1-2")
+}
+
 func TestRun_Duration(t *testing.T) {
 	now, _ := time.Parse(time.RFC3339, "2022-07-20T11:22:58Z")
 
@@ -189,6 +231,29 @@ func TestRunExportData(t *testing.T) {
 			},
 			output: `{"jobs":[{"completedAt":"2022-07-20T11:21:16Z","conclusion":"success","databaseId":123456,"name":"macos","startedAt":"2022-07-20T11:20:13Z","status":"completed","steps":[{"conclusion":"success","name":"Checkout","number":1,"status":"completed"}],"url":"https://example.com/OWNER/REPO/actions/runs/123456"},{"completedAt":"2022-07-20T11:23:16Z","conclusion":"error","databaseId":234567,"name":"windows","startedAt":"2022-07-20T11:20:55Z","status":"completed","steps":[{"conclusion":"error","name":"Checkout","number":2,"status":"completed"}],"url":"https://example.com/OWNER/REPO/actions/runs/234567"}]}`,
 		},
+		{
+			name:   "exports workflow run's head commit",
+			fields: []string{"headCommit"},
+			run: Run{
+				HeadCommit: Commit{
+					ID:      "1234567890abcdef1234567890abcdef12345678",
+					Message: "Fix flaky test\n\nRetries the download step.",
+					Author: CommitAuthor{
+						Name:  "Mona Lisa",
+						Email: "mona@example.com",
+					},
+				},
+			},
+			output: `{"headCommit":{"author":{"email":"mona@example.com","name":"Mona Lisa"},"message":"Fix flaky test\n\nRetries the download step.","sha":"1234567890abcdef1234567890abcdef12345678"}}`,
+		},
+		{
+			name:   "exports workflow run's attempt number",
+			fields: []string{"attempt"},
+			run: Run{
+				Attempts: 3,
+			},
+			output: `{"attempt":3}`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -39,6 +39,7 @@ func TestRunWithCommit(id int64, s Status, c Conclusion, commit string) Run {
 
 var SuccessfulRun Run = TestRun(3, Completed, Success)
 var FailedRun Run = TestRun(1234, Completed, Failure)
+var CancelledRun Run = TestRun(5, Completed, Cancelled)
 
 var TestRuns []Run = []Run{
 	TestRun(1, Completed, TimedOut),
@@ -109,6 +110,25 @@ var FailedJob Job = Job{
 	},
 }
 
+var CancelledJob Job = Job{
+	ID:          30,
+	Status:      Completed,
+	Conclusion:  Cancelled,
+	Name:        "stopped job",
+	StartedAt:   TestRunStartTime,
+	CompletedAt: TestRunStartTime.Add(time.Minute*4 + time.Second*34),
+	URL:         "https://github.com/jobs/30",
+	RunID:       5,
+	Steps: []Step{
+		{
+			Name:       "fob the barz",
+			Status:     Completed,
+			Conclusion: Cancelled,
+			Number:     1,
+		},
+	},
+}
+
 var FailedJobAnnotations []Annotation = []Annotation{
 	{
 		JobName:   "sad job",
@@ -217,6 +217,12 @@ func runView(opts *ViewOptions) error {
 	run, err = shared.GetRun(client, repo, runID, attempt)
 	opts.IO.StopProgressIndicator()
 	if err != nil {
+		if attempt > 0 {
+			var httpError api.HTTPError
+			if errors.As(err, &httpError) && httpError.StatusCode == 404 {
+				return shared.AttemptNotFoundError(client, repo, runID, attempt, nil)
+			}
+		}
 		return fmt.Errorf("failed to get run: %w", err)
 	}
 
@@ -305,6 +311,16 @@ func runView(opts *ViewOptions) error {
 		}
 	}
 
+	var attempts []shared.Run
+	if selectedJob == nil && attempt == 0 && run.Attempts > 1 {
+		opts.IO.StartProgressIndicator()
+		attempts, err = shared.GetAttempts(client, repo, run)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to get run attempts: %w", err)
+		}
+	}
+
 	var annotations []shared.Annotation
 	for _, job := range jobs {
 		as, err := shared.GetAnnotations(client, repo, job)
@@ -347,6 +363,12 @@ func runView(opts *ViewOptions) error {
 		fmt.Fprintln(out, shared.RenderAnnotations(cs, annotations))
 	}
 
+	if len(attempts) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, cs.Bold("ATTEMPTS"))
+		fmt.Fprintln(out, shared.RenderAttempts(cs, attempts))
+	}
+
 	if selectedJob == nil {
 		if len(artifacts) > 0 {
 			fmt.Fprintln(out)
@@ -370,7 +392,7 @@ func runView(opts *ViewOptions) error {
 		}
 		fmt.Fprintf(out, cs.Gray("View this run on GitHub: %s\n"), run.URL)
 
-		if opts.ExitStatus && shared.IsFailureState(run.Conclusion) {
+		if opts.ExitStatus && isExitStatusFailure(run.Conclusion) {
 			return cmdutil.SilentError
 		}
 	} else {
@@ -382,7 +404,7 @@ func runView(opts *ViewOptions) error {
 		}
 		fmt.Fprintf(out, cs.Gray("View this run on GitHub: %s\n"), run.URL)
 
-		if opts.ExitStatus && shared.IsFailureState(selectedJob.Conclusion) {
+		if opts.ExitStatus && isExitStatusFailure(selectedJob.Conclusion) {
 			return cmdutil.SilentError
 		}
 	}
@@ -390,6 +412,14 @@ func runView(opts *ViewOptions) error {
 	return nil
 }
 
+// isExitStatusFailure reports whether --exit-status should cause the command to
+// exit non-zero for the given conclusion. This is broader than shared.IsFailureState,
+// which also drives verbose-step display, because a cancelled run is not a "failure"
+// worth expanding steps for but should still fail CI invocations relying on --exit-status.
+func isExitStatusFailure(c shared.Conclusion) bool {
+	return shared.IsFailureState(c) || c == shared.Cancelled
+}
+
 func shouldFetchJobs(opts *ViewOptions) bool {
 	if opts.Prompt {
 		return true
@@ -268,6 +268,83 @@ func TestViewRun(t *testing.T) {
 			},
 			wantOut: "\n✓ trunk CI OWNER/REPO#2898 · 3 (Attempt #3)\nTriggered via push about 59 minutes ago\n\nJOBS\n✓ cool job in 4m34s (ID 10)\n\nFor more information about the job, try: gh run view --job=10\nView this run on GitHub: https://github.com/runs/3/attempts/3\n",
 		},
+		{
+			name: "multiple attempts",
+			opts: &ViewOptions{
+				RunID: "3",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				run := shared.SuccessfulRun
+				run.Attempts = 3
+				attempt1 := shared.TestRun(3, shared.Completed, shared.TimedOut)
+				attempt1.Attempts = 1
+				attempt2 := shared.TestRun(3, shared.Completed, shared.Skipped)
+				attempt2.Attempts = 2
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(run))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/artifacts"),
+					httpmock.StringResponse(`{}`))
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestForRun`),
+					httpmock.StringResponse(``))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.SuccessfulJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/10/annotations"),
+					httpmock.JSONResponse([]shared.Annotation{}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/attempts/1"),
+					httpmock.JSONResponse(attempt1))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/attempts/2"),
+					httpmock.JSONResponse(attempt2))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/attempts/3"),
+					httpmock.JSONResponse(run))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+			wantOut: "\n✓ trunk CI · 3\nTriggered via push about 59 minutes ago\n\nJOBS\n✓ cool job in 4m34s (ID 10)\n\nATTEMPTS\nX Attempt #1\n- Attempt #2\n✓ Attempt #3\n\nFor more information about the job, try: gh run view --job=10\nView this run on GitHub: https://github.com/runs/3\n",
+		},
+		{
+			name: "attempt not found",
+			opts: &ViewOptions{
+				RunID:   "3",
+				Attempt: 5,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				run := shared.SuccessfulRun
+				run.Attempts = 3
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/attempts/5"),
+					httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(run))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+			wantErr: true,
+			errMsg:  "no attempt 5 found for run 3; valid attempts are 1-3",
+		},
 		{
 			name: "exit status, failed run",
 			opts: &ViewOptions{
@@ -424,6 +501,39 @@ func TestViewRun(t *testing.T) {
 			},
 			wantOut: "\n✓ trunk CI · 3\nTriggered via push about 59 minutes ago\n\nJOBS\n✓ cool job in 4m34s (ID 10)\n\nFor more information about the job, try: gh run view --job=10\nView this run on GitHub: https://github.com/runs/3\n",
 		},
+		{
+			name: "exit status, cancelled run",
+			opts: &ViewOptions{
+				RunID:      "5",
+				ExitStatus: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/5"),
+					httpmock.JSONResponse(shared.CancelledRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/5/artifacts"),
+					httpmock.StringResponse(`{}`))
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestForRun`),
+					httpmock.StringResponse(``))
+				reg.Register(
+					httpmock.REST("GET", "runs/5/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.CancelledJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/30/annotations"),
+					httpmock.JSONResponse([]shared.Annotation{}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+			wantOut: "\nX trunk CI · 5\nTriggered via push about 59 minutes ago\n\nJOBS\nX stopped job in 4m34s (ID 30)\n\nFor more information about the job, try: gh run view --job=30\nView this run on GitHub: https://github.com/runs/5\n",
+			wantErr: true,
+		},
 		{
 			name: "exit status, successful run, with attempt",
 			opts: &ViewOptions{
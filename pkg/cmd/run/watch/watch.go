@@ -68,7 +68,7 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 				return runF(opts)
 			}
 
-			return watchRun(opts)
+			return WatchRun(opts)
 		},
 	}
 	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if run fails")
@@ -77,7 +77,9 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 	return cmd
 }
 
-func watchRun(opts *WatchOptions) error {
+// WatchRun streams a run's status to the terminal until it completes. It is exported
+// so other commands (e.g. `gh run rerun --watch`) can follow up a run they just triggered.
+func WatchRun(opts *WatchOptions) error {
 	c, err := opts.HttpClient()
 	if err != nil {
 		return fmt.Errorf("failed to create http client: %w", err)
@@ -355,7 +355,7 @@ func TestWatchRun(t *testing.T) {
 				tt.promptStubs(pm)
 			}
 
-			err := watchRun(tt.opts)
+			err := WatchRun(tt.opts)
 			if tt.wantErr {
 				assert.EqualError(t, err, tt.errMsg)
 			} else {
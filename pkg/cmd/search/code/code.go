@@ -16,6 +16,7 @@ import (
 
 type CodeOptions struct {
 	Browser  browser.Browser
+	ExitCode bool
 	Exporter cmdutil.Exporter
 	IO       *iostreams.IOStreams
 	Query    search.Query
@@ -84,6 +85,7 @@ func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Comman
 
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.CodeFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
 
 	// Query parameter flags
@@ -118,8 +120,18 @@ func codeRun(opts *CodeOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(results.Items) == 0 && opts.Exporter == nil {
-		return cmdutil.NewNoResultsError("no code results matched your search")
+	if len(results.Items) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			return cmdutil.NewNoResultsError("no code results matched your search")
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(io, results.Items); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
+		}
 	}
 	if err := io.StartPager(); err == nil {
 		defer io.StopPager()
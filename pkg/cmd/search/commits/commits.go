@@ -17,6 +17,7 @@ import (
 
 type CommitsOptions struct {
 	Browser  browser.Browser
+	ExitCode bool
 	Exporter cmdutil.Exporter
 	IO       *iostreams.IOStreams
 	Now      time.Time
@@ -93,6 +94,7 @@ func NewCmdCommits(f *cmdutil.Factory, runF func(*CommitsOptions) error) *cobra.
 
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.CommitFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
 
 	// Query parameter flags
@@ -135,8 +137,18 @@ func commitsRun(opts *CommitsOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(result.Items) == 0 && opts.Exporter == nil {
-		return cmdutil.NewNoResultsError("no commits matched your search")
+	if len(result.Items) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			return cmdutil.NewNoResultsError("no commits matched your search")
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(io, result.Items); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
+		}
 	}
 	if err := io.StartPager(); err == nil {
 		defer io.StopPager()
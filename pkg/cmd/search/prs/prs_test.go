@@ -120,6 +120,8 @@ func TestNewCmdPrs(t *testing.T) {
       --language=language
       --locked
       --merged
+      --no-assignee
+      --no-label
       --no-milestone
       --updated=updated
       --visibility=public
@@ -139,7 +141,7 @@ func TestNewCmdPrs(t *testing.T) {
 						In:        []string{"title", "body"},
 						Is:        []string{"public", "locked", "merged"},
 						Language:  "language",
-						No:        []string{"milestone"},
+						No:        []string{"assignee", "label", "milestone"},
 						Type:      "pr",
 						Updated:   "updated",
 					},
@@ -18,6 +18,7 @@ import (
 
 type ReposOptions struct {
 	Browser  browser.Browser
+	ExitCode bool
 	Exporter cmdutil.Exporter
 	IO       *iostreams.IOStreams
 	Now      time.Time
@@ -97,6 +98,7 @@ func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Comm
 
 	// Output flags
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.RepositoryFields)
+	cmdutil.AddExitCodeFlag(cmd, &opts.ExitCode)
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
 
 	// Query parameter flags
@@ -141,8 +143,18 @@ func reposRun(opts *ReposOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(result.Items) == 0 && opts.Exporter == nil {
-		return cmdutil.NewNoResultsError("no repositories matched your search")
+	if len(result.Items) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			return cmdutil.NewNoResultsError("no repositories matched your search")
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(io, result.Items); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
+		}
 	}
 	if err := io.StartPager(); err == nil {
 		defer io.StopPager()
@@ -9,6 +9,7 @@ import (
 	searchIssuesCmd "github.com/cli/cli/v2/pkg/cmd/search/issues"
 	searchPrsCmd "github.com/cli/cli/v2/pkg/cmd/search/prs"
 	searchReposCmd "github.com/cli/cli/v2/pkg/cmd/search/repos"
+	searchUsersCmd "github.com/cli/cli/v2/pkg/cmd/search/users"
 )
 
 func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
@@ -23,6 +24,7 @@ func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(searchIssuesCmd.NewCmdIssues(f, nil))
 	cmd.AddCommand(searchPrsCmd.NewCmdPrs(f, nil))
 	cmd.AddCommand(searchReposCmd.NewCmdRepos(f, nil))
+	cmd.AddCommand(searchUsersCmd.NewCmdUsers(f, nil))
 
 	return cmd
 }
@@ -29,6 +29,7 @@ const (
 type IssuesOptions struct {
 	Browser  browser.Browser
 	Entity   EntityType
+	ExitCode bool
 	Exporter cmdutil.Exporter
 	IO       *iostreams.IOStreams
 	Now      time.Time
@@ -65,17 +66,27 @@ func SearchIssues(opts *IssuesOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(result.Items) == 0 && opts.Exporter == nil {
-		var msg string
-		switch opts.Entity {
-		case Both:
-			msg = "no issues or pull requests matched your search"
-		case Issues:
-			msg = "no issues matched your search"
-		case PullRequests:
-			msg = "no pull requests matched your search"
+	if len(result.Items) == 0 {
+		if opts.Exporter == nil {
+			if opts.ExitCode {
+				return cmdutil.SilentError
+			}
+			var msg string
+			switch opts.Entity {
+			case Both:
+				msg = "no issues or pull requests matched your search"
+			case Issues:
+				msg = "no issues matched your search"
+			case PullRequests:
+				msg = "no pull requests matched your search"
+			}
+			return cmdutil.NewNoResultsError(msg)
+		} else if opts.ExitCode {
+			if err := opts.Exporter.Write(io, result.Items); err != nil {
+				return err
+			}
+			return cmdutil.SilentError
 		}
-		return cmdutil.NewNoResultsError(msg)
 	}
 
 	if err := io.StartPager(); err == nil {
@@ -142,6 +142,21 @@ func TestSearchIssues(t *testing.T) {
 			wantErr: true,
 			errMsg:  "no issues matched your search",
 		},
+		{
+			name: "exit code with no results",
+			opts: &IssuesOptions{
+				Entity:   Issues,
+				ExitCode: true,
+				Query:    query,
+				Searcher: &search.SearcherMock{
+					IssuesFunc: func(query search.Query) (search.IssuesResult, error) {
+						return search.IssuesResult{}, nil
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "SilentError",
+		},
 		{
 			name: "displays search error",
 			opts: &IssuesOptions{
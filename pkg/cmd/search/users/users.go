@@ -0,0 +1,155 @@
+package users
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/search/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+type UsersOptions struct {
+	Browser  browser.Browser
+	Exporter cmdutil.Exporter
+	IO       *iostreams.IOStreams
+	Query    search.Query
+	Searcher search.Searcher
+	WebMode  bool
+}
+
+func NewCmdUsers(f *cmdutil.Factory, runF func(*UsersOptions) error) *cobra.Command {
+	var order string
+	var sort string
+	opts := &UsersOptions{
+		Browser: f.Browser,
+		IO:      f.IOStreams,
+		Query:   search.Query{Kind: search.KindUsers},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "users [<query>]",
+		Short: "Search for users",
+		Long: heredoc.Doc(`
+			Search for users on GitHub.
+
+			The command supports constructing queries using the GitHub search syntax,
+			using the parameter and qualifier flags, or a combination of the two.
+
+			GitHub search syntax is documented at:
+			<https://docs.github.com/search-github/searching-on-github/searching-users>
+    `),
+		Example: heredoc.Doc(`
+			# search users with the name "jane doe"
+			$ gh search users "jane doe"
+
+			# search users within the "github" organization
+			$ gh search users --owner=github
+
+			# search users located in Japan
+			$ gh search users --location=japan
+
+			# search users that have over 1000 followers
+			$ gh search users --followers=">1000"
+
+			# search organizations that match a given query
+			$ gh search users --type=org "design system"
+    `),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 && c.Flags().NFlag() == 0 {
+				return cmdutil.FlagErrorf("specify search keywords or flags")
+			}
+			if opts.Query.Limit < 1 || opts.Query.Limit > shared.SearchMaxResults {
+				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
+			}
+			if c.Flags().Changed("order") {
+				opts.Query.Order = order
+			}
+			if c.Flags().Changed("sort") {
+				opts.Query.Sort = sort
+			}
+			opts.Query.Keywords = args
+			if runF != nil {
+				return runF(opts)
+			}
+			var err error
+			opts.Searcher, err = shared.Searcher(f)
+			if err != nil {
+				return err
+			}
+			return usersRun(opts)
+		},
+	}
+
+	// Output flags
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, search.UserFields)
+	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the search query in the web browser")
+
+	// Query parameter flags
+	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of users to fetch")
+	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of users returned, ignored unless '--sort' flag is specified")
+	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match", []string{"followers", "repositories", "joined"}, "Sort fetched users")
+
+	// Query qualifier flags
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Followers, "followers", "", "Filter based on `number` of followers")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Language, "language", "", "Filter based on the coding language of a user's repositories")
+	cmd.Flags().StringVar(&opts.Query.Qualifiers.Location, "location", "", "Filter based on user's location")
+	cmd.Flags().StringSliceVar(&opts.Query.Qualifiers.Repo, "repo", nil, "Filter on `owner/name` of a user's repository")
+	cmdutil.StringEnumFlag(cmd, &opts.Query.Qualifiers.Type, "type", "", "", []string{"user", "org"}, "Filter based on account type")
+	cmd.Flags().StringSliceVar(&opts.Query.Qualifiers.User, "owner", nil, "Filter on owner")
+
+	return cmd
+}
+
+func usersRun(opts *UsersOptions) error {
+	io := opts.IO
+	if opts.WebMode {
+		url := opts.Searcher.URL(opts.Query)
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+		}
+		return opts.Browser.Browse(url)
+	}
+	io.StartProgressIndicator()
+	result, err := opts.Searcher.Users(opts.Query)
+	io.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+	if len(result.Items) == 0 && opts.Exporter == nil {
+		return cmdutil.NewNoResultsError("no users matched your search")
+	}
+
+	if err := io.StartPager(); err == nil {
+		defer io.StopPager()
+	} else {
+		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
+	}
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(io, result.Items)
+	}
+
+	return displayResults(io, result)
+}
+
+func displayResults(io *iostreams.IOStreams, results search.UsersResult) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("Login", "Name", "Type", "Location"))
+	for _, user := range results.Items {
+		tp.AddField(user.Login, tableprinter.WithColor(cs.Bold))
+		tp.AddField(user.Name)
+		tp.AddField(user.Type)
+		tp.AddField(text.RemoveExcessiveWhitespace(user.Location))
+		tp.EndRow()
+	}
+	if io.IsStdoutTTY() {
+		header := fmt.Sprintf("Showing %d of %d users\n\n", len(results.Items), results.Total)
+		fmt.Fprintf(io.Out, "\n%s", header)
+	}
+	return tp.Render()
+}
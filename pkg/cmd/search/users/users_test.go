@@ -0,0 +1,237 @@
+package users
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/search"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUsers(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  UsersOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no arguments",
+			input:   "",
+			wantErr: true,
+			errMsg:  "specify search keywords or flags",
+		},
+		{
+			name:  "keyword arguments",
+			input: "jane doe",
+			output: UsersOptions{
+				Query: search.Query{Keywords: []string{"jane", "doe"}, Kind: "users", Limit: 30},
+			},
+		},
+		{
+			name:  "web flag",
+			input: "--web",
+			output: UsersOptions{
+				Query:   search.Query{Keywords: []string{}, Kind: "users", Limit: 30},
+				WebMode: true,
+			},
+		},
+		{
+			name:   "limit flag",
+			input:  "--limit 10",
+			output: UsersOptions{Query: search.Query{Keywords: []string{}, Kind: "users", Limit: 10}},
+		},
+		{
+			name:    "invalid limit flag",
+			input:   "--limit 1001",
+			wantErr: true,
+			errMsg:  "`--limit` must be between 1 and 1000",
+		},
+		{
+			name:  "order flag",
+			input: "--order asc",
+			output: UsersOptions{
+				Query: search.Query{Keywords: []string{}, Kind: "users", Limit: 30, Order: "asc"},
+			},
+		},
+		{
+			name: "qualifier flags",
+			input: `
+      --followers=">1000"
+      --language=go
+      --location=japan
+      --owner=github
+      --repo=cli/cli
+      --type=org
+      `,
+			output: UsersOptions{
+				Query: search.Query{
+					Keywords: []string{},
+					Kind:     "users",
+					Limit:    30,
+					Qualifiers: search.Qualifiers{
+						Followers: ">1000",
+						Language:  "go",
+						Location:  "japan",
+						Repo:      []string{"cli/cli"},
+						Type:      "org",
+						User:      []string{"github"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+			var gotOpts *UsersOptions
+			cmd := NewCmdUsers(f, func(opts *UsersOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Query, gotOpts.Query)
+			assert.Equal(t, tt.output.WebMode, gotOpts.WebMode)
+		})
+	}
+}
+
+func TestUsersRun(t *testing.T) {
+	var query = search.Query{
+		Keywords: []string{"jane"},
+		Kind:     "users",
+		Limit:    30,
+		Qualifiers: search.Qualifiers{
+			Type: "user",
+		},
+	}
+	tests := []struct {
+		errMsg     string
+		name       string
+		opts       *UsersOptions
+		tty        bool
+		wantErr    bool
+		wantStderr string
+		wantStdout string
+	}{
+		{
+			name: "displays results tty",
+			opts: &UsersOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					UsersFunc: func(query search.Query) (search.UsersResult, error) {
+						return search.UsersResult{
+							IncompleteResults: false,
+							Items: []search.SearchUser{
+								{Login: "jane", Name: "Jane Doe", Type: "User", Location: "Tokyo"},
+								{Login: "janeorg", Name: "Jane Org", Type: "Organization", Location: ""},
+							},
+							Total: 2,
+						}, nil
+					},
+				},
+			},
+			tty:        true,
+			wantStdout: "\nShowing 2 of 2 users\n\nLOGIN    NAME      TYPE          LOCATION\njane     Jane Doe  User          Tokyo\njaneorg  Jane Org  Organization  \n",
+		},
+		{
+			name: "displays results notty",
+			opts: &UsersOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					UsersFunc: func(query search.Query) (search.UsersResult, error) {
+						return search.UsersResult{
+							IncompleteResults: false,
+							Items: []search.SearchUser{
+								{Login: "jane", Name: "Jane Doe", Type: "User", Location: "Tokyo"},
+							},
+							Total: 1,
+						}, nil
+					},
+				},
+			},
+			wantStdout: "jane\tJane Doe\tUser\tTokyo\n",
+		},
+		{
+			name: "displays no results",
+			opts: &UsersOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					UsersFunc: func(query search.Query) (search.UsersResult, error) {
+						return search.UsersResult{}, nil
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "no users matched your search",
+		},
+		{
+			name: "displays search error",
+			opts: &UsersOptions{
+				Query: query,
+				Searcher: &search.SearcherMock{
+					UsersFunc: func(query search.Query) (search.UsersResult, error) {
+						return search.UsersResult{}, fmt.Errorf("error with query")
+					},
+				},
+			},
+			errMsg:  "error with query",
+			wantErr: true,
+		},
+		{
+			name: "opens browser for web mode tty",
+			opts: &UsersOptions{
+				Browser: &browser.Stub{},
+				Query:   query,
+				Searcher: &search.SearcherMock{
+					URLFunc: func(query search.Query) string {
+						return "https://github.com/search?type=users&q=jane"
+					},
+				},
+				WebMode: true,
+			},
+			tty:        true,
+			wantStderr: "Opening github.com/search in your browser.\n",
+		},
+	}
+	for _, tt := range tests {
+		ios, _, stdout, stderr := iostreams.Test()
+		ios.SetStdinTTY(tt.tty)
+		ios.SetStdoutTTY(tt.tty)
+		ios.SetStderrTTY(tt.tty)
+		tt.opts.IO = ios
+		t.Run(tt.name, func(t *testing.T) {
+			err := usersRun(tt.opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			} else if err != nil {
+				t.Fatalf("usersRun unexpected error: %v", err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
@@ -158,7 +158,7 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 	cmdutil.StringEnumFlag(cmd, &opts.Visibility, "visibility", "v", shared.Private, []string{shared.All, shared.Private, shared.Selected}, "Set visibility for an organization secret")
 	cmd.Flags().StringSliceVarP(&opts.RepositoryNames, "repos", "r", []string{}, "List of `repositories` that can access an organization or user secret")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "The value for the secret (reads from standard input if not specified)")
-	cmd.Flags().BoolVar(&opts.DoNotStore, "no-store", false, "Print the encrypted, base64-encoded value instead of storing it on Github")
+	cmd.Flags().BoolVar(&opts.DoNotStore, "no-store", false, "Print the key ID and the encrypted, base64-encoded value instead of storing it on Github")
 	cmd.Flags().StringVarP(&opts.EnvFile, "env-file", "f", "", "Load secret names and values from a dotenv-formatted `file`")
 	cmdutil.StringEnumFlag(cmd, &opts.Application, "app", "a", "", []string{shared.Actions, shared.Codespaces, shared.Dependabot}, "Set the application for a secret")
 
@@ -267,7 +267,7 @@ func setRun(opts *SetOptions) error {
 			continue
 		}
 		if result.encrypted != "" {
-			fmt.Fprintln(opts.IO.Out, result.encrypted)
+			fmt.Fprintf(opts.IO.Out, "%s\t%s\n", result.keyID, result.encrypted)
 			continue
 		}
 		if !opts.IO.IsStdoutTTY() {
@@ -287,6 +287,7 @@ func setRun(opts *SetOptions) error {
 type setResult struct {
 	key       string
 	encrypted string
+	keyID     string
 	err       error
 }
 
@@ -316,6 +317,7 @@ func setSecret(opts *SetOptions, pk *PubKey, host string, client *api.Client, ba
 	encoded := base64.StdEncoding.EncodeToString(eBody)
 	if opts.DoNotStore {
 		res.encrypted = encoded
+		res.keyID = pk.ID
 		return
 	}
 
@@ -542,7 +542,7 @@ func Test_setRun_shouldNotStore(t *testing.T) {
 	err := setRun(opts)
 	assert.NoError(t, err)
 
-	assert.Equal(t, "UKYUCbHd0DJemxa3AOcZ6XcsBwALG9d4bpB8ZT0gSV39vl3BHiGSgj8zJapDxgB2BwqNqRhpjC4=\n", stdout.String())
+	assert.Equal(t, "123\tUKYUCbHd0DJemxa3AOcZ6XcsBwALG9d4bpB8ZT0gSV39vl3BHiGSgj8zJapDxgB2BwqNqRhpjC4=\n", stdout.String())
 	assert.Equal(t, "", stderr.String())
 }
 
@@ -34,7 +34,7 @@ type StatusOptions struct {
 	HostConfig   hostConfig
 	CachedClient func(*http.Client, time.Duration) *http.Client
 	IO           *iostreams.IOStreams
-	Org          string
+	Orgs         []string
 	Exclude      []string
 }
 
@@ -49,7 +49,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Print information about relevant issues, pull requests, and notifications across repositories",
-		Long: heredoc.Doc(`
+		Long: heredoc.Docf(`
 			The status command prints information about your work on GitHub across all the repositories you're subscribed to, including:
 
 			- Assigned Issues
@@ -57,10 +57,13 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 			- Review Requests
 			- Mentions
 			- Repository Activity (new issues/pull requests, comments)
-		`),
+
+			Repositories to exclude by default can be set with the %[1]sstatus_exclude%[1]s config key,
+			as a comma separated list in owner/name format. This can be set with %[1]sgh config set status_exclude owner/repo%[1]s.
+		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh status -e cli/cli -e cli/go-gh # Exclude multiple repositories
-			$ gh status -o cli # Limit results to a single organization
+			$ gh status -o cli -o github # Limit results to one or more organizations
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := f.Config()
@@ -70,6 +73,11 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 
 			opts.HostConfig = cfg.Authentication()
 
+			hostname, _ := cfg.Authentication().DefaultHost()
+			if configExclude := cfg.StatusExclude(hostname); configExclude != "" {
+				opts.Exclude = append(opts.Exclude, strings.Split(configExclude, ",")...)
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -78,7 +86,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Report status within an organization")
+	cmd.Flags().StringSliceVarP(&opts.Orgs, "org", "o", []string{}, "Only report status for these organizations. Can be specified multiple times")
 	cmd.Flags().StringSliceVarP(&opts.Exclude, "exclude", "e", []string{}, "Comma separated list of repos to exclude in owner/name format")
 
 	return cmd
@@ -172,7 +180,7 @@ type StatusGetter struct {
 	Client         *http.Client
 	cachedClient   func(*http.Client, time.Duration) *http.Client
 	host           string
-	Org            string
+	Orgs           []string
 	Exclude        []string
 	AssignedPRs    []StatusItem
 	AssignedIssues []StatusItem
@@ -190,7 +198,7 @@ type StatusGetter struct {
 func NewStatusGetter(client *http.Client, hostname string, opts *StatusOptions) *StatusGetter {
 	return &StatusGetter{
 		Client:       client,
-		Org:          opts.Org,
+		Orgs:         opts.Orgs,
 		Exclude:      opts.Exclude,
 		cachedClient: opts.CachedClient,
 		host:         hostname,
@@ -201,6 +209,20 @@ func (s *StatusGetter) hostname() string {
 	return s.host
 }
 
+// ShouldIncludeOrg reports whether org passes the --org filter. An empty
+// filter includes every organization.
+func (s *StatusGetter) ShouldIncludeOrg(org string) bool {
+	if len(s.Orgs) == 0 {
+		return true
+	}
+	for _, o := range s.Orgs {
+		if strings.EqualFold(o, org) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *StatusGetter) CachedClient(ttl time.Duration) *http.Client {
 	return s.cachedClient(s.Client, ttl)
 }
@@ -351,7 +373,7 @@ func (s *StatusGetter) LoadNotifications() error {
 			if n.Reason != "mention" {
 				continue
 			}
-			if s.Org != "" && n.Repository.Owner.Login != s.Org {
+			if !s.ShouldIncludeOrg(n.Repository.Owner.Login) {
 				continue
 			}
 			if s.ShouldExclude(n.Repository.FullName) {
@@ -417,9 +439,9 @@ func (s *StatusGetter) LoadSearchResults() error {
 
 	searchAssigns := `assignee:@me state:open archived:false`
 	searchReviews := `review-requested:@me state:open archived:false`
-	if s.Org != "" {
-		searchAssigns += " org:" + s.Org
-		searchReviews += " org:" + s.Org
+	for _, org := range s.Orgs {
+		searchAssigns += " org:" + org
+		searchReviews += " org:" + org
 	}
 	for _, repo := range s.Exclude {
 		searchAssigns += " -repo:" + repo
@@ -562,7 +584,7 @@ func (s *StatusGetter) LoadEvents() error {
 	s.RepoActivity = []StatusItem{}
 
 	for _, e := range events {
-		if s.Org != "" && e.Org.Login != s.Org {
+		if !s.ShouldIncludeOrg(e.Org.Login) {
 			continue
 		}
 		if s.ShouldExclude(e.Repo.Name) {
@@ -667,6 +689,14 @@ func statusRun(opts *StatusOptions) error {
 
 	cs := opts.IO.ColorScheme()
 	out := opts.IO.Out
+
+	if len(opts.Orgs) > 0 {
+		fmt.Fprintln(out, cs.Gray(fmt.Sprintf("Filtering by organization(s): %s", strings.Join(opts.Orgs, ", "))))
+	}
+	if len(opts.Exclude) > 0 {
+		fmt.Fprintln(out, cs.Gray(fmt.Sprintf("Excluding repositories: %s", strings.Join(opts.Exclude, ", "))))
+	}
+
 	fullWidth := opts.IO.TerminalWidth()
 	halfWidth := (fullWidth / 2) - 2
 
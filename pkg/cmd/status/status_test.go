@@ -2,6 +2,7 @@ package status
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -25,9 +26,10 @@ func (c testHostConfig) DefaultHost() (string, string) {
 
 func TestNewCmdStatus(t *testing.T) {
 	tests := []struct {
-		name  string
-		cli   string
-		wants StatusOptions
+		name                string
+		cli                 string
+		configStatusExclude string
+		wants               StatusOptions
 	}{
 		{
 			name: "defaults",
@@ -36,7 +38,14 @@ func TestNewCmdStatus(t *testing.T) {
 			name: "org",
 			cli:  "-o cli",
 			wants: StatusOptions{
-				Org: "cli",
+				Orgs: []string{"cli"},
+			},
+		},
+		{
+			name: "multiple orgs",
+			cli:  "-o cli -o github",
+			wants: StatusOptions{
+				Orgs: []string{"cli", "github"},
 			},
 		},
 		{
@@ -46,6 +55,21 @@ func TestNewCmdStatus(t *testing.T) {
 				Exclude: []string{"cli/cli", "cli/go-gh"},
 			},
 		},
+		{
+			name:                "exclude from config",
+			configStatusExclude: "cli/cli,cli/go-gh",
+			wants: StatusOptions{
+				Exclude: []string{"cli/cli", "cli/go-gh"},
+			},
+		},
+		{
+			name:                "exclude from config and flag combine",
+			cli:                 "-e cli/cli",
+			configStatusExclude: "cli/go-gh",
+			wants: StatusOptions{
+				Exclude: []string{"cli/cli", "cli/go-gh"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -56,11 +80,19 @@ func TestNewCmdStatus(t *testing.T) {
 		if tt.wants.Exclude == nil {
 			tt.wants.Exclude = []string{}
 		}
+		if tt.wants.Orgs == nil {
+			tt.wants.Orgs = []string{}
+		}
+
+		cfgStr := ""
+		if tt.configStatusExclude != "" {
+			cfgStr = fmt.Sprintf("status_exclude: %s\n", tt.configStatusExclude)
+		}
 
 		f := &cmdutil.Factory{
 			IOStreams: ios,
 			Config: func() (config.Config, error) {
-				return config.NewBlankConfig(), nil
+				return config.NewFromString(cfgStr), nil
 			},
 		}
 		t.Run(tt.name, func(t *testing.T) {
@@ -77,7 +109,7 @@ func TestNewCmdStatus(t *testing.T) {
 			_, err = cmd.ExecuteC()
 			assert.NoError(t, err)
 
-			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.Orgs, gotOpts.Orgs)
 			assert.Equal(t, tt.wants.Exclude, gotOpts.Exclude)
 		})
 	}
@@ -177,7 +209,7 @@ func TestStatusRun(t *testing.T) {
 			},
 			// NOTA BENE: you'll see cli/cli in search results because that happens
 			// server side and the fixture doesn't account for that
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110               hello @j...\nvilmibm/testing#1234  Foobar          │ vilmibm/gh-screensaver#15  a messag...\nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
+			wantOut: "Excluding repositories: cli/cli\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110               hello @j...\nvilmibm/testing#1234  Foobar          │ vilmibm/gh-screensaver#15  a messag...\nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
 		},
 		{
 			name: "exclude repositories",
@@ -203,7 +235,7 @@ func TestStatusRun(t *testing.T) {
 			},
 			// NOTA BENE: you'll see cli/cli in search results because that happens
 			// server side and the fixture doesn't account for that
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ vilmibm/gh-screensaver#15  a messag...\nvilmibm/testing#1234  Foobar          │                                       \nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
+			wantOut: "Excluding repositories: cli/cli, rpd/todo\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ vilmibm/gh-screensaver#15  a messag...\nvilmibm/testing#1234  Foobar          │                                       \nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
 		},
 		{
 			name: "filter to an org",
@@ -231,9 +263,42 @@ func TestStatusRun(t *testing.T) {
 					httpmock.FileResponse("./fixtures/events.json"))
 			},
 			opts: &StatusOptions{
-				Org: "rpd",
+				Orgs: []string{"rpd"},
+			},
+			wantOut: "Filtering by organization(s): rpd\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110  hello @jillvalentine ...\nvilmibm/testing#1234  Foobar          │                                       \nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326  new PR  Only write UTF-8 BOM on Windows where it is needed\n\n",
+		},
+		{
+			name: "filter to multiple orgs",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL("UserCurrent"),
+					httpmock.StringResponse(`{"data": {"viewer": {"login": "jillvalentine"}}}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/rpd/todo/issues/110"),
+					httpmock.StringResponse(`{"body":"hello @jillvalentine how are you"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/rpd/todo/issues/4113"),
+					httpmock.StringResponse(`{"body":"this is a comment"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/rpd/todo/issues/comments/1065"),
+					httpmock.StringResponse(`{"body":"not a real mention"}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/vilmibm/gh-screensaver/issues/comments/10"),
+					httpmock.StringResponse(`{"body":"a message for @jillvalentine"}`))
+				reg.Register(
+					httpmock.GraphQL("AssignedSearch"),
+					httpmock.FileResponse("./fixtures/search.json"))
+				reg.Register(
+					httpmock.REST("GET", "notifications"),
+					httpmock.FileResponse("./fixtures/notifications.json"))
+				reg.Register(
+					httpmock.REST("GET", "users/jillvalentine/received_events"),
+					httpmock.FileResponse("./fixtures/events.json"))
+			},
+			opts: &StatusOptions{
+				Orgs: []string{"rpd", "vilmibm"},
 			},
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110  hello @jillvalentine ...\nvilmibm/testing#1234  Foobar          │                                       \nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326  new PR  Only write UTF-8 BOM on Windows where it is needed\n\n",
+			wantOut: "Filtering by organization(s): rpd, vilmibm\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110               hello @j...\nvilmibm/testing#1234  Foobar          │ vilmibm/gh-screensaver#15  a messag...\nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326  new PR  Only write UTF-8 BOM on Windows where it is needed\n\n",
 		},
 		{
 			name: "forbidden errors",
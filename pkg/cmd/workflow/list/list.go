@@ -3,6 +3,7 @@ package list
 import (
 	"fmt"
 	"net/http"
+	"path"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -23,6 +24,7 @@ type ListOptions struct {
 
 	All   bool
 	Limit int
+	Path  string
 }
 
 var workflowFields = []string{
@@ -30,6 +32,7 @@ var workflowFields = []string{
 	"name",
 	"path",
 	"state",
+	"badgeUrl",
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -62,6 +65,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultLimit, "Maximum number of workflows to fetch")
 	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Include disabled workflows")
+	cmd.Flags().StringVar(&opts.Path, "path", "", "Filter by workflow file path glob, e.g. '.github/workflows/deploy*'")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, workflowFields)
 	return cmd
 }
@@ -96,6 +100,18 @@ func listRun(opts *ListOptions) error {
 		}
 	}
 
+	if opts.Path != "" {
+		var pathFiltered []shared.Workflow
+		for _, workflow := range filteredWorkflows {
+			if matched, err := path.Match(opts.Path, workflow.Path); err != nil {
+				return fmt.Errorf("invalid path glob: %w", err)
+			} else if matched {
+				pathFiltered = append(pathFiltered, workflow)
+			}
+		}
+		filteredWorkflows = pathFiltered
+	}
+
 	if len(filteredWorkflows) == 0 {
 		return cmdutil.NewNoResultsError("no workflows found")
 	}
@@ -23,6 +23,7 @@ const (
 	Active             WorkflowState = "active"
 	DisabledManually   WorkflowState = "disabled_manually"
 	DisabledInactivity WorkflowState = "disabled_inactivity"
+	Deleted            WorkflowState = "deleted"
 )
 
 type iprompter interface {
@@ -32,10 +33,11 @@ type iprompter interface {
 type WorkflowState string
 
 type Workflow struct {
-	Name  string
-	ID    int64
-	Path  string
-	State WorkflowState
+	Name     string
+	ID       int64
+	Path     string
+	State    WorkflowState
+	BadgeURL string `json:"badge_url"`
 }
 
 type WorkflowsPayload struct {
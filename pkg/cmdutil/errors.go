@@ -3,6 +3,7 @@ package cmdutil
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
 )
@@ -57,6 +58,49 @@ func MutuallyExclusive(message string, conditions ...bool) error {
 	return nil
 }
 
+// FlagRequiredInNonInteractiveError indicates that a command cannot prompt the user
+// (stdin isn't a TTY, or GH_PROMPT_DISABLED is set) and lists the flags that would
+// have substituted for the missing prompt.
+type FlagRequiredInNonInteractiveError struct {
+	Flags []string
+	// OneOf indicates that any single flag in Flags satisfies the requirement,
+	// rather than all of them being required together.
+	OneOf bool
+}
+
+func (e *FlagRequiredInNonInteractiveError) Error() string {
+	if e.OneOf {
+		return fmt.Sprintf("could not prompt: pass one of %s to run non-interactively", joinFlags(e.Flags, "or"))
+	}
+	return fmt.Sprintf("could not prompt: pass %s to run non-interactively", joinFlags(e.Flags, "and"))
+}
+
+// NewFlagRequiredInNonInteractiveError returns an error for the case where all of the
+// given flags must be passed together to substitute for an interactive prompt.
+func NewFlagRequiredInNonInteractiveError(flags ...string) error {
+	return &FlagRequiredInNonInteractiveError{Flags: flags}
+}
+
+// NewFlagRequiredOneOfInNonInteractiveError returns an error for the case where any
+// single one of the given flags substitutes for an interactive prompt.
+func NewFlagRequiredOneOfInNonInteractiveError(flags ...string) error {
+	return &FlagRequiredInNonInteractiveError{Flags: flags, OneOf: true}
+}
+
+func joinFlags(flags []string, conjunction string) string {
+	switch len(flags) {
+	case 0:
+		return ""
+	case 1:
+		return flags[0]
+	case 2:
+		return fmt.Sprintf("%s %s %s", flags[0], conjunction, flags[1])
+	default:
+		last := len(flags) - 1
+		return fmt.Sprintf("%s, %s %s", strings.Join(flags[:last], ", "), conjunction, flags[last])
+	}
+}
+
 type NoResultsError struct {
 	message string
 }
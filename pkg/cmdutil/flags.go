@@ -35,6 +35,13 @@ func StringEnumFlag(cmd *cobra.Command, p *string, name, shorthand, defaultValue
 	return f
 }
 
+// AddExitCodeFlag defines a new `--exit-code` flag for list and search commands. When set, the
+// command should return SilentError instead of its usual zero-results messaging so that scripts
+// can distinguish "zero matches" from "matches found" by checking the exit status alone.
+func AddExitCodeFlag(cmd *cobra.Command, exitCode *bool) {
+	cmd.Flags().BoolVar(exitCode, "exit-code", false, "Exit with a non-zero status when there are no results")
+}
+
 func StringSliceEnumFlag(cmd *cobra.Command, p *[]string, name, shorthand string, defaultValues, options []string, usage string) *pflag.Flag {
 	*p = defaultValues
 	val := &enumMultiValue{value: p, options: options}
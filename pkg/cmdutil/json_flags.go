@@ -26,8 +26,10 @@ type JSONFlagError struct {
 func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 	f := cmd.Flags()
 	f.StringSlice("json", nil, "Output JSON with the specified `fields`")
-	f.StringP("jq", "q", "", "Filter JSON output using a jq `expression`")
+	f.StringArrayP("jq", "q", nil, "Filter JSON output using a jq `expression`; can be passed multiple times")
+	f.Bool("jq-exit-on-empty", false, "Exit with code 1 if the `--jq` filter produces no output")
 	f.StringP("template", "t", "", "Format JSON output using a Go template; see \"gh help formatting\"")
+	f.Bool("jsonl", false, "Output newline-delimited JSON, one object per line, instead of a single array")
 
 	_ = cmd.RegisterFlagCompletionFunc("json", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		var results []string
@@ -46,6 +48,11 @@ func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 		return results, cobra.ShellCompDirectiveNoSpace
 	})
 
+	fieldsHelpError := func() error {
+		sort.Strings(fields)
+		return JSONFlagError{fmt.Errorf("Specify one or more comma-separated fields for `--json`:\n  %s", strings.Join(fields, "\n  "))}
+	}
+
 	oldPreRun := cmd.PreRunE
 	cmd.PreRunE = func(c *cobra.Command, args []string) error {
 		if oldPreRun != nil {
@@ -53,6 +60,12 @@ func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 				return err
 			}
 		}
+		jsonFlag := c.Flags().Lookup("json")
+		if jsonFlag.Changed {
+			if raw := jsonFlag.Value.(pflag.SliceValue).GetSlice(); len(raw) == 1 && raw[0] == "help" {
+				return fieldsHelpError()
+			}
+		}
 		if export, err := checkJSONFlags(c); err == nil {
 			if export == nil {
 				*exportTarget = nil
@@ -75,8 +88,7 @@ func AddJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
 
 	cmd.SetFlagErrorFunc(func(c *cobra.Command, e error) error {
 		if c == cmd && e.Error() == "flag needs an argument: --json" {
-			sort.Strings(fields)
-			return JSONFlagError{fmt.Errorf("Specify one or more comma-separated fields for `--json`:\n  %s", strings.Join(fields, "\n  "))}
+			return fieldsHelpError()
 		}
 		if cmd.HasParent() {
 			return cmd.Parent().FlagErrorFunc()(c, e)
@@ -89,7 +101,9 @@ func checkJSONFlags(cmd *cobra.Command) (*jsonExporter, error) {
 	f := cmd.Flags()
 	jsonFlag := f.Lookup("json")
 	jqFlag := f.Lookup("jq")
+	jqExitOnEmptyFlag := f.Lookup("jq-exit-on-empty")
 	tplFlag := f.Lookup("template")
+	jsonlFlag := f.Lookup("jsonl")
 	webFlag := f.Lookup("web")
 
 	if jsonFlag.Changed {
@@ -97,15 +111,38 @@ func checkJSONFlags(cmd *cobra.Command) (*jsonExporter, error) {
 			return nil, errors.New("cannot use `--web` with `--json`")
 		}
 		jv := jsonFlag.Value.(pflag.SliceValue)
+		jqv := jqFlag.Value.(pflag.SliceValue)
+		jsonLines := jsonlFlag.Value.String() == "true"
+		if jsonLines {
+			if len(jqv.GetSlice()) > 0 {
+				return nil, errors.New("cannot use `--jsonl` with `--jq`")
+			}
+			if tplFlag.Value.String() != "" {
+				return nil, errors.New("cannot use `--jsonl` with `--template`")
+			}
+		}
+		selectors := jv.GetSlice()
+		topLevel := set.NewStringSet()
+		for _, s := range selectors {
+			key, _, _ := strings.Cut(s, ".")
+			topLevel.Add(key)
+		}
 		return &jsonExporter{
-			fields:   jv.GetSlice(),
-			filter:   jqFlag.Value.String(),
-			template: tplFlag.Value.String(),
+			fields:      topLevel.ToSlice(),
+			selectors:   selectors,
+			filters:     jqv.GetSlice(),
+			exitOnEmpty: jqExitOnEmptyFlag.Value.String() == "true",
+			template:    tplFlag.Value.String(),
+			jsonLines:   jsonLines,
 		}, nil
 	} else if jqFlag.Changed {
 		return nil, errors.New("cannot use `--jq` without specifying `--json`")
+	} else if jqExitOnEmptyFlag.Changed {
+		return nil, errors.New("cannot use `--jq-exit-on-empty` without specifying `--json`")
 	} else if tplFlag.Changed {
 		return nil, errors.New("cannot use `--template` without specifying `--json`")
+	} else if jsonlFlag.Changed {
+		return nil, errors.New("cannot use `--jsonl` without specifying `--json`")
 	}
 	return nil, nil
 }
@@ -114,7 +151,8 @@ func AddFormatFlags(cmd *cobra.Command, exportTarget *Exporter) {
 	var format string
 	StringEnumFlag(cmd, &format, "format", "", "", []string{"json"}, "Output format")
 	f := cmd.Flags()
-	f.StringP("jq", "q", "", "Filter JSON output using a jq `expression`")
+	f.StringArrayP("jq", "q", nil, "Filter JSON output using a jq `expression`; can be passed multiple times")
+	f.Bool("jq-exit-on-empty", false, "Exit with code 1 if the `--jq` filter produces no output")
 	f.StringP("template", "t", "", "Format JSON output using a Go template; see \"gh help formatting\"")
 
 	oldPreRun := cmd.PreRunE
@@ -143,6 +181,7 @@ func checkFormatFlags(cmd *cobra.Command) (*jsonExporter, error) {
 	formatFlag := f.Lookup("format")
 	formatValue := formatFlag.Value.String()
 	jqFlag := f.Lookup("jq")
+	jqExitOnEmptyFlag := f.Lookup("jq-exit-on-empty")
 	tplFlag := f.Lookup("template")
 	webFlag := f.Lookup("web")
 
@@ -150,12 +189,16 @@ func checkFormatFlags(cmd *cobra.Command) (*jsonExporter, error) {
 		if webFlag != nil && webFlag.Changed {
 			return nil, errors.New("cannot use `--web` with `--format`")
 		}
+		jqv := jqFlag.Value.(pflag.SliceValue)
 		return &jsonExporter{
-			filter:   jqFlag.Value.String(),
-			template: tplFlag.Value.String(),
+			filters:     jqv.GetSlice(),
+			exitOnEmpty: jqExitOnEmptyFlag.Value.String() == "true",
+			template:    tplFlag.Value.String(),
 		}, nil
 	} else if jqFlag.Changed && formatValue != "json" {
 		return nil, errors.New("cannot use `--jq` without specifying `--format json`")
+	} else if jqExitOnEmptyFlag.Changed && formatValue != "json" {
+		return nil, errors.New("cannot use `--jq-exit-on-empty` without specifying `--format json`")
 	} else if tplFlag.Changed && formatValue != "json" {
 		return nil, errors.New("cannot use `--template` without specifying `--format json`")
 	}
@@ -168,9 +211,12 @@ type Exporter interface {
 }
 
 type jsonExporter struct {
-	fields   []string
-	filter   string
-	template string
+	fields      []string
+	selectors   []string
+	filters     []string
+	exitOnEmpty bool
+	template    string
+	jsonLines   bool
 }
 
 // NewJSONExporter returns an Exporter to emit JSON.
@@ -197,17 +243,35 @@ func (e *jsonExporter) Write(ios *iostreams.IOStreams, data interface{}) error {
 		return err
 	}
 
+	if hasDottedSelector(e.selectors) {
+		pruned, err := pruneSelectors(buf.Bytes(), e.selectors)
+		if err != nil {
+			return err
+		}
+		buf = *pruned
+	}
+
 	w := ios.Out
-	if e.filter != "" {
+	if e.jsonLines {
+		return writeJSONLines(w, buf.Bytes())
+	} else if len(e.filters) > 0 {
 		indent := ""
 		if ios.IsStdoutTTY() {
 			indent = "  "
 		}
-		if err := jq.EvaluateFormatted(&buf, w, e.filter, indent, ios.ColorEnabled()); err != nil {
-			return err
+		var filtered bytes.Buffer
+		for _, filter := range e.filters {
+			if err := jq.EvaluateFormatted(bytes.NewReader(buf.Bytes()), &filtered, filter, indent, ios.ColorEnabled()); err != nil {
+				return err
+			}
+		}
+		if e.exitOnEmpty && filtered.Len() == 0 {
+			return SilentError
 		}
+		_, err := io.Copy(w, &filtered)
+		return err
 	} else if e.template != "" {
-		t := template.New(w, ios.TerminalWidth(), ios.ColorEnabled())
+		t := template.New(w, ios.TerminalWidth(), ios.ColorEnabled()).Funcs(TemplateFuncs(ios.ColorScheme()))
 		if err := t.Parse(e.template); err != nil {
 			return err
 		}
@@ -256,6 +320,140 @@ func (e *jsonExporter) exportData(v reflect.Value) interface{} {
 	return v.Interface()
 }
 
+// writeJSONLines re-encodes raw JSON output as newline-delimited JSON: each
+// element of a top-level array gets its own line, rather than one array
+// spanning the whole output. A non-array top-level value is written as a
+// single line, identical to the regular JSON output.
+//
+// Note this only changes the on-disk encoding; the full result set is still
+// fetched and held in memory before Write is called, so this does not by
+// itself bound memory use for very large listings.
+func writeJSONLines(w io.Writer, raw []byte) error {
+	var data interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return err
+	}
+	items, ok := data.([]interface{})
+	if !ok {
+		items = []interface{}{data}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hasDottedSelector(selectors []string) bool {
+	for _, s := range selectors {
+		if strings.Contains(s, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneSelectors re-encodes raw JSON output, narrowing it down to just the
+// nested paths named in selectors (e.g. "author.login", "labels.name").
+// Selectors without a dot are left untouched. An unknown nested path
+// produces an error listing the sub-fields that were actually available.
+func pruneSelectors(raw []byte, selectors []string) (*bytes.Buffer, error) {
+	var data interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	pruned, err := selectFields(data, selectors, "")
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(pruned); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// selectFields narrows v down to the fields named in selectors. A selector
+// like "author.login" keeps only the "login" key of the "author" object; a
+// bare selector like "labels" is left as-is. selectors are grouped by their
+// first path segment, so "author.login" and "author.id" combine to keep
+// just those two keys under "author". path is the dotted location of v,
+// used only to build error messages and is "" at the top level.
+func selectFields(v interface{}, selectors []string, path string) (interface{}, error) {
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, item := range t {
+			pruned, err := selectFields(item, selectors, path)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = pruned
+		}
+		return out, nil
+	case map[string]interface{}:
+		keepFull := set.NewStringSet()
+		nested := map[string][]string{}
+		order := set.NewStringSet()
+		for _, s := range selectors {
+			key, rest, dotted := strings.Cut(s, ".")
+			order.Add(key)
+			if dotted {
+				nested[key] = append(nested[key], rest)
+			} else {
+				keepFull.Add(key)
+			}
+		}
+		out := map[string]interface{}{}
+		for _, key := range order.ToSlice() {
+			val, ok := t[key]
+			if !ok {
+				if path == "" {
+					// Top-level keys were already validated against the
+					// command's list of JSON fields; a key missing here
+					// just means the underlying object left it unset.
+					continue
+				}
+				available := make([]string, 0, len(t))
+				for k := range t {
+					available = append(available, k)
+				}
+				sort.Strings(available)
+				return nil, fmt.Errorf("Unknown JSON field: %q\nAvailable fields:\n  %s", dottedPath(path, key), strings.Join(available, "\n  "))
+			}
+			if keepFull.Contains(key) {
+				out[key] = val
+				continue
+			}
+			pruned, err := selectFields(val, nested[key], dottedPath(path, key))
+			if err != nil {
+				return nil, err
+			}
+			out[key] = pruned
+		}
+		return out, nil
+	default:
+		if path != "" && len(selectors) > 0 {
+			return nil, fmt.Errorf("cannot select fields %q from %q: not an object", strings.Join(selectors, ", "), path)
+		}
+		return v, nil
+	}
+}
+
+func dottedPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
 type exportable interface {
 	ExportData([]string) map[string]interface{}
 }
@@ -42,6 +42,20 @@ func TestAddJSONFlags(t *testing.T) {
 			wantsExport: nil,
 			wantsError:  "Unknown JSON field: \"idontexist\"\nAvailable fields:\n  id\n  number",
 		},
+		{
+			name:        "--json help lists available fields",
+			fields:      []string{"one", "two"},
+			args:        []string{"--json", "help"},
+			wantsExport: nil,
+			wantsError:  "Specify one or more comma-separated fields for `--json`:\n  one\n  two",
+		},
+		{
+			name:        "dotted selector validates its top-level field",
+			fields:      []string{"id", "number"},
+			args:        []string{"--json", "idontexist.login"},
+			wantsExport: nil,
+			wantsError:  "Unknown JSON field: \"idontexist\"\nAvailable fields:\n  id\n  number",
+		},
 		{
 			name:        "cannot combine --json with --web",
 			fields:      []string{"id", "number", "title"},
@@ -63,14 +77,22 @@ func TestAddJSONFlags(t *testing.T) {
 			wantsExport: nil,
 			wantsError:  "cannot use `--template` without specifying `--json`",
 		},
+		{
+			name:        "cannot use --jq-exit-on-empty without --json",
+			fields:      []string{},
+			args:        []string{"--jq-exit-on-empty"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--jq-exit-on-empty` without specifying `--json`",
+		},
 		{
 			name:   "with JSON fields",
 			fields: []string{"id", "number", "title"},
 			args:   []string{"--json", "number,title"},
 			wantsExport: &jsonExporter{
-				fields:   []string{"number", "title"},
-				filter:   "",
-				template: "",
+				fields:    []string{"number", "title"},
+				selectors: []string{"number", "title"},
+				filters:   []string{},
+				template:  "",
 			},
 		},
 		{
@@ -78,9 +100,31 @@ func TestAddJSONFlags(t *testing.T) {
 			fields: []string{"id", "number", "title"},
 			args:   []string{"--json", "number", "-q.number"},
 			wantsExport: &jsonExporter{
-				fields:   []string{"number"},
-				filter:   ".number",
-				template: "",
+				fields:    []string{"number"},
+				selectors: []string{"number"},
+				filters:   []string{".number"},
+				template:  "",
+			},
+		},
+		{
+			name:   "with multiple jq filters",
+			fields: []string{"id", "number", "title"},
+			args:   []string{"--json", "number,title", "-q.number", "-q.title"},
+			wantsExport: &jsonExporter{
+				fields:    []string{"number", "title"},
+				selectors: []string{"number", "title"},
+				filters:   []string{".number", ".title"},
+			},
+		},
+		{
+			name:   "with jq filter and exit-on-empty",
+			fields: []string{"id", "number", "title"},
+			args:   []string{"--json", "number", "-q.number", "--jq-exit-on-empty"},
+			wantsExport: &jsonExporter{
+				fields:      []string{"number"},
+				selectors:   []string{"number"},
+				filters:     []string{".number"},
+				exitOnEmpty: true,
 			},
 		},
 		{
@@ -88,11 +132,56 @@ func TestAddJSONFlags(t *testing.T) {
 			fields: []string{"id", "number", "title"},
 			args:   []string{"--json", "number", "-t", "{{.number}}"},
 			wantsExport: &jsonExporter{
-				fields:   []string{"number"},
-				filter:   "",
-				template: "{{.number}}",
+				fields:    []string{"number"},
+				selectors: []string{"number"},
+				filters:   []string{},
+				template:  "{{.number}}",
+			},
+		},
+		{
+			name:   "with dotted JSON field selector",
+			fields: []string{"id", "number", "author"},
+			args:   []string{"--json", "number,author.login"},
+			wantsExport: &jsonExporter{
+				fields:    []string{"number", "author"},
+				selectors: []string{"number", "author.login"},
+				filters:   []string{},
+				template:  "",
 			},
 		},
+		{
+			name:   "with jsonl",
+			fields: []string{"id", "number", "title"},
+			args:   []string{"--json", "number,title", "--jsonl"},
+			wantsExport: &jsonExporter{
+				fields:    []string{"number", "title"},
+				selectors: []string{"number", "title"},
+				filters:   []string{},
+				template:  "",
+				jsonLines: true,
+			},
+		},
+		{
+			name:        "cannot combine --jsonl with --jq",
+			fields:      []string{"id", "number", "title"},
+			args:        []string{"--json", "number", "--jsonl", "-q.number"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--jsonl` with `--jq`",
+		},
+		{
+			name:        "cannot combine --jsonl with --template",
+			fields:      []string{"id", "number", "title"},
+			args:        []string{"--json", "number", "--jsonl", "-t", "{{.number}}"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--jsonl` with `--template`",
+		},
+		{
+			name:        "cannot use --jsonl without --json",
+			fields:      []string{},
+			args:        []string{"--jsonl"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--jsonl` without specifying `--json`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -161,11 +250,16 @@ func TestAddFormatFlags(t *testing.T) {
 			wantsExport: nil,
 			wantsError:  "cannot use `--template` without specifying `--format json`",
 		},
+		{
+			name:       "cannot use --jq-exit-on-empty without --format",
+			args:       []string{"--jq-exit-on-empty"},
+			wantsError: "cannot use `--jq-exit-on-empty` without specifying `--format json`",
+		},
 		{
 			name: "with json format",
 			args: []string{"--format", "json"},
 			wantsExport: &jsonExporter{
-				filter:   "",
+				filters:  []string{},
 				template: "",
 			},
 		},
@@ -173,15 +267,30 @@ func TestAddFormatFlags(t *testing.T) {
 			name: "with jq filter",
 			args: []string{"--format", "json", "-q.number"},
 			wantsExport: &jsonExporter{
-				filter:   ".number",
+				filters:  []string{".number"},
 				template: "",
 			},
 		},
+		{
+			name: "with multiple jq filters",
+			args: []string{"--format", "json", "-q.number", "-q.title"},
+			wantsExport: &jsonExporter{
+				filters: []string{".number", ".title"},
+			},
+		},
+		{
+			name: "with jq filter and exit-on-empty",
+			args: []string{"--format", "json", "-q.number", "--jq-exit-on-empty"},
+			wantsExport: &jsonExporter{
+				filters:     []string{".number"},
+				exitOnEmpty: true,
+			},
+		},
 		{
 			name: "with Go template",
 			args: []string{"--format", "json", "-t", "{{.number}}"},
 			wantsExport: &jsonExporter{
-				filter:   "",
+				filters:  []string{},
 				template: "{{.number}}",
 			},
 		},
@@ -258,7 +367,7 @@ func Test_exportFormat_Write(t *testing.T) {
 		},
 		{
 			name:     "with jq filter",
-			exporter: jsonExporter{filter: ".name"},
+			exporter: jsonExporter{filters: []string{".name"}},
 			args: args{
 				data: map[string]string{"name": "hubot"},
 			},
@@ -268,7 +377,7 @@ func Test_exportFormat_Write(t *testing.T) {
 		},
 		{
 			name:     "with jq filter pretty printing",
-			exporter: jsonExporter{filter: "."},
+			exporter: jsonExporter{filters: []string{"."}},
 			args: args{
 				data: map[string]string{"name": "hubot"},
 			},
@@ -276,6 +385,36 @@ func Test_exportFormat_Write(t *testing.T) {
 			wantErr: false,
 			istty:   true,
 		},
+		{
+			name:     "with multiple jq filters",
+			exporter: jsonExporter{filters: []string{".name", ".name"}},
+			args: args{
+				data: map[string]string{"name": "hubot"},
+			},
+			wantW:   "hubot\nhubot\n",
+			wantErr: false,
+			istty:   false,
+		},
+		{
+			name:     "jq-exit-on-empty with matching filter",
+			exporter: jsonExporter{filters: []string{".name"}, exitOnEmpty: true},
+			args: args{
+				data: map[string]string{"name": "hubot"},
+			},
+			wantW:   "hubot\n",
+			wantErr: false,
+			istty:   false,
+		},
+		{
+			name:     "jq-exit-on-empty with empty filter result",
+			exporter: jsonExporter{filters: []string{"empty"}, exitOnEmpty: true},
+			args: args{
+				data: map[string]string{"name": "hubot"},
+			},
+			wantW:   "",
+			wantErr: true,
+			istty:   false,
+		},
 		{
 			name:     "with Go template",
 			exporter: jsonExporter{template: "{{.name}}"},
@@ -286,6 +425,76 @@ func Test_exportFormat_Write(t *testing.T) {
 			wantErr: false,
 			istty:   false,
 		},
+		{
+			name:     "with Go template autocolor func, non-tty",
+			exporter: jsonExporter{template: "{{.state | autocolor}}"},
+			args: args{
+				data: map[string]string{"state": "OPEN"},
+			},
+			wantW:   "OPEN",
+			wantErr: false,
+			istty:   false,
+		},
+		{
+			name:     "with dotted selector pruning a nested object",
+			exporter: jsonExporter{selectors: []string{"number", "author.login"}},
+			args: args{
+				data: map[string]interface{}{
+					"number": 1,
+					"author": map[string]interface{}{"login": "hubot", "id": "1"},
+				},
+			},
+			wantW:   "{\"author\":{\"login\":\"hubot\"},\"number\":1}\n",
+			wantErr: false,
+			istty:   false,
+		},
+		{
+			name:     "with dotted selector pruning each item of an array",
+			exporter: jsonExporter{selectors: []string{"labels.name"}},
+			args: args{
+				data: map[string]interface{}{
+					"labels": []interface{}{
+						map[string]interface{}{"name": "bug", "color": "red"},
+						map[string]interface{}{"name": "docs", "color": "blue"},
+					},
+				},
+			},
+			wantW:   "{\"labels\":[{\"name\":\"bug\"},{\"name\":\"docs\"}]}\n",
+			wantErr: false,
+			istty:   false,
+		},
+		{
+			name:     "with unknown nested field in dotted selector",
+			exporter: jsonExporter{selectors: []string{"author.bogus"}},
+			args: args{
+				data: map[string]interface{}{
+					"author": map[string]interface{}{"login": "hubot", "id": "1"},
+				},
+			},
+			wantW:   "",
+			wantErr: true,
+			istty:   false,
+		},
+		{
+			name:     "with jsonl and array data",
+			exporter: jsonExporter{fields: []string{"number"}, jsonLines: true},
+			args: args{
+				data: []map[string]int{{"number": 1}, {"number": 2}},
+			},
+			wantW:   "{\"number\":1}\n{\"number\":2}\n",
+			wantErr: false,
+			istty:   false,
+		},
+		{
+			name:     "with jsonl and a single object",
+			exporter: jsonExporter{jsonLines: true},
+			args: args{
+				data: map[string]string{"name": "hubot"},
+			},
+			wantW:   "{\"name\":\"hubot\"}\n",
+			wantErr: false,
+			istty:   false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -302,6 +511,75 @@ func Test_exportFormat_Write(t *testing.T) {
 	}
 }
 
+func Test_exportFormat_Write_templateAutocolor(t *testing.T) {
+	tests := []struct {
+		name  string
+		state string
+		want  string
+	}{
+		{name: "open", state: "OPEN", want: "\x1b[0;32mOPEN\x1b[0m"},
+		{name: "closed", state: "CLOSED", want: "\x1b[0;31mCLOSED\x1b[0m"},
+		{name: "merged", state: "MERGED", want: "\x1b[0;35mMERGED\x1b[0m"},
+		{name: "unrecognized state is unchanged", state: "DRAFT", want: "DRAFT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, w, _ := iostreams.Test()
+			io.SetStdoutTTY(true)
+			io.SetColorEnabled(true)
+			exporter := jsonExporter{template: "{{.state | autocolor}}"}
+			err := exporter.Write(io, map[string]string{"state": tt.state})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, w.String())
+		})
+	}
+}
+
+func Test_exportFormat_Write_templateTruncate(t *testing.T) {
+	io, _, w, _ := iostreams.Test()
+	exporter := jsonExporter{template: "{{.name | truncate 5}}"}
+	err := exporter.Write(io, map[string]string{"name": "hello world"})
+	assert.NoError(t, err)
+	assert.Equal(t, "he...", w.String())
+}
+
+func Test_exportFormat_Write_templateTimefmt(t *testing.T) {
+	io, _, w, _ := iostreams.Test()
+	exporter := jsonExporter{template: `{{.createdAt | timefmt "2006-01-02"}}`}
+	err := exporter.Write(io, map[string]string{"createdAt": "2023-05-17T15:04:05Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-05-17", w.String())
+}
+
+func Test_exportFormat_Write_templateHyperlink(t *testing.T) {
+	tests := []struct {
+		name           string
+		hyperlinksMode string
+		want           string
+	}{
+		{name: "hyperlinks enabled", hyperlinksMode: "always", want: "\x1b]8;;https://example.com\x1b\\cli/cli\x1b]8;;\x1b\\"},
+		{name: "hyperlinks disabled", hyperlinksMode: "never", want: "cli/cli"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, w, _ := iostreams.Test()
+			io.SetHyperlinksMode(tt.hyperlinksMode)
+			exporter := jsonExporter{template: `{{hyperlink .url .title}}`}
+			err := exporter.Write(io, map[string]string{"url": "https://example.com", "title": "cli/cli"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, w.String())
+		})
+	}
+}
+
+func Test_exportFormat_Write_templatePluck(t *testing.T) {
+	io, _, w, _ := iostreams.Test()
+	exporter := jsonExporter{template: `{{range (pluck "name" .)}}{{.}}{{"\n"}}{{end}}`}
+	err := exporter.Write(io, []map[string]string{{"name": "hubot"}, {"name": "monalisa"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "hubot\nmonalisa\n", w.String())
+}
+
 type exportableItem struct {
 	Name string
 }
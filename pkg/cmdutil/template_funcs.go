@@ -0,0 +1,150 @@
+package cmdutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// templateFunc pairs a --template helper added by this package with the
+// usage and description of the corresponding bullet in "gh help formatting",
+// so that adding or changing an entry here can't drift out of sync with what
+// the help text advertises.
+type templateFunc struct {
+	name  string
+	usage string
+	desc  string
+	fn    func(cs *iostreams.ColorScheme) interface{}
+}
+
+var templateFuncs = []templateFunc{
+	{
+		name:  "autocolor",
+		usage: "autocolor <state>",
+		desc: "colors an OPEN/CLOSED/MERGED pull request or issue state the same way `gh pr list` " +
+			"and `gh issue list` do; overrides the generic `autocolor` provided by the Go template package",
+		fn: func(cs *iostreams.ColorScheme) interface{} {
+			return autocolorFunc(cs)
+		},
+	},
+	{
+		name:  "truncate",
+		usage: "truncate <length> <input>",
+		desc:  "ensures input fits within length",
+		fn: func(cs *iostreams.ColorScheme) interface{} {
+			return truncateFunc
+		},
+	},
+	{
+		name:  "timefmt",
+		usage: "timefmt <format> <time>",
+		desc:  "formats a timestamp using Go's `Time.Format` function",
+		fn: func(cs *iostreams.ColorScheme) interface{} {
+			return timefmtFunc
+		},
+	},
+	{
+		name:  "hyperlink",
+		usage: "hyperlink <url> <text>",
+		desc:  "renders a terminal hyperlink, leaving text unchanged when hyperlinks aren't enabled",
+		fn: func(cs *iostreams.ColorScheme) interface{} {
+			return hyperlinkFunc(cs)
+		},
+	},
+	{
+		name:  "pluck",
+		usage: "pluck <field> <list>",
+		desc:  "collects values of a field from all items in the input",
+		fn: func(cs *iostreams.ColorScheme) interface{} {
+			return pluckFunc
+		},
+	},
+}
+
+// TemplateFuncs returns additional functions available to the `--template` flag
+// on top of the defaults provided by the go-gh template package.
+func TemplateFuncs(cs *iostreams.ColorScheme) map[string]interface{} {
+	funcs := make(map[string]interface{}, len(templateFuncs))
+	for _, tf := range templateFuncs {
+		funcs[tf.name] = tf.fn(cs)
+	}
+	return funcs
+}
+
+// TemplateFuncsHelp returns one documentation line per function TemplateFuncs
+// adds, formatted for inclusion in "gh help formatting", so that help text
+// doesn't have to be hand-duplicated and can't drift from the actual
+// function registry.
+func TemplateFuncsHelp() []string {
+	lines := make([]string, len(templateFuncs))
+	for i, tf := range templateFuncs {
+		lines[i] = "`" + tf.usage + "`: " + tf.desc
+	}
+	return lines
+}
+
+// autocolorFunc colors an OPEN/CLOSED/MERGED state string the same way the
+// TTY tables for "gh pr list" and "gh issue list" do. Unrecognized states are
+// returned unchanged.
+func autocolorFunc(cs *iostreams.ColorScheme) func(string) string {
+	return func(state string) string {
+		switch state {
+		case "OPEN":
+			return cs.Green(state)
+		case "CLOSED":
+			return cs.Red(state)
+		case "MERGED":
+			return cs.Magenta(state)
+		default:
+			return state
+		}
+	}
+}
+
+// truncateFunc ensures input fits within maxWidth, matching the behavior of
+// the generic `truncate` provided by the Go template package.
+func truncateFunc(maxWidth int, v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid value; expected string, got %T", v)
+	}
+	return text.Truncate(maxWidth, s), nil
+}
+
+// timefmtFunc formats an RFC 3339 timestamp using Go's Time.Format layout.
+func timefmtFunc(format, input string) (string, error) {
+	t, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(format), nil
+}
+
+// hyperlinkFunc renders a terminal hyperlink for url using the repo's own
+// ColorScheme.Hyperlink, so that --template output degrades to plain text
+// under the same rules (NO_COLOR, non-TTY, --no-color, etc.) as every other
+// hyperlink gh renders.
+func hyperlinkFunc(cs *iostreams.ColorScheme) func(string, string) string {
+	return func(url, text string) string {
+		return cs.Hyperlink(text, url)
+	}
+}
+
+// pluckFunc collects the value of field from each item of input, matching
+// the behavior of the generic `pluck` provided by the Go template package.
+func pluckFunc(field string, input []interface{}) ([]interface{}, error) {
+	results := make([]interface{}, 0, len(input))
+	for _, item := range input {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid value; expected object, got %T", item)
+		}
+		results = append(results, obj[field])
+	}
+	return results, nil
+}
@@ -110,6 +110,51 @@ func ExtractContents(filePath string) []byte {
 	return contents
 }
 
+// ExtractMetadata returns the labels and assignees declared in the template's YAML
+// front-matter, if any.
+func ExtractMetadata(filePath string) (labels []string, assignees []string) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, nil
+	}
+	frontmatterBoundaries := detectFrontmatter(contents)
+	if frontmatterBoundaries[0] != 0 {
+		return nil, nil
+	}
+	templateData := struct {
+		Labels    stringList `yaml:"labels"`
+		Assignees stringList `yaml:"assignees"`
+	}{}
+	if err := yaml.Unmarshal(contents[0:frontmatterBoundaries[1]], &templateData); err != nil {
+		return nil, nil
+	}
+	return templateData.Labels, templateData.Assignees
+}
+
+// stringList unmarshals a YAML field that may be either a list of strings or a single
+// comma-separated string, matching GitHub's own handling of `labels`/`assignees` in
+// issue template front-matter.
+type stringList []string
+
+func (s *stringList) UnmarshalYAML(value *yaml.Node) error {
+	var list []string
+	if err := value.Decode(&list); err == nil {
+		*s = list
+		return nil
+	}
+
+	var str string
+	if err := value.Decode(&str); err != nil {
+		return err
+	}
+	for _, item := range strings.Split(str, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*s = append(*s, item)
+		}
+	}
+	return nil
+}
+
 var yamlPattern = regexp.MustCompile(`(?m)^---\r?\n(\s*\r?\n)?`)
 
 func detectFrontmatter(c []byte) []int {
@@ -31,6 +31,7 @@ func NewColorScheme(enabled, is256enabled bool, trueColor bool) *ColorScheme {
 		enabled:      enabled,
 		is256enabled: is256enabled,
 		hasTrueColor: trueColor,
+		theme:        builtinThemes["default"],
 	}
 }
 
@@ -38,8 +39,83 @@ type ColorScheme struct {
 	enabled      bool
 	is256enabled bool
 	hasTrueColor bool
+	theme        map[ColorRole]string
+
+	hyperlinksEnabled bool
+}
+
+// ColorRole identifies a semantic meaning (rather than a literal color) that callers
+// can render through ColorScheme.RoleColor, so the actual color is controlled by the
+// active color_theme config rather than hardcoded at the call site.
+type ColorRole int
+
+const (
+	RoleSuccess ColorRole = iota
+	RoleWarning
+	RoleDanger
+	RoleInfo
+	RoleAccent
+)
+
+// builtinThemes maps each built-in `color_theme` config value to the color name used
+// for every role. "light" swaps out colors that read poorly on a light background
+// (yellow, cyan); "high-contrast" swaps in bold variants.
+var builtinThemes = map[string]map[ColorRole]string{
+	"default": {
+		RoleSuccess: "green",
+		RoleWarning: "yellow",
+		RoleDanger:  "red",
+		RoleInfo:    "cyan",
+		RoleAccent:  "magenta",
+	},
+	"light": {
+		RoleSuccess: "green",
+		RoleWarning: "blue",
+		RoleDanger:  "red",
+		RoleInfo:    "blue",
+		RoleAccent:  "magenta",
+	},
+	"high-contrast": {
+		RoleSuccess: "greenbold",
+		RoleWarning: "red",
+		RoleDanger:  "red",
+		RoleInfo:    "cyanbold",
+		RoleAccent:  "bold",
+	},
 }
 
+// resolveTheme looks up a built-in theme by name (falling back to "default" for an
+// unknown or empty name) and layers any per-role overrides on top of it.
+func resolveTheme(name string, overrides map[ColorRole]string) map[ColorRole]string {
+	base, ok := builtinThemes[name]
+	if !ok {
+		base = builtinThemes["default"]
+	}
+	theme := make(map[ColorRole]string, len(base))
+	for role, color := range base {
+		theme[role] = color
+	}
+	for role, color := range overrides {
+		theme[role] = color
+	}
+	return theme
+}
+
+// RoleColor returns the color function for a semantic role under the active theme.
+func (c *ColorScheme) RoleColor(role ColorRole) func(string) string {
+	name, ok := c.theme[role]
+	if !ok {
+		name = builtinThemes["default"][role]
+	}
+	return c.ColorFromString(name)
+}
+
+func (c *ColorScheme) SuccessColor() func(string) string { return c.RoleColor(RoleSuccess) }
+func (c *ColorScheme) WarningColor() func(string) string { return c.RoleColor(RoleWarning) }
+func (c *ColorScheme) DangerColor() func(string) string  { return c.RoleColor(RoleDanger) }
+func (c *ColorScheme) InfoColor() func(string) string    { return c.RoleColor(RoleInfo) }
+func (c *ColorScheme) AccentColor() func(string) string  { return c.RoleColor(RoleAccent) }
+
 func (c *ColorScheme) Enabled() bool {
 	return c.enabled
 }
@@ -196,6 +272,10 @@ func (c *ColorScheme) ColorFromString(s string) func(string) string {
 		fn = c.Cyan
 	case "blue":
 		fn = c.Blue
+	case "greenbold":
+		fn = c.GreenBold
+	case "cyanbold":
+		fn = c.CyanBold
 	default:
 		fn = func(s string) string {
 			return s
@@ -224,3 +304,12 @@ func (c *ColorScheme) HexToRGB(hex string, x string) string {
 	b, _ := strconv.ParseInt(hex[4:6], 16, 64)
 	return fmt.Sprintf("\033[38;2;%d;%d;%dm%s\033[0m", r, g, b, x)
 }
+
+// Hyperlink renders text as an OSC 8 terminal hyperlink pointing at url, leaving text
+// unchanged when hyperlinks aren't enabled (see IOStreams.SetHyperlinksMode).
+func (c *ColorScheme) Hyperlink(text, url string) string {
+	if !c.hyperlinksEnabled || url == "" {
+		return text
+	}
+	return fmt.Sprintf("\033]8;;%s\033\\%s\033]8;;\033\\", url, text)
+}
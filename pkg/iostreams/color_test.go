@@ -50,6 +50,55 @@ func TestColorFromRGB(t *testing.T) {
 	}
 }
 
+func TestColorSchemeRoleColor(t *testing.T) {
+	tests := []struct {
+		name      string
+		theme     string
+		overrides map[ColorRole]string
+		role      ColorRole
+		text      string
+		wants     string
+	}{
+		{
+			name:  "default theme warning is yellow",
+			theme: "default",
+			role:  RoleWarning,
+			text:  "careful",
+			wants: yellow("careful"),
+		},
+		{
+			name:  "light theme swaps warning to blue",
+			theme: "light",
+			role:  RoleWarning,
+			text:  "careful",
+			wants: blue("careful"),
+		},
+		{
+			name:  "unknown theme falls back to default",
+			theme: "neon",
+			role:  RoleInfo,
+			text:  "fyi",
+			wants: cyan("fyi"),
+		},
+		{
+			name:      "per-role override wins over the theme",
+			theme:     "default",
+			overrides: map[ColorRole]string{RoleWarning: "blue"},
+			role:      RoleWarning,
+			text:      "careful",
+			wants:     blue("careful"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewColorScheme(true, false, false)
+			cs.theme = resolveTheme(tt.theme, tt.overrides)
+			assert.Equal(t, tt.wants, cs.RoleColor(tt.role)(tt.text))
+		})
+	}
+}
+
 func TestHexToRGB(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -93,3 +142,43 @@ func TestHexToRGB(t *testing.T) {
 		assert.Equal(t, tt.wants, output)
 	}
 }
+
+func TestHyperlink(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		text    string
+		url     string
+		wants   string
+	}{
+		{
+			name:    "enabled",
+			enabled: true,
+			text:    "#123",
+			url:     "https://github.com/cli/cli/pull/123",
+			wants:   "\033]8;;https://github.com/cli/cli/pull/123\033\\#123\033]8;;\033\\",
+		},
+		{
+			name:    "disabled",
+			enabled: false,
+			text:    "#123",
+			url:     "https://github.com/cli/cli/pull/123",
+			wants:   "#123",
+		},
+		{
+			name:    "enabled with no url",
+			enabled: true,
+			text:    "#123",
+			url:     "",
+			wants:   "#123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := NewColorScheme(true, false, false)
+			cs.hyperlinksEnabled = tt.enabled
+			assert.Equal(t, tt.wants, cs.Hyperlink(tt.text, tt.url))
+		})
+	}
+}
@@ -73,6 +73,20 @@ type IOStreams struct {
 	colorOverride bool
 	colorEnabled  bool
 
+	// colorTheme selects the built-in role palette ("default", "light", "high-contrast")
+	// returned by ColorScheme. colorRoleOverrides replaces individual roles on top of it.
+	colorTheme         string
+	colorRoleOverrides map[ColorRole]string
+
+	tableTruncationDisabled bool
+	tableFormat             string
+	tableHeaders            *bool
+	tableTimestampFormat    string
+
+	// hyperlinksMode is "always", "never", "auto", or "" (equivalent to "auto"). It
+	// controls whether ColorScheme.Hyperlink renders an OSC 8 escape sequence.
+	hyperlinksMode string
+
 	pagerCommand string
 	pagerProcess *os.Process
 
@@ -366,7 +380,90 @@ func (s *IOStreams) TerminalWidth() int {
 }
 
 func (s *IOStreams) ColorScheme() *ColorScheme {
-	return NewColorScheme(s.ColorEnabled(), s.ColorSupport256(), s.HasTrueColor())
+	cs := NewColorScheme(s.ColorEnabled(), s.ColorSupport256(), s.HasTrueColor())
+	cs.theme = resolveTheme(s.colorTheme, s.colorRoleOverrides)
+	cs.hyperlinksEnabled = s.HyperlinksEnabled()
+	return cs
+}
+
+// SetColorTheme selects the built-in role palette used by ColorScheme accessors that
+// are expressed in terms of a role (SuccessColor, WarningColor, ...) rather than a
+// literal color name. Valid themes are "default", "light", and "high-contrast".
+func (s *IOStreams) SetColorTheme(theme string) {
+	s.colorTheme = theme
+}
+
+// SetColorRoleOverride overrides a single role's color regardless of the selected theme.
+func (s *IOStreams) SetColorRoleOverride(role ColorRole, colorName string) {
+	if s.colorRoleOverrides == nil {
+		s.colorRoleOverrides = map[ColorRole]string{}
+	}
+	s.colorRoleOverrides[role] = colorName
+}
+
+// SetTableTruncationDisabled controls whether tableprinter fields are truncated to fit
+// the terminal width. Non-TTY output is already untruncated and unaffected by this.
+func (s *IOStreams) SetTableTruncationDisabled(disabled bool) {
+	s.tableTruncationDisabled = disabled
+}
+
+func (s *IOStreams) TableTruncationDisabled() bool {
+	return s.tableTruncationDisabled
+}
+
+// SetTableFormat selects the machine/human output mode for the shared tableprinter:
+// "table" forces pretty column rendering even when not a terminal, "tsv" forces
+// tab-separated output, and "" leaves the default terminal-detecting behavior in place.
+func (s *IOStreams) SetTableFormat(format string) {
+	s.tableFormat = format
+}
+
+func (s *IOStreams) TableFormat() string {
+	return s.tableFormat
+}
+
+// SetTableHeaders overrides whether the tableprinter includes a header row, regardless
+// of the format's usual default (headers off for tsv, on for csv).
+func (s *IOStreams) SetTableHeaders(show bool) {
+	s.tableHeaders = &show
+}
+
+// TableHeaders reports the header override set via SetTableHeaders, if any.
+func (s *IOStreams) TableHeaders() *bool {
+	return s.tableHeaders
+}
+
+// SetTableTimestampFormat selects how the shared tableprinter renders timestamp
+// columns added via AddTimeField: "relative" (or "") keeps the existing fuzzy
+// "About 2 months ago" rendering, "absolute" prints the local time, and "iso8601"
+// prints UTC in RFC 3339. Non-TTY output always uses RFC 3339 and is unaffected.
+func (s *IOStreams) SetTableTimestampFormat(format string) {
+	s.tableTimestampFormat = format
+}
+
+// TableTimestampFormat reports the timestamp format set via SetTableTimestampFormat.
+func (s *IOStreams) TableTimestampFormat() string {
+	return s.tableTimestampFormat
+}
+
+// SetHyperlinksMode controls whether ColorScheme.Hyperlink renders OSC 8 escape
+// sequences: "always" forces them on, "never" forces them off, and "auto" (or "")
+// renders them only when color is enabled, matching most terminals' own OSC 8 support.
+func (s *IOStreams) SetHyperlinksMode(mode string) {
+	s.hyperlinksMode = mode
+}
+
+// HyperlinksEnabled reports whether ColorScheme.Hyperlink should render OSC 8 escape
+// sequences, per the active hyperlinks mode.
+func (s *IOStreams) HyperlinksEnabled() bool {
+	switch s.hyperlinksMode {
+	case "always":
+		return true
+	case "never":
+		return false
+	default:
+		return s.ColorEnabled()
+	}
 }
 
 func (s *IOStreams) ReadUserFile(fn string) ([]byte, error) {
@@ -24,6 +24,53 @@ func TestStopAlternateScreenBuffer(t *testing.T) {
 	}
 }
 
+func TestIOStreams_SetColorTheme(t *testing.T) {
+	ios, _, _, _ := Test()
+	ios.SetColorEnabled(true)
+
+	ios.SetColorTheme("light")
+	cs := ios.ColorScheme()
+	if got, want := cs.WarningColor()("x"), blue("x"); got != want {
+		t.Errorf("light theme WarningColor() = %q, want %q", got, want)
+	}
+
+	ios.SetColorRoleOverride(RoleWarning, "red")
+	cs = ios.ColorScheme()
+	if got, want := cs.WarningColor()("x"), red("x"); got != want {
+		t.Errorf("overridden WarningColor() = %q, want %q", got, want)
+	}
+}
+
+func TestIOStreams_SetHyperlinksMode(t *testing.T) {
+	ios, _, _, _ := Test()
+
+	ios.SetColorEnabled(false)
+	if ios.HyperlinksEnabled() {
+		t.Error("HyperlinksEnabled() = true, want false when color is disabled and mode is unset")
+	}
+
+	ios.SetHyperlinksMode("always")
+	if !ios.HyperlinksEnabled() {
+		t.Error("HyperlinksEnabled() = false, want true when mode is \"always\"")
+	}
+
+	ios.SetColorEnabled(true)
+	ios.SetHyperlinksMode("never")
+	if ios.HyperlinksEnabled() {
+		t.Error("HyperlinksEnabled() = true, want false when mode is \"never\"")
+	}
+
+	ios.SetHyperlinksMode("auto")
+	if !ios.HyperlinksEnabled() {
+		t.Error("HyperlinksEnabled() = false, want true when mode is \"auto\" and color is enabled")
+	}
+
+	cs := ios.ColorScheme()
+	if got, want := cs.Hyperlink("text", "https://example.com"), "\033]8;;https://example.com\033\\text\033]8;;\033\\"; got != want {
+		t.Errorf("ColorScheme().Hyperlink() = %q, want %q", got, want)
+	}
+}
+
 func TestIOStreams_pager(t *testing.T) {
 	t.Skip("TODO: fix this test in race detection mode")
 	ios, _, stdout, _ := Test()
@@ -0,0 +1,122 @@
+package iostreams
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// StartProgressBar begins rendering a determinate progress indicator to stderr for a
+// transfer of `total` bytes (pass 0 if the total is unknown). The returned writer is
+// meant to observe the transfer as it happens — for example via io.MultiWriter
+// alongside the real destination, or by wrapping the source with io.TeeReader — and
+// should be closed once the transfer completes so a final line gets printed.
+//
+// When stderr is a terminal the bar is redrawn in place; otherwise progress is
+// reported via periodic lines instead, matching how StartProgressIndicator degrades.
+// In CI, where neither rendering is useful, writes are still counted but nothing is
+// printed.
+func (s *IOStreams) StartProgressBar(label string, total int64) io.WriteCloser {
+	return &progressBar{
+		out:       s.ErrOut,
+		label:     label,
+		total:     total,
+		startedAt: time.Now(),
+		isTTY:     s.IsStderrTTY(),
+		enabled:   !isCI(),
+	}
+}
+
+type progressBar struct {
+	out       io.Writer
+	label     string
+	total     int64
+	isTTY     bool
+	enabled   bool
+	startedAt time.Time
+
+	mu        sync.Mutex
+	written   int64
+	lastPrint time.Time
+}
+
+func (p *progressBar) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.written += int64(len(b))
+	if p.enabled {
+		p.render(false)
+	}
+	return len(b), nil
+}
+
+// Close prints the final state of the bar. It never returns an error; it exists to
+// satisfy io.WriteCloser so progress bars can be used in a defer alongside the writer
+// they're tracking.
+func (p *progressBar) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.enabled {
+		p.render(true)
+	}
+	return nil
+}
+
+func (p *progressBar) render(final bool) {
+	now := time.Now()
+	// Non-TTY output can't redraw a line in place, so only print periodically to avoid
+	// flooding logs with one line per Write call.
+	if !final && !p.isTTY && now.Sub(p.lastPrint) < 2*time.Second {
+		return
+	}
+	p.lastPrint = now
+
+	elapsed := now.Sub(p.startedAt).Seconds()
+	var rate float64
+	if elapsed > 0 {
+		rate = float64(p.written) / elapsed
+	}
+
+	line := fmt.Sprintf("%s %s, %s/s", p.label, formatBytes(p.written), formatBytes(int64(rate)))
+	if p.total > 0 {
+		pct := float64(p.written) / float64(p.total) * 100
+		if pct > 100 {
+			pct = 100
+		}
+		line = fmt.Sprintf("%s %.0f%% (%s/%s), %s/s", p.label, pct, formatBytes(p.written), formatBytes(p.total), formatBytes(int64(rate)))
+		if !final && rate > 0 && p.total > p.written {
+			eta := time.Duration(float64(p.total-p.written)/rate) * time.Second
+			line += fmt.Sprintf(", ETA %s", eta.Round(time.Second))
+		}
+	}
+
+	if p.isTTY {
+		fmt.Fprint(p.out, "\r\033[K"+line)
+		if final {
+			fmt.Fprintln(p.out)
+		}
+	} else {
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+func isCI() bool {
+	return os.Getenv("GITHUB_ACTIONS") == "true" || os.Getenv("CI") != ""
+}
+
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
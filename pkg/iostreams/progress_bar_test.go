@@ -0,0 +1,68 @@
+package iostreams
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIOStreams_StartProgressBar_tty(t *testing.T) {
+	ios, _, _, stderr := Test()
+	ios.SetStderrTTY(true)
+
+	pb := ios.StartProgressBar("Downloading foo.zip", 100)
+	_, err := pb.Write(make([]byte, 50))
+	assert.NoError(t, err)
+	assert.NoError(t, pb.Close())
+
+	out := stderr.String()
+	assert.Contains(t, out, "Downloading foo.zip")
+	assert.Contains(t, out, "50%")
+	assert.Contains(t, out, "\r")
+}
+
+func TestIOStreams_StartProgressBar_nonTTY(t *testing.T) {
+	ios, _, _, stderr := Test()
+	ios.SetStderrTTY(false)
+
+	pb := ios.StartProgressBar("Downloading foo.zip", 100)
+	_, err := pb.Write(make([]byte, 100))
+	assert.NoError(t, err)
+	assert.NoError(t, pb.Close())
+
+	out := stderr.String()
+	assert.Contains(t, out, "100%")
+	assert.False(t, strings.Contains(out, "\r"))
+}
+
+func TestIOStreams_StartProgressBar_unknownTotal(t *testing.T) {
+	ios, _, _, stderr := Test()
+	ios.SetStderrTTY(true)
+
+	pb := ios.StartProgressBar("Uploading", 0)
+	_, err := pb.Write(make([]byte, 2048))
+	assert.NoError(t, err)
+	assert.NoError(t, pb.Close())
+
+	out := stderr.String()
+	assert.Contains(t, out, "Uploading")
+	assert.Contains(t, out, "2.0KiB")
+	assert.False(t, strings.Contains(out, "%"))
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1024, "1.0KiB"},
+		{1536, "1.5KiB"},
+		{1024 * 1024, "1.0MiB"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatBytes(tt.in))
+	}
+}
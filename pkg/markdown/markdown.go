@@ -1,6 +1,10 @@
 package markdown
 
 import (
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/charmbracelet/glamour"
 	ghMarkdown "github.com/cli/go-gh/v2/pkg/markdown"
 )
@@ -23,6 +27,51 @@ func WithTheme(theme string) glamour.TermRendererOption {
 	return ghMarkdown.WithTheme(theme)
 }
 
+// WithStyle is a rendering option that sets an explicit glamour style, either a
+// built-in style name (e.g. "dracula") or a path to a custom style JSON file,
+// overriding the style WithTheme would otherwise derive from the terminal
+// background.
+func WithStyle(style string) glamour.TermRendererOption {
+	return glamour.WithStylePath(style)
+}
+
+// ValidStyle reports whether style names a built-in glamour style or an existing,
+// readable file, i.e. whether it's safe to pass to WithStyle.
+func ValidStyle(style string) bool {
+	if style == "" || style == "auto" {
+		return true
+	}
+	if _, ok := glamour.DefaultStyles[style]; ok {
+		return true
+	}
+	_, err := os.Stat(style)
+	return err == nil
+}
+
+// Style resolves the glamour rendering option to use for a given glamour_style
+// config value and the terminal's detected theme. ok is false when glamourStyle
+// is set but isn't a usable style name or file path, in which case the returned
+// option falls back to rendering for terminalTheme.
+func Style(glamourStyle, terminalTheme string) (opt glamour.TermRendererOption, ok bool) {
+	if glamourStyle == "" || glamourStyle == "auto" {
+		return WithTheme(terminalTheme), true
+	}
+	if ValidStyle(glamourStyle) {
+		return WithStyle(glamourStyle), true
+	}
+	return WithTheme(terminalTheme), false
+}
+
+// StyleFromConfig is like Style, but prints a warning to errOut and falls back to
+// terminalTheme when glamourStyle isn't a usable style name or file path.
+func StyleFromConfig(glamourStyle, terminalTheme string, errOut io.Writer) glamour.TermRendererOption {
+	opt, ok := Style(glamourStyle, terminalTheme)
+	if !ok {
+		fmt.Fprintf(errOut, "warning: glamour_style %q is not a valid style name or file path; falling back to the terminal theme\n", glamourStyle)
+	}
+	return opt
+}
+
 func WithBaseURL(u string) glamour.TermRendererOption {
 	return ghMarkdown.WithBaseURL(u)
 }
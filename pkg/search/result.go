@@ -90,6 +90,24 @@ var PullRequestFields = append(IssueFields,
 	"isDraft",
 )
 
+var UserFields = []string{
+	"bio",
+	"company",
+	"createdAt",
+	"email",
+	"followers",
+	"following",
+	"hireable",
+	"id",
+	"location",
+	"login",
+	"name",
+	"publicRepos",
+	"score",
+	"type",
+	"url",
+}
+
 type CodeResult struct {
 	IncompleteResults bool   `json:"incomplete_results"`
 	Items             []Code `json:"items"`
@@ -114,6 +132,12 @@ type IssuesResult struct {
 	Total             int     `json:"total_count"`
 }
 
+type UsersResult struct {
+	IncompleteResults bool         `json:"incomplete_results"`
+	Items             []SearchUser `json:"items"`
+	Total             int          `json:"total_count"`
+}
+
 type Code struct {
 	Name        string      `json:"name"`
 	Path        string      `json:"path"`
@@ -253,6 +277,28 @@ type Label struct {
 	Name        string `json:"name"`
 }
 
+// SearchUser is a user or organization account as returned by the user search
+// API. Unlike User, which is embedded as a nested field on other result types
+// (e.g. an issue's author), SearchUser is a top-level search result and
+// carries the additional profile fields the user search endpoint returns.
+type SearchUser struct {
+	Bio         string    `json:"bio"`
+	Company     string    `json:"company"`
+	CreatedAt   time.Time `json:"created_at"`
+	Email       string    `json:"email"`
+	Followers   int       `json:"followers"`
+	Following   int       `json:"following"`
+	Hireable    bool      `json:"hireable"`
+	ID          string    `json:"node_id"`
+	Location    string    `json:"location"`
+	Login       string    `json:"login"`
+	Name        string    `json:"name"`
+	PublicRepos int       `json:"public_repos"`
+	Score       float64   `json:"score"`
+	Type        string    `json:"type"`
+	URL         string    `json:"html_url"`
+}
+
 func (u User) IsBot() bool {
 	// copied from api/queries_issue.go
 	// would ideally be shared, but it would require coordinating a "user"
@@ -442,6 +488,10 @@ func (issue Issue) ExportData(fields []string) map[string]interface{} {
 	return data
 }
 
+func (user SearchUser) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(user, fields)
+}
+
 func fieldByName(v reflect.Value, field string) reflect.Value {
 	return v.FieldByNameFunc(func(s string) bool {
 		return strings.EqualFold(field, s)
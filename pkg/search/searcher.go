@@ -29,6 +29,7 @@ type Searcher interface {
 	Commits(Query) (CommitsResult, error)
 	Repositories(Query) (RepositoriesResult, error)
 	Issues(Query) (IssuesResult, error)
+	Users(Query) (UsersResult, error)
 	URL(Query) string
 }
 
@@ -154,6 +155,30 @@ func (s searcher) Issues(query Query) (IssuesResult, error) {
 	return result, nil
 }
 
+func (s searcher) Users(query Query) (UsersResult, error) {
+	result := UsersResult{}
+	toRetrieve := query.Limit
+	var resp *http.Response
+	var err error
+	for toRetrieve > 0 {
+		query.Limit = min(toRetrieve, maxPerPage)
+		query.Page = nextPage(resp)
+		if query.Page == 0 {
+			break
+		}
+		page := UsersResult{}
+		resp, err = s.search(query, &page)
+		if err != nil {
+			return result, err
+		}
+		result.IncompleteResults = page.IncompleteResults
+		result.Total = page.Total
+		result.Items = append(result.Items, page.Items...)
+		toRetrieve = toRetrieve - len(page.Items)
+	}
+	return result, nil
+}
+
 func (s searcher) search(query Query, result interface{}) (*http.Response, error) {
 	path := fmt.Sprintf("%ssearch/%s", ghinstance.RESTPrefix(s.host), query.Kind)
 	qs := url.Values{}